@@ -66,6 +66,9 @@ const (
 	Permission_CLUSTER_IDENTITY_LIST_OIDC_CLIENTS         Permission = 127
 	Permission_CLUSTER_IDENTITY_GET_OIDC_CLIENT           Permission = 128
 	Permission_CLUSTER_IDENTITY_DELETE_OIDC_CLIENT        Permission = 129
+	Permission_CLUSTER_IDENTITY_LIST_USERS                Permission = 142
+	Permission_CLUSTER_IDENTITY_DISABLE_USER              Permission = 143
+	Permission_CLUSTER_IDENTITY_DELETE_USER               Permission = 144
 	Permission_CLUSTER_DEBUG_DUMP                         Permission = 131
 	Permission_CLUSTER_LICENSE_ACTIVATE                   Permission = 132
 	Permission_CLUSTER_LICENSE_GET_CODE                   Permission = 133
@@ -73,6 +76,7 @@ const (
 	Permission_CLUSTER_LICENSE_UPDATE_CLUSTER             Permission = 135
 	Permission_CLUSTER_LICENSE_DELETE_CLUSTER             Permission = 136
 	Permission_CLUSTER_LICENSE_LIST_CLUSTERS              Permission = 137
+	Permission_CLUSTER_LICENSE_SET_LIMITS                 Permission = 145
 	Permission_CLUSTER_DELETE_ALL                         Permission = 138
 	Permission_REPO_READ                                  Permission = 200
 	Permission_REPO_WRITE                                 Permission = 201
@@ -124,6 +128,9 @@ var Permission_name = map[int32]string{
 	127: "CLUSTER_IDENTITY_LIST_OIDC_CLIENTS",
 	128: "CLUSTER_IDENTITY_GET_OIDC_CLIENT",
 	129: "CLUSTER_IDENTITY_DELETE_OIDC_CLIENT",
+	142: "CLUSTER_IDENTITY_LIST_USERS",
+	143: "CLUSTER_IDENTITY_DISABLE_USER",
+	144: "CLUSTER_IDENTITY_DELETE_USER",
 	131: "CLUSTER_DEBUG_DUMP",
 	132: "CLUSTER_LICENSE_ACTIVATE",
 	133: "CLUSTER_LICENSE_GET_CODE",
@@ -131,6 +138,7 @@ var Permission_name = map[int32]string{
 	135: "CLUSTER_LICENSE_UPDATE_CLUSTER",
 	136: "CLUSTER_LICENSE_DELETE_CLUSTER",
 	137: "CLUSTER_LICENSE_LIST_CLUSTERS",
+	145: "CLUSTER_LICENSE_SET_LIMITS",
 	138: "CLUSTER_DELETE_ALL",
 	200: "REPO_READ",
 	201: "REPO_WRITE",
@@ -182,6 +190,9 @@ var Permission_value = map[string]int32{
 	"CLUSTER_IDENTITY_LIST_OIDC_CLIENTS":         127,
 	"CLUSTER_IDENTITY_GET_OIDC_CLIENT":           128,
 	"CLUSTER_IDENTITY_DELETE_OIDC_CLIENT":        129,
+	"CLUSTER_IDENTITY_LIST_USERS":                142,
+	"CLUSTER_IDENTITY_DISABLE_USER":              143,
+	"CLUSTER_IDENTITY_DELETE_USER":               144,
 	"CLUSTER_DEBUG_DUMP":                         131,
 	"CLUSTER_LICENSE_ACTIVATE":                   132,
 	"CLUSTER_LICENSE_GET_CODE":                   133,
@@ -189,6 +200,7 @@ var Permission_value = map[string]int32{
 	"CLUSTER_LICENSE_UPDATE_CLUSTER":             135,
 	"CLUSTER_LICENSE_DELETE_CLUSTER":             136,
 	"CLUSTER_LICENSE_LIST_CLUSTERS":              137,
+	"CLUSTER_LICENSE_SET_LIMITS":                 145,
 	"CLUSTER_DELETE_ALL":                         138,
 	"REPO_READ":                                  200,
 	"REPO_WRITE":                                 201,
@@ -2906,169 +2918,170 @@ func init() {
 func init() { proto.RegisterFile("auth/auth.proto", fileDescriptor_712ec48c1eaf43a2) }
 
 var fileDescriptor_712ec48c1eaf43a2 = []byte{
-	// 2578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x59, 0x73, 0xe3, 0xc6,
-	0x11, 0x5e, 0x88, 0x3a, 0xc8, 0xa6, 0x56, 0xc2, 0x8e, 0x2e, 0x0a, 0xab, 0xd5, 0x01, 0x67, 0xbd,
-	0xeb, 0xb5, 0x23, 0x39, 0x4a, 0xec, 0xda, 0xd8, 0x5b, 0xa9, 0xf0, 0x80, 0x68, 0xc8, 0xbc, 0x32,
-	0x00, 0x77, 0xed, 0xbc, 0x20, 0x14, 0x39, 0x2b, 0xc1, 0x96, 0x08, 0x1a, 0x00, 0x95, 0x95, 0x73,
-	0x38, 0xa9, 0xdc, 0x57, 0x95, 0xab, 0xf2, 0x0b, 0xfc, 0x9a, 0x2a, 0xbf, 0xe4, 0x57, 0x38, 0xb7,
-	0x73, 0x3e, 0x6e, 0x52, 0xfa, 0x09, 0xf9, 0x05, 0x29, 0xcc, 0x01, 0x80, 0x20, 0xb8, 0x3e, 0xe2,
-	0x17, 0x0a, 0xd3, 0xfd, 0xcd, 0xd7, 0x3d, 0x3d, 0x3d, 0x47, 0x8f, 0x60, 0xb1, 0x33, 0xf4, 0x4f,
-	0xf6, 0x82, 0x9f, 0xdd, 0x81, 0xeb, 0xf8, 0x0e, 0x9a, 0x0e, 0xbe, 0x95, 0xe5, 0x63, 0xe7, 0xd8,
-	0xa1, 0x82, 0xbd, 0xe0, 0x8b, 0xe9, 0x94, 0xad, 0x63, 0xc7, 0x39, 0x3e, 0x25, 0x7b, 0xb4, 0x75,
-	0x34, 0x7c, 0xb8, 0xe7, 0xdb, 0x67, 0xc4, 0xf3, 0x3b, 0x67, 0x03, 0x06, 0x50, 0xef, 0xc1, 0x62,
-	0xb1, 0xeb, 0xdb, 0xe7, 0x1d, 0x9f, 0x60, 0xf2, 0xd6, 0x90, 0x78, 0x3e, 0xba, 0x01, 0xe0, 0x3a,
-	0x8e, 0x6f, 0xf9, 0xce, 0x9b, 0xa4, 0x5f, 0xc8, 0x6c, 0x4b, 0xb7, 0x73, 0x38, 0x17, 0x48, 0xcc,
-	0x40, 0x70, 0x38, 0x9d, 0x95, 0xe4, 0xa9, 0xc3, 0xe9, 0xec, 0x94, 0x9c, 0x51, 0xbf, 0x00, 0x72,
-	0xd4, 0xdb, 0x1b, 0x38, 0x7d, 0x8f, 0x04, 0xdd, 0x07, 0x9d, 0xee, 0x09, 0xef, 0x2e, 0xb1, 0xee,
-	0x81, 0x84, 0x76, 0x57, 0x97, 0xe0, 0x5a, 0x85, 0x74, 0x46, 0x4d, 0xaa, 0xcb, 0x80, 0xe2, 0x42,
-	0xc6, 0xa4, 0xbe, 0x37, 0x05, 0xd0, 0xd4, 0x2b, 0xe5, 0xb2, 0xd3, 0x7f, 0x68, 0x1f, 0xa3, 0x55,
-	0x98, 0xb5, 0x3d, 0x6f, 0x48, 0x5c, 0x4e, 0xca, 0x5b, 0xe8, 0x19, 0xc8, 0x75, 0x4f, 0x6d, 0xd2,
-	0xf7, 0x2d, 0xbb, 0x57, 0x98, 0x0a, 0x54, 0xa5, 0xf9, 0xcb, 0xc7, 0x5b, 0xd9, 0x32, 0x15, 0xea,
-	0x15, 0x9c, 0x65, 0x6a, 0xbd, 0x87, 0x9e, 0x82, 0xab, 0x1c, 0xea, 0x91, 0xae, 0x4b, 0x7c, 0x3e,
-	0xba, 0x79, 0x26, 0x34, 0xa8, 0x0c, 0xed, 0xc3, 0xbc, 0x4b, 0x7a, 0xb6, 0x4b, 0xba, 0xbe, 0x35,
-	0x74, 0xed, 0xc2, 0x34, 0xa5, 0x5c, 0xbc, 0x7c, 0xbc, 0x95, 0xc7, 0x5c, 0xde, 0xc6, 0x3a, 0xce,
-	0x0b, 0x50, 0xdb, 0xb5, 0x03, 0xdf, 0xbc, 0xae, 0x33, 0x20, 0x5e, 0x61, 0x66, 0x3b, 0x13, 0xf8,
-	0xc6, 0x5a, 0xe8, 0x4b, 0xb0, 0xea, 0x92, 0xb7, 0x86, 0xb6, 0x4b, 0x2c, 0x72, 0xd6, 0xb1, 0x4f,
-	0xad, 0x73, 0xe2, 0xda, 0x0f, 0x6d, 0xd2, 0x2b, 0xcc, 0x6e, 0x4b, 0xb7, 0xb3, 0x78, 0x99, 0x6b,
-	0xb5, 0x40, 0x79, 0x9f, 0xeb, 0xd0, 0x33, 0x20, 0x9f, 0x3a, 0xdd, 0xce, 0xe9, 0x89, 0xe3, 0xf9,
-	0x16, 0x1f, 0xf3, 0x1c, 0xc5, 0x2f, 0x86, 0x72, 0x9d, 0x8a, 0xd5, 0x75, 0x58, 0xab, 0x12, 0x9f,
-	0x45, 0x68, 0xe8, 0x76, 0x7c, 0xdb, 0xe9, 0x8b, 0xa0, 0x62, 0x28, 0x8c, 0xab, 0xf8, 0x24, 0xbd,
-	0x08, 0x57, 0xbb, 0x71, 0x05, 0x0d, 0x69, 0x7e, 0x5f, 0xde, 0xa5, 0x79, 0x15, 0x05, 0x1d, 0x8f,
-	0xc2, 0xd4, 0xaf, 0xc1, 0x9a, 0x91, 0x6e, 0xee, 0x53, 0x53, 0x2a, 0x50, 0x30, 0x26, 0xb8, 0xa9,
-	0xfe, 0x56, 0x82, 0x1c, 0x4d, 0x1b, 0xbd, 0xff, 0xd0, 0x41, 0x05, 0x98, 0xf3, 0x86, 0x47, 0x6f,
-	0x90, 0xae, 0xcf, 0x33, 0x40, 0x34, 0x91, 0x01, 0x40, 0x1e, 0x0d, 0x6c, 0x6e, 0x78, 0x8a, 0x1a,
-	0x56, 0x76, 0x59, 0xee, 0xef, 0x8a, 0xdc, 0xdf, 0x35, 0x45, 0xee, 0x97, 0xd6, 0xfe, 0xfb, 0x78,
-	0x6b, 0xb1, 0x77, 0xf4, 0x92, 0x1a, 0xf5, 0x52, 0xdf, 0xfd, 0xf7, 0x96, 0x84, 0x63, 0x34, 0xe8,
-	0x45, 0x98, 0x3f, 0xe9, 0x78, 0x27, 0xa4, 0x17, 0x5f, 0x09, 0xa5, 0x25, 0xd1, 0x95, 0x0a, 0xad,
-	0x00, 0xa1, 0xe2, 0x3c, 0x03, 0xb2, 0x0c, 0x7f, 0x03, 0x96, 0x8a, 0x43, 0xff, 0x84, 0xf4, 0x7d,
-	0xbb, 0x1b, 0x5b, 0x56, 0xcf, 0x01, 0x38, 0x76, 0xaf, 0x6b, 0x79, 0x7e, 0xc7, 0x27, 0x9c, 0xec,
-	0xea, 0xe5, 0xe3, 0xad, 0x5c, 0x10, 0x1a, 0x23, 0x10, 0xe2, 0x5c, 0x00, 0xa0, 0x9f, 0x68, 0x1d,
-	0xb2, 0xb6, 0x30, 0x3c, 0xcd, 0x06, 0x6b, 0xf7, 0xc6, 0x17, 0xe0, 0x0b, 0xb0, 0x3c, 0x6a, 0xeb,
-	0xe3, 0x2d, 0xc2, 0x45, 0xb8, 0xfa, 0xe0, 0xc4, 0x29, 0x9e, 0xe9, 0x22, 0x57, 0x7e, 0x2d, 0xc1,
-	0x82, 0x90, 0x70, 0x0a, 0x05, 0xb2, 0x43, 0x8f, 0xb8, 0xfd, 0xce, 0x19, 0xe1, 0x04, 0x61, 0x3b,
-	0x11, 0xef, 0x99, 0xcf, 0x24, 0xde, 0x6c, 0x44, 0x87, 0xd3, 0xd9, 0x8c, 0x3c, 0x7d, 0x38, 0x9d,
-	0x9d, 0x96, 0x67, 0x54, 0x07, 0x66, 0xb0, 0x73, 0x4a, 0x3c, 0xf4, 0x1c, 0xcc, 0xb8, 0xc1, 0x47,
-	0x41, 0xda, 0xce, 0xdc, 0xce, 0xef, 0xaf, 0xb2, 0x9c, 0xa2, 0x3a, 0xf6, 0xab, 0xf5, 0x7d, 0xf7,
-	0x02, 0x33, 0x90, 0x72, 0x17, 0x20, 0x12, 0x22, 0x19, 0x32, 0x6f, 0x92, 0x0b, 0x3e, 0x84, 0xe0,
-	0x13, 0x2d, 0xc3, 0xcc, 0x79, 0xe7, 0x74, 0x48, 0x68, 0xa2, 0x64, 0x31, 0x6b, 0xbc, 0x34, 0x75,
-	0x57, 0x52, 0xdf, 0x95, 0x20, 0x1f, 0x74, 0x2d, 0xd9, 0xfd, 0x9e, 0xdd, 0x3f, 0x46, 0x77, 0x61,
-	0x8e, 0xf4, 0x7d, 0xd7, 0x0e, 0x2d, 0x6f, 0x46, 0x96, 0x39, 0x66, 0x57, 0x63, 0x00, 0xe6, 0x81,
-	0x80, 0x2b, 0x55, 0x98, 0x8f, 0x2b, 0x52, 0xbc, 0xd8, 0x89, 0x7b, 0x91, 0xdf, 0xcf, 0xc7, 0xc6,
-	0x14, 0x77, 0xe9, 0x00, 0xb2, 0x98, 0x78, 0xce, 0xd0, 0xed, 0x12, 0xf4, 0x34, 0x4c, 0xfb, 0x17,
-	0x03, 0x36, 0x1d, 0x0b, 0xfb, 0x88, 0xf7, 0xe0, 0x5a, 0xf3, 0x62, 0x40, 0x30, 0xd5, 0x23, 0x04,
-	0xd3, 0x74, 0xda, 0xe8, 0x66, 0x88, 0xe9, 0xb7, 0xfa, 0x0e, 0xcc, 0xb4, 0x3d, 0xe2, 0x7a, 0xe8,
-	0x2e, 0xe4, 0xc4, 0x3c, 0x8a, 0x51, 0x29, 0x8c, 0x89, 0xea, 0xe9, 0x2f, 0x55, 0xb2, 0x11, 0x45,
-	0x60, 0xe5, 0x1e, 0x2c, 0x8c, 0x2a, 0x3f, 0x51, 0x6c, 0x87, 0x30, 0x5b, 0x75, 0x9d, 0xe1, 0xc0,
-	0x43, 0xcf, 0xc3, 0xec, 0x31, 0xfd, 0xe2, 0xe6, 0x0b, 0xcc, 0x3c, 0xd3, 0xf2, 0x3f, 0xcc, 0x38,
-	0xc7, 0x29, 0x5f, 0x86, 0x7c, 0x4c, 0xfc, 0x89, 0xcc, 0x3e, 0x02, 0x39, 0x58, 0x21, 0x8e, 0x6b,
-	0xbf, 0x1d, 0x2e, 0xc5, 0x3b, 0x90, 0x75, 0x79, 0xd4, 0xf8, 0x2e, 0xb5, 0x30, 0x1a, 0x4b, 0x1c,
-	0xea, 0xd1, 0x3e, 0xe4, 0x07, 0xc4, 0x3d, 0xb3, 0x3d, 0xcf, 0x76, 0xfa, 0x5e, 0x21, 0xb3, 0x9d,
-	0xb9, 0xbd, 0x20, 0x36, 0xb5, 0x56, 0xa8, 0xc0, 0x71, 0x10, 0x5f, 0x9b, 0xef, 0x4b, 0x70, 0x2d,
-	0x66, 0x9a, 0x2f, 0xab, 0x4d, 0x80, 0x8e, 0x10, 0xf6, 0xa8, 0xf5, 0x2c, 0x8e, 0x49, 0xd0, 0x2e,
-	0xe4, 0xbc, 0x8e, 0x6f, 0x7b, 0xf4, 0x90, 0x98, 0x9a, 0x60, 0x2d, 0x82, 0xa0, 0x3b, 0x30, 0x47,
-	0xa5, 0xfd, 0xe3, 0x89, 0xbe, 0x09, 0x00, 0xda, 0x80, 0xdc, 0xc0, 0xb5, 0xfb, 0x5d, 0x7b, 0xd0,
-	0x39, 0xe5, 0xbb, 0x4a, 0x24, 0x50, 0xcb, 0xb0, 0x52, 0x25, 0x7e, 0xd4, 0xcf, 0xfb, 0x14, 0xe1,
-	0x52, 0xcf, 0x60, 0x67, 0x94, 0xe4, 0xc0, 0x71, 0x5b, 0xc2, 0xc4, 0xa7, 0x89, 0xff, 0x88, 0xcf,
-	0x53, 0x49, 0x9f, 0x8f, 0x60, 0x35, 0xe9, 0x33, 0x8f, 0x73, 0x62, 0xde, 0xa4, 0x8f, 0x31, 0x6f,
-	0x41, 0x16, 0xb1, 0x6d, 0x66, 0x8a, 0x1e, 0xe2, 0xac, 0xa1, 0xbe, 0x0d, 0x85, 0xba, 0xd3, 0xb3,
-	0x1f, 0x5e, 0xc4, 0x56, 0xfd, 0x67, 0x3e, 0x92, 0xc8, 0x76, 0x26, 0x6e, 0xfb, 0x3a, 0xac, 0xa7,
-	0xd8, 0xe6, 0xa7, 0x23, 0x9b, 0xb0, 0xff, 0xcf, 0x2b, 0x55, 0xa3, 0x11, 0x4c, 0xa1, 0x47, 0xcf,
-	0xc2, 0xdc, 0x11, 0x13, 0x71, 0x92, 0x6b, 0x63, 0x9b, 0x1f, 0x16, 0x08, 0xf5, 0x1b, 0x90, 0x37,
-	0x08, 0x0d, 0x23, 0x3d, 0xaa, 0x97, 0x61, 0xa6, 0xef, 0xf4, 0xbb, 0xe2, 0xe4, 0x60, 0x8d, 0x40,
-	0x4a, 0x6f, 0x41, 0x7c, 0xf4, 0xac, 0x81, 0x6e, 0xc2, 0x42, 0xd7, 0xe9, 0x9f, 0x13, 0x37, 0xe8,
-	0x6d, 0x11, 0xd7, 0xa5, 0x87, 0x63, 0x96, 0xde, 0x13, 0xb8, 0x54, 0x73, 0x5d, 0x75, 0x05, 0x96,
-	0xaa, 0xc4, 0x0f, 0x0e, 0xcb, 0x9a, 0x73, 0x6c, 0x87, 0xb7, 0x9c, 0x07, 0xb0, 0x3c, 0x2a, 0xe6,
-	0xde, 0x3f, 0x03, 0xb9, 0xd3, 0x40, 0x60, 0x0d, 0xdd, 0x53, 0xe6, 0x05, 0xbb, 0x15, 0x52, 0x54,
-	0x1b, 0xd7, 0x70, 0x96, 0xaa, 0xdb, 0x2e, 0x0d, 0x3d, 0x3b, 0x94, 0xb9, 0x5b, 0xb4, 0xa1, 0x56,
-	0x29, 0x31, 0x76, 0x8e, 0xf8, 0xc5, 0x57, 0x04, 0x97, 0x4e, 0xd4, 0x91, 0x23, 0xee, 0x20, 0xac,
-	0x81, 0xd6, 0x21, 0xe3, 0xfb, 0x6c, 0x60, 0x99, 0xd2, 0xdc, 0xe5, 0xe3, 0xad, 0x8c, 0x69, 0xd6,
-	0x70, 0x20, 0x53, 0x3f, 0xcf, 0xa7, 0x29, 0x22, 0xe2, 0x2e, 0x2e, 0xc3, 0x4c, 0xfc, 0x7c, 0x66,
-	0x0d, 0x75, 0x17, 0x56, 0x31, 0x39, 0x77, 0xde, 0x24, 0xc1, 0xde, 0x91, 0xb4, 0x9c, 0x82, 0x5f,
-	0x87, 0xb5, 0x31, 0x3c, 0x4f, 0x90, 0x3a, 0xbd, 0xad, 0xb1, 0x9d, 0xf3, 0xc0, 0x71, 0x83, 0xcd,
-	0x5b, 0x70, 0x3d, 0xe9, 0x74, 0x5f, 0x0d, 0xf7, 0x67, 0xb6, 0x0e, 0x78, 0x8b, 0xdf, 0xd4, 0x12,
-	0x74, 0xdc, 0xd4, 0x7d, 0x58, 0x66, 0x89, 0x5a, 0x27, 0x67, 0x47, 0xc4, 0xf5, 0x62, 0x3e, 0xd3,
-	0xde, 0xc2, 0x67, 0xda, 0x08, 0x36, 0xf0, 0x4e, 0xaf, 0xc7, 0xe9, 0x83, 0xcf, 0xc0, 0xa6, 0x4b,
-	0xce, 0x9c, 0x73, 0xc2, 0xf3, 0x9f, 0xb7, 0xd4, 0x35, 0x58, 0x49, 0xf0, 0x72, 0x83, 0x08, 0xe4,
-	0xaa, 0x70, 0x46, 0xe4, 0xc2, 0x3d, 0xd8, 0xa8, 0xc6, 0x1c, 0x1c, 0xdb, 0x77, 0x46, 0x56, 0xa0,
-	0x94, 0xdc, 0x4b, 0x9e, 0x85, 0x6b, 0x31, 0x46, 0x3e, 0x47, 0xab, 0x23, 0x67, 0x55, 0x14, 0x8b,
-	0x5b, 0xb0, 0x58, 0x25, 0x3e, 0x3d, 0x31, 0x9f, 0x38, 0x54, 0xf5, 0x79, 0xea, 0x27, 0x07, 0x72,
-	0xd2, 0x8d, 0xe4, 0x11, 0x9c, 0x8b, 0x1d, 0xb3, 0x41, 0x98, 0xb5, 0x47, 0xbe, 0xdb, 0xe9, 0xfa,
-	0xe1, 0x8c, 0x86, 0x23, 0x3c, 0x84, 0xf5, 0x14, 0x1d, 0xa7, 0xbd, 0x05, 0xb3, 0x34, 0x25, 0xd8,
-	0xbc, 0xe5, 0xf7, 0x17, 0xd9, 0x7a, 0x0d, 0x2f, 0xd0, 0x98, 0xab, 0xd9, 0x0d, 0x52, 0x3d, 0x08,
-	0x12, 0xc7, 0xf3, 0x1d, 0x77, 0x3c, 0xd3, 0x6e, 0x8a, 0x4c, 0x63, 0x77, 0x93, 0x31, 0x22, 0xa6,
-	0xe5, 0x3c, 0x0a, 0x14, 0xc6, 0x79, 0xf8, 0x2c, 0xdd, 0x83, 0xcd, 0x44, 0x72, 0x7e, 0x82, 0x44,
-	0x54, 0x77, 0x60, 0x6b, 0x62, 0x6f, 0x6e, 0x60, 0x1b, 0x36, 0x2b, 0xe4, 0x94, 0xf8, 0x44, 0x0b,
-	0x2e, 0x92, 0xa4, 0x37, 0x1e, 0xb2, 0x1d, 0xd8, 0x9a, 0x88, 0x60, 0x24, 0x77, 0xde, 0xbb, 0x0a,
-	0x10, 0x9d, 0x09, 0x28, 0x0f, 0x73, 0xed, 0xc6, 0xab, 0x8d, 0xe6, 0x83, 0x86, 0x7c, 0x05, 0x5d,
-	0x87, 0xb5, 0x72, 0xad, 0x6d, 0x98, 0x1a, 0xb6, 0xea, 0xcd, 0x8a, 0x7e, 0xf0, 0xba, 0x55, 0xd2,
-	0x1b, 0x15, 0xbd, 0x51, 0x35, 0xe4, 0x1e, 0x2a, 0xc0, 0xb2, 0x50, 0x56, 0x35, 0x33, 0xd2, 0x04,
-	0xf7, 0xf7, 0x15, 0xa1, 0x29, 0xb6, 0xcd, 0x57, 0xac, 0x62, 0xd9, 0xd4, 0xef, 0x17, 0x4d, 0x4d,
-	0x7e, 0x18, 0x67, 0xa4, 0xaa, 0x8a, 0x16, 0x2a, 0x8f, 0xc7, 0x94, 0x01, 0x6d, 0xb9, 0xd9, 0x38,
-	0xd0, 0xab, 0xf2, 0xc9, 0x98, 0xd2, 0x88, 0x94, 0x36, 0xda, 0x81, 0x8d, 0xb1, 0x9e, 0xb8, 0x59,
-	0x6a, 0x9a, 0x96, 0xd9, 0x7c, 0x55, 0x6b, 0xc8, 0xbf, 0x90, 0xd0, 0x4d, 0xd8, 0x19, 0x81, 0xf0,
-	0x01, 0x55, 0x71, 0xb3, 0xdd, 0xb2, 0xea, 0x5a, 0xbd, 0xa4, 0x61, 0x43, 0x3e, 0x4b, 0xf5, 0x81,
-	0x62, 0x0c, 0xb9, 0x8f, 0xb6, 0x53, 0xcc, 0x30, 0x82, 0xb6, 0x11, 0x74, 0x77, 0xd0, 0x16, 0x5c,
-	0x1f, 0x41, 0x68, 0xaf, 0x99, 0xb8, 0x58, 0xe6, 0x6e, 0x18, 0xf2, 0x00, 0x6d, 0x82, 0x32, 0x02,
-	0xc0, 0x9a, 0x61, 0x36, 0xb1, 0xc6, 0xfd, 0x7c, 0x0b, 0xed, 0xc1, 0x9d, 0x31, 0x13, 0x2d, 0x0d,
-	0xd7, 0x75, 0xc3, 0xd0, 0x9b, 0x0d, 0xc3, 0x3a, 0x68, 0x62, 0xab, 0x85, 0xf5, 0x46, 0x59, 0x6f,
-	0x15, 0x6b, 0xf2, 0xaf, 0x24, 0x74, 0x0b, 0xd4, 0x44, 0x44, 0x6b, 0x9a, 0xa9, 0x59, 0xda, 0x6b,
-	0x2d, 0x1d, 0x6b, 0x15, 0x61, 0xf8, 0x97, 0x52, 0xdc, 0x35, 0xad, 0x61, 0x6a, 0xb8, 0x85, 0x75,
-	0x43, 0x8b, 0xe6, 0xc6, 0x8d, 0x8f, 0x2e, 0x06, 0x78, 0x45, 0x2b, 0x62, 0xb3, 0xa4, 0x15, 0x4d,
-	0xd9, 0x9b, 0x40, 0xc1, 0xa6, 0xa9, 0xa2, 0xc9, 0x3e, 0xda, 0x81, 0x1b, 0x29, 0x80, 0xd8, 0x24,
-	0x0f, 0xe3, 0x1c, 0x7a, 0x45, 0x6b, 0x98, 0xba, 0xf9, 0x7a, 0x7c, 0x2e, 0xcf, 0x53, 0x01, 0xb1,
-	0x4c, 0xf8, 0x66, 0x2a, 0xa0, 0x8c, 0xb5, 0xa2, 0xa9, 0x59, 0x7a, 0xa5, 0x25, 0x3f, 0x4a, 0x05,
-	0xb4, 0x5b, 0x15, 0x01, 0xb8, 0x88, 0x4f, 0x42, 0x08, 0xa8, 0xe9, 0x86, 0x19, 0xa8, 0x0d, 0xf9,
-	0x6d, 0xb4, 0x01, 0x85, 0x54, 0x17, 0x82, 0xde, 0xdf, 0x4a, 0xa5, 0xe7, 0x51, 0x0f, 0x00, 0xdf,
-	0x46, 0xb7, 0xe0, 0xa9, 0x49, 0x0e, 0x06, 0x67, 0xb5, 0x55, 0xae, 0xe9, 0x5a, 0xc3, 0x94, 0xbf,
-	0x93, 0x0a, 0xe4, 0x8e, 0xc6, 0x81, 0xdf, 0x45, 0x4f, 0x47, 0x93, 0x3c, 0xea, 0x70, 0x0c, 0x66,
-	0xc8, 0xef, 0xa0, 0x9b, 0xb0, 0x9d, 0xea, 0x78, 0x9c, 0xed, 0x7b, 0x12, 0xba, 0x9d, 0x62, 0x97,
-	0x8f, 0x20, 0x8e, 0xfc, 0xbe, 0x84, 0xd6, 0x00, 0x09, 0x64, 0x45, 0x2b, 0xb5, 0xab, 0x56, 0xa5,
-	0x5d, 0x6f, 0xc9, 0x3f, 0x90, 0xd0, 0x8d, 0x28, 0x44, 0x35, 0xbd, 0xac, 0x35, 0xe2, 0xa9, 0xf4,
-	0xc3, 0x54, 0x75, 0x98, 0x26, 0x3f, 0x92, 0xd0, 0x76, 0x14, 0xc2, 0xb0, 0x77, 0xa5, 0x62, 0x71,
-	0x99, 0xfc, 0x63, 0x09, 0x3d, 0x05, 0x9b, 0x49, 0x04, 0x8f, 0x8c, 0x00, 0xfd, 0x24, 0x15, 0xc4,
-	0x87, 0x21, 0x40, 0x3f, 0x95, 0x90, 0x1a, 0xe5, 0xa4, 0x00, 0xd1, 0xd0, 0x71, 0xa1, 0x21, 0xff,
-	0x2c, 0x31, 0x4c, 0x4a, 0x50, 0xac, 0xd5, 0xe4, 0x9f, 0x4b, 0x68, 0x01, 0x72, 0x58, 0x6b, 0x35,
-	0x2d, 0xac, 0x15, 0x2b, 0xf2, 0x07, 0x12, 0x5a, 0x04, 0xa0, 0xed, 0x07, 0x58, 0x37, 0x35, 0xf9,
-	0x77, 0x12, 0x5a, 0x87, 0x65, 0x2a, 0x48, 0xee, 0x8f, 0xbf, 0x97, 0x90, 0x0c, 0x79, 0xaa, 0x62,
-	0x8c, 0xf2, 0x1f, 0x24, 0x54, 0x80, 0x25, 0x2a, 0xd1, 0x1b, 0x46, 0x4b, 0x2b, 0x07, 0xe1, 0xa8,
-	0xd7, 0x75, 0x53, 0xfe, 0xa3, 0x84, 0x56, 0x40, 0xa6, 0x1a, 0xe6, 0x19, 0x13, 0xff, 0x89, 0xfa,
-	0x15, 0xa3, 0x10, 0x8a, 0x3f, 0x47, 0x0a, 0x9e, 0x56, 0x25, 0x5c, 0x6c, 0x94, 0x5f, 0x91, 0xff,
-	0x92, 0x20, 0xe2, 0xe2, 0x0f, 0xc7, 0x88, 0xb8, 0xe2, 0xaf, 0x12, 0x5a, 0x85, 0x6b, 0x23, 0x2e,
-	0x1d, 0xe8, 0x35, 0x4d, 0xfe, 0x9b, 0x84, 0x96, 0x60, 0x21, 0xe2, 0xa1, 0xc2, 0xbf, 0xd3, 0x59,
-	0xa5, 0xc2, 0x60, 0xae, 0x5a, 0x7a, 0x4b, 0xab, 0xe9, 0x0d, 0x8d, 0x86, 0x46, 0xc3, 0xf2, 0x3f,
-	0xe8, 0xac, 0xf2, 0x60, 0xd5, 0x9b, 0xf7, 0xb5, 0x31, 0xc4, 0x3f, 0x27, 0x10, 0xd0, 0x58, 0x62,
-	0xf9, 0x5f, 0xd4, 0x99, 0x50, 0x4a, 0x0d, 0x1f, 0x36, 0x4b, 0xf2, 0xfb, 0x53, 0x77, 0xbe, 0x0a,
-	0xf3, 0xf1, 0x4a, 0x3f, 0x38, 0x60, 0xb0, 0x66, 0x34, 0xdb, 0xb8, 0xac, 0x59, 0xe6, 0xeb, 0x2d,
-	0xcd, 0x8a, 0x8e, 0xac, 0x3c, 0xcc, 0x89, 0xb9, 0x97, 0x50, 0x16, 0xa6, 0x03, 0x73, 0xf2, 0xd4,
-	0xfe, 0x6f, 0x16, 0x20, 0x53, 0x6c, 0xe9, 0xe8, 0x65, 0xc8, 0x8a, 0x47, 0x5b, 0xb4, 0xc2, 0x4e,
-	0xf6, 0xc4, 0x13, 0xb0, 0xb2, 0x9a, 0x14, 0xf3, 0xd3, 0xf6, 0x0a, 0x2a, 0x02, 0x44, 0x2f, 0xb5,
-	0x68, 0x8d, 0xe1, 0xc6, 0x1e, 0x74, 0x95, 0xc2, 0xb8, 0x22, 0xa4, 0x30, 0xe8, 0x8d, 0x68, 0xe4,
-	0xc1, 0x0f, 0xdd, 0xe0, 0x4f, 0x00, 0xe9, 0x6f, 0x8b, 0xca, 0xe6, 0x24, 0x75, 0x9c, 0xd4, 0x98,
-	0x40, 0x6a, 0x3c, 0x99, 0xd4, 0x98, 0x4c, 0x5a, 0x85, 0xf9, 0xf8, 0xeb, 0x1a, 0x5a, 0xe7, 0x61,
-	0x19, 0x7f, 0xdd, 0x53, 0x94, 0x34, 0x55, 0x48, 0xf4, 0x15, 0xc8, 0x85, 0x2f, 0x01, 0x68, 0x35,
-	0x82, 0xc6, 0x5f, 0x25, 0x94, 0xb5, 0x31, 0x79, 0xd8, 0xbf, 0x0e, 0x0b, 0xa3, 0x65, 0x2e, 0xba,
-	0x1e, 0x46, 0x64, 0xbc, 0x60, 0x57, 0x36, 0xd2, 0x95, 0x21, 0x1d, 0x01, 0x65, 0x72, 0x91, 0x8e,
-	0x6e, 0xa5, 0xf5, 0x4e, 0xb9, 0x4e, 0x7f, 0xa4, 0x99, 0x17, 0x60, 0x96, 0xbd, 0x29, 0xa2, 0x25,
-	0x86, 0x1c, 0x79, 0x73, 0x54, 0x96, 0x47, 0x85, 0x61, 0xb7, 0xfb, 0x70, 0x6d, 0xac, 0xe6, 0x45,
-	0x7c, 0xb2, 0x26, 0x15, 0xe2, 0xca, 0xd6, 0x44, 0x7d, 0x22, 0x88, 0x71, 0xd2, 0x28, 0x88, 0x29,
-	0x8c, 0x1b, 0xe9, 0xca, 0x78, 0x72, 0xc4, 0x0b, 0x4f, 0x91, 0x1c, 0x29, 0x35, 0xaa, 0x48, 0x8e,
-	0xb4, 0x3a, 0x55, 0xbd, 0x82, 0x0e, 0xe1, 0xea, 0x48, 0x7d, 0x88, 0x94, 0x98, 0xe5, 0x44, 0xf5,
-	0xa9, 0x5c, 0x4f, 0xd5, 0x85, 0x5c, 0x2d, 0x58, 0x4c, 0xdc, 0x98, 0xd1, 0x86, 0x28, 0xfd, 0xd3,
-	0x6a, 0x4a, 0xe5, 0xc6, 0x04, 0x6d, 0xc8, 0x78, 0x32, 0x56, 0x5e, 0x8a, 0x3b, 0x38, 0xfa, 0x5c,
-	0x6a, 0xdf, 0xc4, 0x05, 0x5f, 0xb9, 0xf9, 0x11, 0xa8, 0xc4, 0x12, 0x1e, 0x29, 0x2f, 0x63, 0x4b,
-	0x38, 0xad, 0x8a, 0x8d, 0x2d, 0xe1, 0xf4, 0xaa, 0x94, 0x06, 0x77, 0xa4, 0x7e, 0x14, 0xc1, 0x4d,
-	0x2b, 0x56, 0x45, 0x70, 0xd3, 0x0b, 0x4e, 0xba, 0x8a, 0xc3, 0x02, 0x51, 0xac, 0xe2, 0x64, 0x0d,
-	0x2a, 0x56, 0xf1, 0x58, 0x25, 0x49, 0x13, 0x7b, 0x25, 0xb5, 0x3c, 0x45, 0x6a, 0xa2, 0x4f, 0xda,
-	0x62, 0x7b, 0x02, 0xef, 0xcb, 0x90, 0x15, 0x25, 0xa6, 0xd8, 0xd0, 0x13, 0xb5, 0xa9, 0xb2, 0x9a,
-	0x14, 0xc7, 0x57, 0xdb, 0x58, 0x45, 0x29, 0x56, 0xdb, 0xa4, 0x32, 0x54, 0xac, 0xb6, 0x89, 0xa5,
-	0x28, 0x9b, 0xcd, 0x64, 0x55, 0x88, 0xc2, 0x64, 0x4b, 0xad, 0x3a, 0xc5, 0x6c, 0x4e, 0x2c, 0x26,
-	0x69, 0x32, 0x4e, 0xa8, 0xe5, 0x44, 0x32, 0x3e, 0xb9, 0x18, 0x14, 0xc9, 0xf8, 0x11, 0x05, 0xa1,
-	0x7a, 0xa5, 0x74, 0xf7, 0x83, 0xcb, 0x4d, 0xe9, 0xc3, 0xcb, 0x4d, 0xe9, 0x3f, 0x97, 0x9b, 0xd2,
-	0xd7, 0xef, 0x1c, 0xdb, 0xfe, 0xc9, 0xf0, 0x68, 0xb7, 0xeb, 0x9c, 0xed, 0x0d, 0x3a, 0xdd, 0x93,
-	0x8b, 0x1e, 0x71, 0xe3, 0x5f, 0xe7, 0xfb, 0x7b, 0x9e, 0xdb, 0xa5, 0xff, 0x94, 0x3d, 0x9a, 0xa5,
-	0xff, 0xff, 0xf8, 0xe2, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x24, 0x77, 0x2b, 0xbb, 0xa8, 0x1d,
-	0x00, 0x00,
+	// 2608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x59, 0x77, 0xdb, 0xc6,
+	0xf5, 0x0f, 0xb4, 0x92, 0x97, 0xb2, 0x04, 0x8f, 0x36, 0x0a, 0x92, 0xb5, 0xc0, 0x7f, 0xc7, 0x8e,
+	0x93, 0xbf, 0x94, 0xaa, 0x4d, 0x8e, 0x9b, 0xf8, 0xf4, 0x94, 0x0b, 0xc4, 0x40, 0xe1, 0xd6, 0x01,
+	0x68, 0x27, 0x7d, 0x41, 0x29, 0x72, 0x2c, 0x21, 0x91, 0x08, 0x06, 0x00, 0x55, 0x2b, 0x5d, 0xd2,
+	0x9e, 0xee, 0x7b, 0x7a, 0xfa, 0x09, 0xfa, 0xda, 0x9e, 0xbc, 0xf4, 0x53, 0xa4, 0x7b, 0xba, 0x3e,
+	0xba, 0x3d, 0xfa, 0x08, 0xfd, 0x04, 0x3d, 0x98, 0x19, 0x80, 0x43, 0x00, 0x74, 0x12, 0x37, 0x2f,
+	0x12, 0xe6, 0xfe, 0x7e, 0x73, 0xef, 0x9d, 0x3b, 0x77, 0x96, 0x3b, 0x84, 0x85, 0xf6, 0xc0, 0x3f,
+	0xd9, 0x0b, 0xfe, 0xec, 0xf6, 0x5d, 0xc7, 0x77, 0xd0, 0x54, 0xf0, 0xad, 0x2c, 0x1d, 0x3b, 0xc7,
+	0x0e, 0x15, 0xec, 0x05, 0x5f, 0x0c, 0x53, 0xb6, 0x8e, 0x1d, 0xe7, 0xf8, 0x94, 0xec, 0xd1, 0xd6,
+	0xd1, 0xe0, 0xc1, 0x9e, 0x6f, 0x9f, 0x11, 0xcf, 0x6f, 0x9f, 0xf5, 0x19, 0x41, 0xbd, 0x0b, 0x0b,
+	0x85, 0x8e, 0x6f, 0x9f, 0xb7, 0x7d, 0x82, 0xc9, 0x5b, 0x03, 0xe2, 0xf9, 0xe8, 0x1a, 0x80, 0xeb,
+	0x38, 0xbe, 0xe5, 0x3b, 0x6f, 0x92, 0x5e, 0x7e, 0x72, 0x5b, 0xba, 0x95, 0xc5, 0xd9, 0x40, 0x62,
+	0x06, 0x82, 0xc3, 0xa9, 0x8c, 0x24, 0x4f, 0x1c, 0x4e, 0x65, 0x26, 0xe4, 0x49, 0xf5, 0x53, 0x20,
+	0x0f, 0x7b, 0x7b, 0x7d, 0xa7, 0xe7, 0x91, 0xa0, 0x7b, 0xbf, 0xdd, 0x39, 0xe1, 0xdd, 0x25, 0xd6,
+	0x3d, 0x90, 0xd0, 0xee, 0xea, 0x22, 0x5c, 0x2d, 0x93, 0xf6, 0xa8, 0x49, 0x75, 0x09, 0x90, 0x28,
+	0x64, 0x9a, 0xd4, 0x5f, 0x4e, 0x00, 0x34, 0xf4, 0x72, 0xa9, 0xe4, 0xf4, 0x1e, 0xd8, 0xc7, 0x68,
+	0x05, 0x66, 0x6c, 0xcf, 0x1b, 0x10, 0x97, 0x2b, 0xe5, 0x2d, 0xf4, 0x0c, 0x64, 0x3b, 0xa7, 0x36,
+	0xe9, 0xf9, 0x96, 0xdd, 0xcd, 0x4f, 0x04, 0x50, 0x71, 0xee, 0xf2, 0xd1, 0x56, 0xa6, 0x44, 0x85,
+	0x7a, 0x19, 0x67, 0x18, 0xac, 0x77, 0xd1, 0x75, 0xb8, 0xc2, 0xa9, 0x1e, 0xe9, 0xb8, 0xc4, 0xe7,
+	0xa3, 0x9b, 0x63, 0x42, 0x83, 0xca, 0xd0, 0x3e, 0xcc, 0xb9, 0xa4, 0x6b, 0xbb, 0xa4, 0xe3, 0x5b,
+	0x03, 0xd7, 0xce, 0x4f, 0x51, 0x95, 0x0b, 0x97, 0x8f, 0xb6, 0x72, 0x98, 0xcb, 0x5b, 0x58, 0xc7,
+	0xb9, 0x90, 0xd4, 0x72, 0xed, 0xc0, 0x37, 0xaf, 0xe3, 0xf4, 0x89, 0x97, 0x9f, 0xde, 0x9e, 0x0c,
+	0x7c, 0x63, 0x2d, 0xf4, 0x19, 0x58, 0x71, 0xc9, 0x5b, 0x03, 0xdb, 0x25, 0x16, 0x39, 0x6b, 0xdb,
+	0xa7, 0xd6, 0x39, 0x71, 0xed, 0x07, 0x36, 0xe9, 0xe6, 0x67, 0xb6, 0xa5, 0x5b, 0x19, 0xbc, 0xc4,
+	0x51, 0x2d, 0x00, 0xef, 0x71, 0x0c, 0x3d, 0x03, 0xf2, 0xa9, 0xd3, 0x69, 0x9f, 0x9e, 0x38, 0x9e,
+	0x6f, 0xf1, 0x31, 0xcf, 0x52, 0xfe, 0x42, 0x24, 0xd7, 0xa9, 0x58, 0x5d, 0x83, 0xd5, 0x0a, 0xf1,
+	0x59, 0x84, 0x06, 0x6e, 0xdb, 0xb7, 0x9d, 0x5e, 0x18, 0x54, 0x0c, 0xf9, 0x24, 0xc4, 0x27, 0xe9,
+	0x45, 0xb8, 0xd2, 0x11, 0x01, 0x1a, 0xd2, 0xdc, 0xbe, 0xbc, 0x4b, 0xf3, 0x6a, 0x18, 0x74, 0x3c,
+	0x4a, 0x53, 0xbf, 0x00, 0xab, 0x46, 0xba, 0xb9, 0x27, 0x56, 0xa9, 0x40, 0xde, 0x18, 0xe3, 0xa6,
+	0xfa, 0x1b, 0x09, 0xb2, 0x34, 0x6d, 0xf4, 0xde, 0x03, 0x07, 0xe5, 0x61, 0xd6, 0x1b, 0x1c, 0xbd,
+	0x41, 0x3a, 0x3e, 0xcf, 0x80, 0xb0, 0x89, 0x0c, 0x00, 0xf2, 0xb0, 0x6f, 0x73, 0xc3, 0x13, 0xd4,
+	0xb0, 0xb2, 0xcb, 0x72, 0x7f, 0x37, 0xcc, 0xfd, 0x5d, 0x33, 0xcc, 0xfd, 0xe2, 0xea, 0x7f, 0x1e,
+	0x6d, 0x2d, 0x74, 0x8f, 0x5e, 0x52, 0x87, 0xbd, 0xd4, 0x77, 0xff, 0xb5, 0x25, 0x61, 0x41, 0x0d,
+	0x7a, 0x11, 0xe6, 0x4e, 0xda, 0xde, 0x09, 0xe9, 0x8a, 0x2b, 0xa1, 0xb8, 0x18, 0x76, 0xa5, 0x42,
+	0x2b, 0x60, 0xa8, 0x38, 0xc7, 0x88, 0x2c, 0xc3, 0xdf, 0x80, 0xc5, 0xc2, 0xc0, 0x3f, 0x21, 0x3d,
+	0xdf, 0xee, 0x08, 0xcb, 0xea, 0x39, 0x00, 0xc7, 0xee, 0x76, 0x2c, 0xcf, 0x6f, 0xfb, 0x84, 0x2b,
+	0xbb, 0x72, 0xf9, 0x68, 0x2b, 0x1b, 0x84, 0xc6, 0x08, 0x84, 0x38, 0x1b, 0x10, 0xe8, 0x27, 0x5a,
+	0x83, 0x8c, 0x1d, 0x1a, 0x9e, 0x62, 0x83, 0xb5, 0xbb, 0xc9, 0x05, 0xf8, 0x02, 0x2c, 0x8d, 0xda,
+	0xfa, 0x68, 0x8b, 0x70, 0x01, 0xae, 0xdc, 0x3f, 0x71, 0x0a, 0x67, 0x7a, 0x98, 0x2b, 0xbf, 0x90,
+	0x60, 0x3e, 0x94, 0x70, 0x15, 0x0a, 0x64, 0x06, 0x1e, 0x71, 0x7b, 0xed, 0x33, 0xc2, 0x15, 0x44,
+	0xed, 0x58, 0xbc, 0xa7, 0x3f, 0x91, 0x78, 0xb3, 0x11, 0x1d, 0x4e, 0x65, 0x26, 0xe5, 0xa9, 0xc3,
+	0xa9, 0xcc, 0x94, 0x3c, 0xad, 0x3a, 0x30, 0x8d, 0x9d, 0x53, 0xe2, 0xa1, 0xe7, 0x60, 0xda, 0x0d,
+	0x3e, 0xf2, 0xd2, 0xf6, 0xe4, 0xad, 0xdc, 0xfe, 0x0a, 0xcb, 0x29, 0x8a, 0xb1, 0xbf, 0x5a, 0xcf,
+	0x77, 0x2f, 0x30, 0x23, 0x29, 0x77, 0x00, 0x86, 0x42, 0x24, 0xc3, 0xe4, 0x9b, 0xe4, 0x82, 0x0f,
+	0x21, 0xf8, 0x44, 0x4b, 0x30, 0x7d, 0xde, 0x3e, 0x1d, 0x10, 0x9a, 0x28, 0x19, 0xcc, 0x1a, 0x2f,
+	0x4d, 0xdc, 0x91, 0xd4, 0x77, 0x25, 0xc8, 0x05, 0x5d, 0x8b, 0x76, 0xaf, 0x6b, 0xf7, 0x8e, 0xd1,
+	0x1d, 0x98, 0x25, 0x3d, 0xdf, 0xb5, 0x23, 0xcb, 0x9b, 0x43, 0xcb, 0x9c, 0xb3, 0xab, 0x31, 0x02,
+	0xf3, 0x20, 0xa4, 0x2b, 0x15, 0x98, 0x13, 0x81, 0x14, 0x2f, 0x76, 0x44, 0x2f, 0x72, 0xfb, 0x39,
+	0x61, 0x4c, 0xa2, 0x4b, 0x07, 0x90, 0xc1, 0xc4, 0x73, 0x06, 0x6e, 0x87, 0xa0, 0xa7, 0x61, 0xca,
+	0xbf, 0xe8, 0xb3, 0xe9, 0x98, 0xdf, 0x47, 0xbc, 0x07, 0x47, 0xcd, 0x8b, 0x3e, 0xc1, 0x14, 0x47,
+	0x08, 0xa6, 0xe8, 0xb4, 0xd1, 0xcd, 0x10, 0xd3, 0x6f, 0xf5, 0x1d, 0x98, 0x6e, 0x79, 0xc4, 0xf5,
+	0xd0, 0x1d, 0xc8, 0x86, 0xf3, 0x18, 0x8e, 0x4a, 0x61, 0x9a, 0x28, 0xbe, 0xdb, 0x0a, 0x41, 0x36,
+	0xa2, 0x21, 0x59, 0xb9, 0x0b, 0xf3, 0xa3, 0xe0, 0xc7, 0x8a, 0xed, 0x00, 0x66, 0x2a, 0xae, 0x33,
+	0xe8, 0x7b, 0xe8, 0x79, 0x98, 0x39, 0xa6, 0x5f, 0xdc, 0x7c, 0x9e, 0x99, 0x67, 0x28, 0xff, 0xc7,
+	0x8c, 0x73, 0x9e, 0xf2, 0x59, 0xc8, 0x09, 0xe2, 0x8f, 0x65, 0xf6, 0x21, 0xc8, 0xc1, 0x0a, 0x71,
+	0x5c, 0xfb, 0xed, 0x68, 0x29, 0xde, 0x86, 0x8c, 0xcb, 0xa3, 0xc6, 0x77, 0xa9, 0xf9, 0xd1, 0x58,
+	0xe2, 0x08, 0x47, 0xfb, 0x90, 0xeb, 0x13, 0xf7, 0xcc, 0xf6, 0x3c, 0xdb, 0xe9, 0x79, 0xf9, 0xc9,
+	0xed, 0xc9, 0x5b, 0xf3, 0xe1, 0xa6, 0xd6, 0x8c, 0x00, 0x2c, 0x92, 0xf8, 0xda, 0x7c, 0x4f, 0x82,
+	0xab, 0x82, 0x69, 0xbe, 0xac, 0x36, 0x01, 0xda, 0xa1, 0xb0, 0x4b, 0xad, 0x67, 0xb0, 0x20, 0x41,
+	0xbb, 0x90, 0xf5, 0xda, 0xbe, 0xed, 0xd1, 0x43, 0x62, 0x62, 0x8c, 0xb5, 0x21, 0x05, 0xdd, 0x86,
+	0x59, 0x2a, 0xed, 0x1d, 0x8f, 0xf5, 0x2d, 0x24, 0xa0, 0x0d, 0xc8, 0xf6, 0x5d, 0xbb, 0xd7, 0xb1,
+	0xfb, 0xed, 0x53, 0xbe, 0xab, 0x0c, 0x05, 0x6a, 0x09, 0x96, 0x2b, 0xc4, 0x1f, 0xf6, 0xf3, 0x9e,
+	0x20, 0x5c, 0xea, 0x19, 0xec, 0x8c, 0x2a, 0x39, 0x70, 0xdc, 0x66, 0x68, 0xe2, 0x49, 0xe2, 0x3f,
+	0xe2, 0xf3, 0x44, 0xdc, 0xe7, 0x23, 0x58, 0x89, 0xfb, 0xcc, 0xe3, 0x1c, 0x9b, 0x37, 0xe9, 0x23,
+	0xcc, 0x5b, 0x90, 0x45, 0x6c, 0x9b, 0x99, 0xa0, 0x87, 0x38, 0x6b, 0xa8, 0x6f, 0x43, 0xbe, 0xe6,
+	0x74, 0xed, 0x07, 0x17, 0xc2, 0xaa, 0xff, 0xc4, 0x47, 0x32, 0xb4, 0x3d, 0x29, 0xda, 0x5e, 0x87,
+	0xb5, 0x14, 0xdb, 0xfc, 0x74, 0x64, 0x13, 0xf6, 0xbf, 0x79, 0xa5, 0x6a, 0xb0, 0x12, 0x57, 0xc2,
+	0x23, 0xf8, 0x2c, 0xcc, 0x1e, 0x31, 0x11, 0x57, 0x72, 0x35, 0xb1, 0xf9, 0xe1, 0x90, 0xa1, 0x7e,
+	0x09, 0x72, 0x06, 0xa1, 0x61, 0xa4, 0x47, 0xf5, 0x12, 0x4c, 0xf7, 0x9c, 0x5e, 0x27, 0x3c, 0x39,
+	0x58, 0x23, 0x90, 0xd2, 0x5b, 0x10, 0x1f, 0x3d, 0x6b, 0xa0, 0x1b, 0x30, 0xdf, 0x71, 0x7a, 0xe7,
+	0xc4, 0x0d, 0x7a, 0x5b, 0xc4, 0x75, 0xe9, 0xe1, 0x98, 0xc1, 0x57, 0x86, 0x52, 0xcd, 0x75, 0xd5,
+	0x65, 0x58, 0xac, 0x10, 0x3f, 0x38, 0x2c, 0xab, 0xce, 0xb1, 0x1d, 0xdd, 0x72, 0xee, 0xc3, 0xd2,
+	0xa8, 0x98, 0x7b, 0xff, 0x0c, 0x64, 0x4f, 0x03, 0x81, 0x35, 0x70, 0x4f, 0xf3, 0xd2, 0xf0, 0x56,
+	0x48, 0x59, 0x2d, 0x5c, 0xc5, 0x19, 0x0a, 0xb7, 0x5c, 0x1a, 0x7a, 0x76, 0x28, 0x73, 0xb7, 0x68,
+	0x43, 0xad, 0x50, 0xc5, 0xd8, 0x39, 0xe2, 0x17, 0xdf, 0x30, 0xb8, 0x74, 0xa2, 0x8e, 0x9c, 0xf0,
+	0x0e, 0xc2, 0x1a, 0x68, 0x0d, 0x26, 0x7d, 0x9f, 0x0d, 0x6c, 0xb2, 0x38, 0x7b, 0xf9, 0x68, 0x6b,
+	0xd2, 0x34, 0xab, 0x38, 0x90, 0xa9, 0xff, 0x0f, 0xcb, 0x31, 0x45, 0xdc, 0xc5, 0x25, 0x98, 0x16,
+	0xcf, 0x67, 0xd6, 0x50, 0x77, 0x61, 0x05, 0x93, 0x73, 0xe7, 0x4d, 0x12, 0xec, 0x1d, 0x71, 0xcb,
+	0x29, 0xfc, 0x35, 0x58, 0x4d, 0xf0, 0x79, 0x82, 0xd4, 0xe8, 0x6d, 0x8d, 0xed, 0x9c, 0x07, 0x8e,
+	0x1b, 0x6c, 0xde, 0xa1, 0xae, 0xc7, 0x9d, 0xee, 0x2b, 0xd1, 0xfe, 0xcc, 0xd6, 0x01, 0x6f, 0xf1,
+	0x9b, 0x5a, 0x4c, 0x1d, 0x37, 0x75, 0x0f, 0x96, 0x58, 0xa2, 0xd6, 0xc8, 0xd9, 0x11, 0x71, 0x3d,
+	0xc1, 0x67, 0xda, 0x3b, 0xf4, 0x99, 0x36, 0x82, 0x0d, 0xbc, 0xdd, 0xed, 0x72, 0xf5, 0xc1, 0x67,
+	0x60, 0xd3, 0x25, 0x67, 0xce, 0x39, 0xe1, 0xf9, 0xcf, 0x5b, 0xea, 0x2a, 0x2c, 0xc7, 0xf4, 0x72,
+	0x83, 0x08, 0xe4, 0x4a, 0xe8, 0x4c, 0x98, 0x0b, 0x77, 0x61, 0xa3, 0x22, 0x38, 0x98, 0xd8, 0x77,
+	0x46, 0x56, 0xa0, 0x14, 0xdf, 0x4b, 0x9e, 0x85, 0xab, 0x82, 0x46, 0x3e, 0x47, 0x2b, 0x23, 0x67,
+	0xd5, 0x30, 0x16, 0x37, 0x61, 0xa1, 0x42, 0x7c, 0x7a, 0x62, 0x3e, 0x76, 0xa8, 0xea, 0xf3, 0x20,
+	0x0f, 0x89, 0x5c, 0xe9, 0x46, 0xfc, 0x08, 0xce, 0x0a, 0xc7, 0x6c, 0x10, 0x66, 0xed, 0xa1, 0xef,
+	0xb6, 0x3b, 0x7e, 0x34, 0xa3, 0xd1, 0x08, 0x0f, 0x61, 0x2d, 0x05, 0xe3, 0x6a, 0x6f, 0xc2, 0x0c,
+	0x4d, 0x09, 0x36, 0x6f, 0xb9, 0xfd, 0x05, 0xb6, 0x5e, 0xa3, 0x0b, 0x34, 0xe6, 0x30, 0xbb, 0x41,
+	0xaa, 0x07, 0x41, 0xe2, 0x78, 0xbe, 0xe3, 0x26, 0x33, 0xed, 0x46, 0x98, 0x69, 0xec, 0x6e, 0x92,
+	0x50, 0xc4, 0x50, 0xae, 0x47, 0x81, 0x7c, 0x52, 0x0f, 0x9f, 0xa5, 0xbb, 0xb0, 0x19, 0x4b, 0xce,
+	0x8f, 0x91, 0x88, 0xea, 0x0e, 0x6c, 0x8d, 0xed, 0xcd, 0x0d, 0x6c, 0xc3, 0x66, 0x99, 0x9c, 0x12,
+	0x9f, 0x68, 0xc1, 0x45, 0x92, 0x74, 0x93, 0x21, 0xdb, 0x81, 0xad, 0xb1, 0x0c, 0xa6, 0xe4, 0xf6,
+	0xaf, 0xe7, 0x01, 0x86, 0x67, 0x02, 0xca, 0xc1, 0x6c, 0xab, 0xfe, 0x6a, 0xbd, 0x71, 0xbf, 0x2e,
+	0x3f, 0x85, 0xd6, 0x61, 0xb5, 0x54, 0x6d, 0x19, 0xa6, 0x86, 0xad, 0x5a, 0xa3, 0xac, 0x1f, 0xbc,
+	0x6e, 0x15, 0xf5, 0x7a, 0x59, 0xaf, 0x57, 0x0c, 0xb9, 0x8b, 0xf2, 0xb0, 0x14, 0x82, 0x15, 0xcd,
+	0x1c, 0x22, 0xc1, 0xfd, 0x7d, 0x39, 0x44, 0x0a, 0x2d, 0xf3, 0x15, 0xab, 0x50, 0x32, 0xf5, 0x7b,
+	0x05, 0x53, 0x93, 0x1f, 0x88, 0x1a, 0x29, 0x54, 0xd6, 0x22, 0xf0, 0x38, 0x01, 0x06, 0x6a, 0x4b,
+	0x8d, 0xfa, 0x81, 0x5e, 0x91, 0x4f, 0x12, 0xa0, 0x31, 0x04, 0x6d, 0xb4, 0x03, 0x1b, 0x89, 0x9e,
+	0xb8, 0x51, 0x6c, 0x98, 0x96, 0xd9, 0x78, 0x55, 0xab, 0xcb, 0x3f, 0x92, 0xd0, 0x0d, 0xd8, 0x19,
+	0xa1, 0xf0, 0x01, 0x55, 0x70, 0xa3, 0xd5, 0xb4, 0x6a, 0x5a, 0xad, 0xa8, 0x61, 0x43, 0x3e, 0x4b,
+	0xf5, 0x81, 0x72, 0x0c, 0xb9, 0x87, 0xb6, 0x61, 0x23, 0x1d, 0xb4, 0x5a, 0x46, 0xd0, 0xdd, 0x41,
+	0x5b, 0xb0, 0x3e, 0xc2, 0xd0, 0x5e, 0x33, 0x71, 0xa1, 0xc4, 0xdd, 0x30, 0xe4, 0x3e, 0xda, 0x04,
+	0x65, 0x84, 0x80, 0x35, 0xc3, 0x6c, 0x60, 0x8d, 0xfb, 0xf9, 0x16, 0xda, 0x83, 0xdb, 0x09, 0x13,
+	0x4d, 0x0d, 0xd7, 0x74, 0xc3, 0xd0, 0x1b, 0x75, 0xc3, 0x3a, 0x68, 0x60, 0xab, 0x89, 0xf5, 0x7a,
+	0x49, 0x6f, 0x16, 0xaa, 0xf2, 0x4f, 0x24, 0x74, 0x13, 0xd4, 0x58, 0x44, 0xab, 0x9a, 0xa9, 0x59,
+	0xda, 0x6b, 0x4d, 0x1d, 0x6b, 0xe5, 0xd0, 0xf0, 0x8f, 0x25, 0xd1, 0x35, 0xad, 0x6e, 0x6a, 0xb8,
+	0x89, 0x75, 0x43, 0x1b, 0xce, 0x8d, 0x2b, 0x8e, 0x4e, 0x20, 0xbc, 0xa2, 0x15, 0xb0, 0x59, 0xd4,
+	0x0a, 0xa6, 0xec, 0x8d, 0x51, 0xc1, 0xa6, 0xa9, 0xac, 0xc9, 0x3e, 0xda, 0x81, 0x6b, 0x29, 0x04,
+	0x61, 0x92, 0x07, 0xa2, 0x0e, 0xbd, 0xac, 0xd5, 0x4d, 0xdd, 0x7c, 0x5d, 0x9c, 0xcb, 0xf3, 0x54,
+	0x82, 0x90, 0x09, 0x5f, 0x4e, 0x25, 0x94, 0xb0, 0x56, 0x30, 0x35, 0x4b, 0x2f, 0x37, 0xe5, 0x87,
+	0xa9, 0x84, 0x56, 0xb3, 0x1c, 0x12, 0x2e, 0xc4, 0x49, 0x88, 0x08, 0x55, 0xdd, 0x30, 0x03, 0xd8,
+	0x90, 0xdf, 0x46, 0x1b, 0x90, 0x4f, 0x75, 0x21, 0xe8, 0xfd, 0x95, 0x54, 0xf5, 0x3c, 0xea, 0x01,
+	0xe1, 0xab, 0xe8, 0x26, 0x5c, 0x1f, 0xe7, 0x60, 0x70, 0x56, 0x5b, 0xa5, 0xaa, 0xae, 0xd5, 0x4d,
+	0xf9, 0x6b, 0xa9, 0x44, 0xee, 0xa8, 0x48, 0xfc, 0x3a, 0x7a, 0x1a, 0xd4, 0x04, 0x91, 0x3a, 0x2c,
+	0xd0, 0x0c, 0xf9, 0x1d, 0x74, 0x03, 0xb6, 0x53, 0x1d, 0x17, 0xb5, 0x7d, 0x43, 0x42, 0xb7, 0xe0,
+	0xfa, 0xb8, 0x11, 0x88, 0xcc, 0x6f, 0x4a, 0x68, 0x1b, 0xd6, 0xd3, 0x0d, 0xb3, 0x84, 0xff, 0xa9,
+	0x84, 0x54, 0xb8, 0x96, 0x60, 0x94, 0x75, 0xa3, 0x50, 0xac, 0x6a, 0x94, 0x24, 0xff, 0x4c, 0x12,
+	0x97, 0x67, 0xdc, 0x1e, 0xa5, 0xbc, 0x2b, 0xa1, 0x55, 0x40, 0x21, 0xa5, 0xac, 0x15, 0x5b, 0x15,
+	0xab, 0xdc, 0xaa, 0x35, 0xe5, 0x6f, 0x49, 0xe8, 0xda, 0x70, 0x2e, 0xaa, 0x7a, 0x49, 0xab, 0x8b,
+	0x39, 0xfb, 0xed, 0x54, 0x38, 0xca, 0xc7, 0xef, 0x8c, 0xf8, 0x1f, 0xf5, 0x2e, 0x97, 0x2d, 0x2e,
+	0x93, 0xbf, 0x2b, 0xa1, 0xeb, 0xb0, 0x19, 0x67, 0xf0, 0x29, 0x08, 0x49, 0xdf, 0x4b, 0x25, 0x71,
+	0xff, 0x43, 0xd2, 0xf7, 0x47, 0x22, 0x11, 0x92, 0x68, 0xa8, 0xb8, 0xd0, 0x90, 0x7f, 0x10, 0x2c,
+	0x42, 0x25, 0xce, 0x09, 0x92, 0xbf, 0xaa, 0xd7, 0x74, 0xd3, 0x90, 0x7f, 0x1e, 0x8b, 0x03, 0xb5,
+	0x50, 0xa8, 0x56, 0xe5, 0x1f, 0x4a, 0x68, 0x1e, 0xb2, 0x58, 0x6b, 0x36, 0x2c, 0xac, 0x15, 0xca,
+	0xf2, 0xfb, 0x12, 0x5a, 0x00, 0xa0, 0xed, 0xfb, 0x58, 0x37, 0x35, 0xf9, 0xb7, 0x12, 0x5a, 0x83,
+	0x25, 0x2a, 0x88, 0xef, 0xd4, 0xbf, 0x93, 0x90, 0x0c, 0x39, 0x0a, 0x31, 0x8d, 0xf2, 0xef, 0x25,
+	0x94, 0x87, 0x45, 0x2a, 0xd1, 0xeb, 0x46, 0x53, 0x2b, 0x05, 0xf1, 0xaa, 0xd5, 0x74, 0x53, 0xfe,
+	0x83, 0x84, 0x96, 0x41, 0xa6, 0x08, 0x73, 0x9d, 0x89, 0xff, 0x48, 0xfd, 0x12, 0x54, 0x84, 0xc0,
+	0x9f, 0x86, 0x00, 0x4f, 0xf0, 0x22, 0x2e, 0xd4, 0x4b, 0xaf, 0xc8, 0x7f, 0x8e, 0x29, 0xe2, 0xe2,
+	0x0f, 0x12, 0x8a, 0x38, 0xf0, 0x17, 0x09, 0xad, 0xc0, 0xd5, 0x11, 0x97, 0x0e, 0xf4, 0xaa, 0x26,
+	0xff, 0x55, 0x42, 0x8b, 0x30, 0x3f, 0xd4, 0x43, 0x85, 0x7f, 0xa3, 0xd3, 0x4e, 0x85, 0xc1, 0x64,
+	0x36, 0xf5, 0xa6, 0x56, 0xd5, 0xeb, 0x1a, 0x0d, 0x8d, 0x86, 0xe5, 0xbf, 0xd3, 0x69, 0xe7, 0xc1,
+	0xaa, 0x35, 0xee, 0x69, 0x09, 0xc6, 0x3f, 0xc6, 0x28, 0xa0, 0xb1, 0xc4, 0xf2, 0x3f, 0xa9, 0x33,
+	0x91, 0x94, 0x1a, 0x3e, 0x6c, 0x14, 0xe5, 0xf7, 0x26, 0x6e, 0x7f, 0x1e, 0xe6, 0xc4, 0x37, 0x87,
+	0xe0, 0xa8, 0xc3, 0x9a, 0xd1, 0x68, 0xe1, 0x92, 0x66, 0x99, 0xaf, 0x37, 0x35, 0x6b, 0x78, 0x78,
+	0xe6, 0x60, 0x36, 0x4c, 0x0e, 0x09, 0x65, 0x60, 0x2a, 0x30, 0x27, 0x4f, 0xec, 0xff, 0x6a, 0x1e,
+	0x26, 0x0b, 0x4d, 0x1d, 0xbd, 0x0c, 0x99, 0xf0, 0xf9, 0x18, 0x2d, 0xb3, 0x3b, 0x46, 0xec, 0x31,
+	0x5a, 0x59, 0x89, 0x8b, 0xf9, 0xb9, 0xff, 0x14, 0x2a, 0x00, 0x0c, 0xdf, 0x8c, 0xd1, 0x2a, 0xe3,
+	0x25, 0x9e, 0x96, 0x95, 0x7c, 0x12, 0x88, 0x54, 0x18, 0xf4, 0x6e, 0x36, 0xf2, 0xf4, 0x88, 0xae,
+	0x31, 0xfe, 0x98, 0x47, 0x55, 0x65, 0x73, 0x1c, 0x2c, 0x2a, 0x35, 0xc6, 0x28, 0x35, 0x1e, 0xaf,
+	0xd4, 0x18, 0xaf, 0xb4, 0x02, 0x73, 0xe2, 0x3b, 0x1f, 0x5a, 0xe3, 0x61, 0x49, 0xbe, 0x33, 0x2a,
+	0x4a, 0x1a, 0x14, 0x29, 0xfa, 0x1c, 0x64, 0xa3, 0x37, 0x09, 0xb4, 0x32, 0xa4, 0x8a, 0xef, 0x23,
+	0xca, 0x6a, 0x42, 0x1e, 0xf5, 0xaf, 0xc1, 0xfc, 0x68, 0xc1, 0x8d, 0xd6, 0xa3, 0x88, 0x24, 0x9f,
+	0x0e, 0x94, 0x8d, 0x74, 0x30, 0x52, 0x47, 0x40, 0x19, 0xff, 0x5c, 0x80, 0x6e, 0xa6, 0xf5, 0x4e,
+	0xb9, 0xd8, 0x7f, 0xa8, 0x99, 0x17, 0x60, 0x86, 0xbd, 0x6e, 0xa2, 0x45, 0xc6, 0x1c, 0x79, 0xfd,
+	0x54, 0x96, 0x46, 0x85, 0x51, 0xb7, 0x7b, 0x70, 0x35, 0x51, 0x7d, 0x23, 0x3e, 0x59, 0xe3, 0x9e,
+	0x04, 0x94, 0xad, 0xb1, 0x78, 0x2c, 0x88, 0xa2, 0xd2, 0x61, 0x10, 0x53, 0x34, 0x6e, 0xa4, 0x83,
+	0x62, 0x72, 0x88, 0x25, 0x70, 0x98, 0x1c, 0x29, 0xd5, 0xb2, 0xa2, 0xa4, 0x41, 0x91, 0xa2, 0x43,
+	0xb8, 0x32, 0x52, 0xa9, 0x22, 0x45, 0xb0, 0x1c, 0xab, 0x83, 0x95, 0xf5, 0x54, 0x2c, 0xd2, 0xd5,
+	0x84, 0x85, 0xd8, 0xdd, 0x1d, 0x6d, 0x84, 0x8f, 0x10, 0x69, 0xd5, 0xad, 0x72, 0x6d, 0x0c, 0x1a,
+	0x69, 0x3c, 0x49, 0x14, 0xba, 0x61, 0x35, 0x80, 0xfe, 0x2f, 0xb5, 0x6f, 0xac, 0xd4, 0x50, 0x6e,
+	0x7c, 0x08, 0x2b, 0xb6, 0x84, 0x47, 0x0a, 0x5d, 0x61, 0x09, 0xa7, 0xd5, 0xd3, 0xca, 0xe6, 0x38,
+	0x58, 0x0c, 0xee, 0x48, 0x25, 0x1b, 0x06, 0x37, 0xad, 0x6c, 0x56, 0xd6, 0x53, 0x31, 0x71, 0x15,
+	0x47, 0xa5, 0x6a, 0xb8, 0x8a, 0xe3, 0xd5, 0xb0, 0xb2, 0x9a, 0x90, 0x0b, 0x89, 0xbd, 0x9c, 0x5a,
+	0x28, 0x23, 0x35, 0xd6, 0x27, 0x6d, 0xb1, 0x3d, 0x46, 0xef, 0xcb, 0x90, 0x09, 0x8b, 0xdd, 0x70,
+	0x43, 0x8f, 0x55, 0xc9, 0xca, 0x4a, 0x5c, 0x2c, 0xae, 0xb6, 0x44, 0x6d, 0x1b, 0xae, 0xb6, 0x71,
+	0x05, 0xb1, 0xb2, 0x35, 0x16, 0x17, 0x67, 0x33, 0x5e, 0x9f, 0xa2, 0x28, 0xd9, 0x52, 0xeb, 0x5f,
+	0x65, 0x73, 0x1c, 0x2c, 0x26, 0xe3, 0x98, 0xaa, 0x32, 0x4c, 0xc6, 0xc7, 0x97, 0xa5, 0xca, 0x8d,
+	0x0f, 0x61, 0x85, 0x96, 0x8a, 0x77, 0xde, 0xbf, 0xdc, 0x94, 0x3e, 0xb8, 0xdc, 0x94, 0xfe, 0x7d,
+	0xb9, 0x29, 0x7d, 0xf1, 0xf6, 0xb1, 0xed, 0x9f, 0x0c, 0x8e, 0x76, 0x3b, 0xce, 0xd9, 0x5e, 0xf0,
+	0x7b, 0xce, 0x45, 0x97, 0xb8, 0xe2, 0xd7, 0xf9, 0xfe, 0x9e, 0xe7, 0x76, 0xe8, 0xcf, 0xc3, 0x47,
+	0x33, 0xf4, 0x97, 0x98, 0x4f, 0xff, 0x77, 0x00, 0xb6, 0x25, 0xef, 0x2d, 0x32, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
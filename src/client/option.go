@@ -1,6 +1,10 @@
 package client
 
-import "github.com/pachyderm/pachyderm/v2/src/pfs"
+import (
+	"os"
+
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
 
 // PutFileOption configures a PutFile call.
 type PutFileOption func(*pfs.PutFile)
@@ -19,6 +23,22 @@ func WithTagPutFile(tag string) PutFileOption {
 	}
 }
 
+// WithMetadataPutFile configures the PutFile call to set user-defined metadata
+// on the written files.
+func WithMetadataPutFile(metadata map[string]string) PutFileOption {
+	return func(pf *pfs.PutFile) {
+		pf.Metadata = metadata
+	}
+}
+
+// WithModePutFile configures the PutFile call to set the permission bits of
+// the written files.
+func WithModePutFile(mode os.FileMode) PutFileOption {
+	return func(pf *pfs.PutFile) {
+		pf.Mode = uint32(mode.Perm())
+	}
+}
+
 // DeleteFileOption configures a DeleteFile call.
 type DeleteFileOption func(*pfs.DeleteFile)
 
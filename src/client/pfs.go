@@ -24,6 +24,14 @@ func NewBranch(repoName string, branchName string) *pfs.Branch {
 	}
 }
 
+// NewTag creates a pfs.Tag.
+func NewTag(repoName string, tagName string) *pfs.Tag {
+	return &pfs.Tag{
+		Repo: NewRepo(repoName),
+		Name: tagName,
+	}
+}
+
 // NewCommit creates a pfs.Commit.
 func NewCommit(repoName string, commitID string) *pfs.Commit {
 	return &pfs.Commit{
@@ -74,6 +82,23 @@ func (c APIClient) UpdateRepo(repoName string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ForkRepo creates a new repo whose first commit, on branch "master", has the
+// same contents as the given commit. No data is copied, and the two repos have
+// independent histories afterwards.
+func (c APIClient) ForkRepo(sourceRepo string, sourceCommit string, repoName string) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.ForkRepo(
+		c.Ctx(),
+		&pfs.ForkRepoRequest{
+			Source: NewCommit(sourceRepo, sourceCommit),
+			Repo:   NewRepo(repoName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commit, nil
+}
+
 // InspectRepo returns info about a specific Repo.
 func (c APIClient) InspectRepo(repoName string) (*pfs.RepoInfo, error) {
 	resp, err := c.PfsAPIClient.InspectRepo(
@@ -93,7 +118,13 @@ func (c APIClient) InspectRepo(repoName string) (*pfs.RepoInfo, error) {
 // the specified repos as provenance will be returned unless provenance is nil
 // in which case it is ignored.
 func (c APIClient) ListRepo() ([]*pfs.RepoInfo, error) {
-	request := &pfs.ListRepoRequest{}
+	return c.ListRepoByLabels(nil)
+}
+
+// ListRepoByLabels returns info about the repos which have all of the labels
+// in selector. A nil selector matches every repo.
+func (c APIClient) ListRepoByLabels(selector map[string]string) ([]*pfs.RepoInfo, error) {
+	request := &pfs.ListRepoRequest{LabelSelector: selector}
 	repoInfos, err := c.PfsAPIClient.ListRepo(
 		c.Ctx(),
 		request,
@@ -123,6 +154,20 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RenameRepo renames a Repo. Its commits, branches, provenance and role
+// bindings are kept, and pipelines which read from it are updated to read
+// from the new name.
+func (c APIClient) RenameRepo(repoName string, newName string) error {
+	_, err := c.PfsAPIClient.RenameRepo(
+		c.Ctx(),
+		&pfs.RenameRepoRequest{
+			Repo:    NewRepo(repoName),
+			NewName: newName,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -252,11 +297,18 @@ func (c APIClient) ListCommit(repoName string, to string, from string, number ui
 // `reverse` lists the commits from oldest to newest, rather than newest to oldest
 // all commits that match the aforementioned criteria are passed to f.
 func (c APIClient) ListCommitF(repoName string, to string, from string, number uint64, reverse bool, f func(*pfs.CommitInfo) error) error {
+	return c.ListCommitByLabelsF(repoName, to, from, number, reverse, nil, f)
+}
+
+// ListCommitByLabelsF is like ListCommitF, but only passes commits which have
+// all of the labels in selector to f.
+func (c APIClient) ListCommitByLabelsF(repoName string, to string, from string, number uint64, reverse bool, selector map[string]string, f func(*pfs.CommitInfo) error) error {
 	req := &pfs.ListCommitRequest{
 		// repoName may be "", but the repo object must exist
-		Repo:    NewRepo(repoName),
-		Number:  number,
-		Reverse: reverse,
+		Repo:          NewRepo(repoName),
+		Number:        number,
+		Reverse:       reverse,
+		LabelSelector: selector,
 	}
 	if from != "" {
 		req.From = NewCommit(repoName, from)
@@ -264,6 +316,13 @@ func (c APIClient) ListCommitF(repoName string, to string, from string, number u
 	if to != "" {
 		req.To = NewCommit(repoName, to)
 	}
+	return c.ListCommitFilterF(req, f)
+}
+
+// ListCommitFilterF lists the commits matching req, calling f with each
+// commit. To page through a repo's commits, set req.Number to the page size
+// and req.StartAfter to the ID of the last commit of the previous page.
+func (c APIClient) ListCommitFilterF(req *pfs.ListCommitRequest, f func(*pfs.CommitInfo) error) error {
 	stream, err := c.PfsAPIClient.ListCommit(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -326,6 +385,25 @@ func (c APIClient) CreateBranchTrigger(repoName string, branch string, commit st
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateBranchWithProtection creates a branch and sets its protection rules.
+// A protection with no rules set removes the branch's existing protection.
+func (c APIClient) CreateBranchWithProtection(repoName string, branch string, commit string, provenance []*pfs.Branch, protection *pfs.BranchProtection) error {
+	var head *pfs.Commit
+	if commit != "" {
+		head = NewCommit(repoName, commit)
+	}
+	_, err := c.PfsAPIClient.CreateBranch(
+		c.Ctx(),
+		&pfs.CreateBranchRequest{
+			Branch:     NewBranch(repoName, branch),
+			Head:       head,
+			Provenance: provenance,
+			Protection: protection,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // InspectBranch returns information on a specific PFS branch
 func (c APIClient) InspectBranch(repoName string, branch string) (*pfs.BranchInfo, error) {
 	branchInfo, err := c.PfsAPIClient.InspectBranch(
@@ -371,6 +449,153 @@ func (c APIClient) DeleteBranch(repoName string, branch string, force bool) erro
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RenameBranch renames a branch. Pipelines which read from it are updated to
+// read from the new name.
+func (c APIClient) RenameBranch(repoName string, branch string, newName string) error {
+	_, err := c.PfsAPIClient.RenameBranch(
+		c.Ctx(),
+		&pfs.RenameBranchRequest{
+			Branch:  NewBranch(repoName, branch),
+			NewName: newName,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// MergeBranch applies the changes made on the source branch since its common
+// ancestor with the target branch to the target branch, as a new commit.
+// Conflicting changes are resolved according to prefer, if conflicts remain
+// no commit is created and the conflicts are returned in the response.
+func (c APIClient) MergeBranch(repoName string, source string, target string, prefer pfs.MergePreference, dryRun bool) (*pfs.MergeBranchResponse, error) {
+	resp, err := c.PfsAPIClient.MergeBranch(
+		c.Ctx(),
+		&pfs.MergeBranchRequest{
+			Source: NewBranch(repoName, source),
+			Target: NewBranch(repoName, target),
+			Prefer: prefer,
+			DryRun: dryRun,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// CreateTag creates a tag named tagName on the commit that commit (which may
+// be a commit ID, branch or tag, with ancestry syntax) currently refers to.
+// Tags can't be moved, and tagged commits can't be squashed, until the tag is
+// deleted. Tags can be used in place of a commit ID, as in repo@tag.
+func (c APIClient) CreateTag(repoName string, tagName string, commit string, description string) error {
+	_, err := c.PfsAPIClient.CreateTag(
+		c.Ctx(),
+		&pfs.CreateTagRequest{
+			Tag:         NewTag(repoName, tagName),
+			Commit:      NewCommit(repoName, commit),
+			Description: description,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectTag returns information on a specific tag.
+func (c APIClient) InspectTag(repoName string, tagName string) (*pfs.TagInfo, error) {
+	tagInfo, err := c.PfsAPIClient.InspectTag(
+		c.Ctx(),
+		&pfs.InspectTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return tagInfo, grpcutil.ScrubGRPC(err)
+}
+
+// ListTag lists the tags on a Repo.
+func (c APIClient) ListTag(repoName string) ([]*pfs.TagInfo, error) {
+	tagInfos, err := c.PfsAPIClient.ListTag(
+		c.Ctx(),
+		&pfs.ListTagRequest{
+			Repo: NewRepo(repoName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return tagInfos.TagInfo, nil
+}
+
+// DeleteTag deletes a tag, but leaves the commit it refers to intact.
+func (c APIClient) DeleteTag(repoName string, tagName string) error {
+	_, err := c.PfsAPIClient.DeleteTag(
+		c.Ctx(),
+		&pfs.DeleteTagRequest{
+			Tag: NewTag(repoName, tagName),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetRetentionPolicy sets the retention policy of a repo, or of a branch if
+// branch is non-empty. A nil policy removes the existing policy.
+func (c APIClient) SetRetentionPolicy(repoName string, branch string, policy *pfs.RetentionPolicy) error {
+	request := &pfs.SetRetentionPolicyRequest{Policy: policy}
+	if branch != "" {
+		request.Branch = NewBranch(repoName, branch)
+	} else {
+		request.Repo = NewRepo(repoName)
+	}
+	_, err := c.PfsAPIClient.SetRetentionPolicy(c.Ctx(), request)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// EnforceRetention squashes the commits in a repo, or in all repos if
+// repoName is empty, which are no longer kept by a retention policy. It
+// returns the squashed commits, or the commits that would be squashed if
+// dryRun is true.
+func (c APIClient) EnforceRetention(repoName string, dryRun bool) ([]*pfs.Commit, error) {
+	request := &pfs.EnforceRetentionRequest{DryRun: dryRun}
+	if repoName != "" {
+		request.Repo = NewRepo(repoName)
+	}
+	resp, err := c.PfsAPIClient.EnforceRetention(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Commits, nil
+}
+
+// SetRepoLabels merges labels into the labels of a repo and removes the
+// labels with the keys in remove.
+func (c APIClient) SetRepoLabels(repoName string, labels map[string]string, remove ...string) error {
+	_, err := c.PfsAPIClient.SetLabels(c.Ctx(), &pfs.SetLabelsRequest{
+		Repo:   NewRepo(repoName),
+		Labels: labels,
+		Remove: remove,
+	})
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetBranchLabels merges labels into the labels of a branch and removes the
+// labels with the keys in remove.
+func (c APIClient) SetBranchLabels(repoName string, branch string, labels map[string]string, remove ...string) error {
+	_, err := c.PfsAPIClient.SetLabels(c.Ctx(), &pfs.SetLabelsRequest{
+		Branch: NewBranch(repoName, branch),
+		Labels: labels,
+		Remove: remove,
+	})
+	return grpcutil.ScrubGRPC(err)
+}
+
+// SetCommitLabels merges labels into the labels of a commit and removes the
+// labels with the keys in remove.
+func (c APIClient) SetCommitLabels(repoName string, commitID string, labels map[string]string, remove ...string) error {
+	_, err := c.PfsAPIClient.SetLabels(c.Ctx(), &pfs.SetLabelsRequest{
+		Commit: NewCommit(repoName, commitID),
+		Labels: labels,
+		Remove: remove,
+	})
+	return grpcutil.ScrubGRPC(err)
+}
+
 // SquashCommit deletes a commit.
 func (c APIClient) SquashCommit(repoName string, commitID string) error {
 	_, err := c.PfsAPIClient.SquashCommit(
@@ -454,14 +679,21 @@ func (c APIClient) FlushCommitAll(commits []*pfs.Commit, toRepos []*pfs.Repo) ([
 // SubscribeCommit is like ListCommit but it keeps listening for commits as
 // they come in.
 func (c APIClient) SubscribeCommit(repo, branch string, prov *pfs.CommitProvenance, from string, state pfs.CommitState, cb func(*pfs.CommitInfo) error) (retErr error) {
+	return c.SubscribeCommitByLabels(repo, branch, prov, from, state, nil, cb)
+}
+
+// SubscribeCommitByLabels is like SubscribeCommit, but only passes commits
+// which have all of the labels in selector to cb.
+func (c APIClient) SubscribeCommitByLabels(repo, branch string, prov *pfs.CommitProvenance, from string, state pfs.CommitState, selector map[string]string, cb func(*pfs.CommitInfo) error) (retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	req := &pfs.SubscribeCommitRequest{
-		Repo:   NewRepo(repo),
-		Branch: branch,
-		Prov:   prov,
-		State:  state,
+		Repo:          NewRepo(repo),
+		Branch:        branch,
+		Prov:          prov,
+		State:         state,
+		LabelSelector: selector,
 	}
 	if from != "" {
 		req.From = NewCommit(repo, from)
@@ -506,7 +738,19 @@ func (c APIClient) ClearCommit(repo, commit string) (retErr error) {
 // prevent the completion of fsck. Errors that do prevent completion will be
 // returned from the function.
 func (c APIClient) Fsck(fix bool, cb func(*pfs.FsckResponse) error) error {
-	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), &pfs.FsckRequest{Fix: fix})
+	return c.fsck(&pfs.FsckRequest{Fix: fix}, cb)
+}
+
+// FsckStorage performs the same checks as Fsck, and additionally verifies
+// that the file sets and chunks referenced by every commit are present and
+// intact in storage. sampleRate is the fraction of chunks whose content is
+// read and hashed, from 0 (none of them) to 1 (all of them).
+func (c APIClient) FsckStorage(fix bool, sampleRate float64, cb func(*pfs.FsckResponse) error) error {
+	return c.fsck(&pfs.FsckRequest{Fix: fix, Storage: true, SampleRate: sampleRate}, cb)
+}
+
+func (c APIClient) fsck(req *pfs.FsckRequest, cb func(*pfs.FsckResponse) error) error {
+	fsckClient, err := c.PfsAPIClient.Fsck(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -374,9 +374,10 @@ func (c APIClient) RenewFileSet(ID string, ttl time.Duration) (retErr error) {
 // size limits the total amount of data returned, note you will get fewer bytes
 // than size if you pass a value larger than the size of the file.
 // If size is set to 0 then all of the data will be returned.
+// Symlinks are followed within the commit.
 // TODO: Should we error if multiple files are matched?
 func (c APIClient) GetFile(repo, commit, path string, w io.Writer) error {
-	r, err := c.getFileTar(repo, commit, path)
+	r, err := c.getFileTar(repo, commit, path, true)
 	if err != nil {
 		return err
 	}
@@ -385,12 +386,13 @@ func (c APIClient) GetFile(repo, commit, path string, w io.Writer) error {
 	}, true)
 }
 
-func (c APIClient) getFileTar(repo, commit, path string) (_ io.Reader, retErr error) {
+func (c APIClient) getFileTar(repo, commit, path string, followSymlinks bool) (_ io.Reader, retErr error) {
 	defer func() {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	req := &pfs.GetFileRequest{
-		File: NewFile(repo, commit, path),
+		File:           NewFile(repo, commit, path),
+		FollowSymlinks: followSymlinks,
 	}
 	client, err := c.PfsAPIClient.GetFile(c.Ctx(), req)
 	if err != nil {
@@ -399,15 +401,16 @@ func (c APIClient) getFileTar(repo, commit, path string) (_ io.Reader, retErr er
 	return grpcutil.NewStreamingBytesReader(client, nil), nil
 }
 
-// GetFileTar gets a tar file from PFS.
+// GetFileTar gets a tar file from PFS. Symlinks are returned as tar symlink
+// entries rather than being followed.
 func (c APIClient) GetFileTar(repo, commit, path string) (io.Reader, error) {
-	return c.getFileTar(repo, commit, path)
+	return c.getFileTar(repo, commit, path, false)
 }
 
 // GetFileReader gets a reader for the specified path
 // TODO: This should probably be an io.ReadCloser so we can close the rpc if the full file isn't read.
 func (c APIClient) GetFileReader(repo, commit, path string) (io.Reader, error) {
-	r, err := c.getFileTar(repo, commit, path)
+	r, err := c.getFileTar(repo, commit, path, true)
 	if err != nil {
 		return nil, err
 	}
@@ -489,8 +492,9 @@ func (c APIClient) GetFileURL(repo, commit, path, URL string) (retErr error) {
 		retErr = grpcutil.ScrubGRPC(retErr)
 	}()
 	req := &pfs.GetFileRequest{
-		File: NewFile(repo, commit, path),
-		URL:  URL,
+		File:           NewFile(repo, commit, path),
+		URL:            URL,
+		FollowSymlinks: true,
 	}
 	client, err := c.PfsAPIClient.GetFile(c.Ctx(), req)
 	if err != nil {
@@ -610,6 +614,45 @@ func (c APIClient) GlobFileAll(repo, commit, pattern string) (_ []*pfs.FileInfo,
 	return fis, nil
 }
 
+// GrepFile searches the files that match glob in a given commit for lines
+// that match pattern, calling cb with each matching line in path order.
+// pattern is an RE2 regular expression, unless literal is set.
+func (c APIClient) GrepFile(repo, commit, glob, pattern string, literal, ignoreCase bool, cb func(*pfs.GrepFileResponse) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	ctx, cancel := context.WithCancel(c.Ctx())
+	defer cancel()
+	client, err := c.PfsAPIClient.GrepFile(
+		ctx,
+		&pfs.GrepFileRequest{
+			Commit:     NewCommit(repo, commit),
+			Glob:       glob,
+			Pattern:    pattern,
+			Literal:    literal,
+			IgnoreCase: ignoreCase,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := cb(resp); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // DiffFile returns the differences between 2 paths at 2 commits.
 // It streams back one file at a time which is either from the new path, or the old path
 func (c APIClient) DiffFile(newRepo, newCommit, newPath, oldRepo, oldCommit, oldPath string, shallow bool, cb func(*pfs.FileInfo, *pfs.FileInfo) error) (retErr error) {
@@ -63,6 +63,10 @@ const (
 	// OutputCommitIDEnv is an env var that is added to the environment of user
 	// pipelined code and indicates the id of the output commit.
 	OutputCommitIDEnv = "PACH_OUTPUT_COMMIT_ID"
+	// OutputDirEnv is an env var that is added to the environment of user
+	// pipeline code and indicates the directory that output should be written
+	// to.
+	OutputDirEnv = "PACH_OUTPUT_DIR"
 	// PeerPortEnv is the env var that sets a custom peer port
 	PeerPortEnv = "PEER_PORT"
 
@@ -189,6 +193,35 @@ func NewCronInputOpts(name string, repo string, spec string, overwrite bool) *pp
 	}
 }
 
+// NewSQLInput returns an input which runs query against the database at url,
+// either on the cron schedule spec or, if spec is empty, when the pipeline's
+// RunCron is called. The results are exposed to jobs as JSON lines files in
+// `/pfs/<name>/`.
+func NewSQLInput(name string, url string, query string, spec string) *pps.Input {
+	return &pps.Input{
+		SQL: &pps.SQLInput{
+			Name:  name,
+			URL:   url,
+			Query: query,
+			Spec:  spec,
+		},
+	}
+}
+
+// NewBucketInput returns an input which commits the objects under the
+// bucket and prefix at url, such as s3://bucket/prefix, whenever they're
+// added or changed. The bucket is accessed with the credentials in the secret
+// credentialsSecret. The objects are exposed to jobs in `/pfs/<name>/`.
+func NewBucketInput(name string, url string, credentialsSecret string) *pps.Input {
+	return &pps.Input{
+		Bucket: &pps.BucketInput{
+			Name:              name,
+			URL:               url,
+			CredentialsSecret: credentialsSecret,
+		},
+	}
+}
+
 // NewJobInput creates a pps.JobInput.
 func NewJobInput(repoName string, commitID string, glob string) *pps.JobInput {
 	return &pps.JobInput{
@@ -467,6 +500,54 @@ func (c APIClient) ListDatumAll(job string) (_ []*pps.DatumInfo, retErr error) {
 	return dis, nil
 }
 
+// FileLineage returns the edges of the lineage graph of a file. If downstream
+// is false the edges lead to the input files that the file was derived from,
+// otherwise they lead to the output files that were derived from the file.
+// depth limits the number of pipelines traversed, 0 means no limit.
+func (c APIClient) FileLineage(repo, commit, path string, downstream bool, depth int64, cb func(*pps.FileLineageInfo) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	client, err := c.PpsAPIClient.FileLineage(
+		c.Ctx(),
+		&pps.FileLineageRequest{
+			File:       NewFile(repo, commit, path),
+			Downstream: downstream,
+			Depth:      depth,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for {
+		fli, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := cb(fli); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// FileLineageAll returns all of the edges of the lineage graph of a file.
+func (c APIClient) FileLineageAll(repo, commit, path string, downstream bool, depth int64) (_ []*pps.FileLineageInfo, retErr error) {
+	var flis []*pps.FileLineageInfo
+	if err := c.FileLineage(repo, commit, path, downstream, depth, func(fli *pps.FileLineageInfo) error {
+		flis = append(flis, fli)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return flis, nil
+}
+
 // InspectDatum returns info about a single datum
 func (c APIClient) InspectDatum(jobID string, datumID string) (*pps.DatumInfo, error) {
 	datumInfo, err := c.PpsAPIClient.InspectDatum(
@@ -717,6 +798,20 @@ func (c APIClient) StopPipeline(name string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RollbackPipeline re-applies version of a pipeline as its newest version. If
+// reprocess is true, all datums are reprocessed by the new version.
+func (c APIClient) RollbackPipeline(name string, version uint64, reprocess bool) error {
+	_, err := c.PpsAPIClient.RollbackPipeline(
+		c.Ctx(),
+		&pps.RollbackPipelineRequest{
+			Pipeline:  NewPipeline(name),
+			Version:   version,
+			Reprocess: reprocess,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // RunPipeline runs a pipeline. It can be passed a list of commit provenance.
 // This will trigger a new job provenant on those commits, effectively running the pipeline on the data in those commits.
 func (c APIClient) RunPipeline(name string, provenance []*pfs.CommitProvenance, jobID string) error {
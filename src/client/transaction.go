@@ -326,6 +326,10 @@ func (c *ppsBuilderClient) UpdateJobState(ctx context.Context, req *pps.UpdateJo
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{UpdateJobState: req})
 	return nil, nil
 }
+func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{CreatePipeline: req})
+	return nil, nil
+}
 
 // Boilerplate for making unsupported API requests error when used on a TransactionBuilder
 func unsupportedError(name string) error {
@@ -362,6 +366,39 @@ func (c *pfsBuilderClient) InspectBranch(ctx context.Context, req *pfs.InspectBr
 func (c *pfsBuilderClient) ListBranch(ctx context.Context, req *pfs.ListBranchRequest, opts ...grpc.CallOption) (*pfs.BranchInfos, error) {
 	return nil, unsupportedError("ListBranch")
 }
+func (c *pfsBuilderClient) SetRetentionPolicy(ctx context.Context, req *pfs.SetRetentionPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("SetRetentionPolicy")
+}
+func (c *pfsBuilderClient) EnforceRetention(ctx context.Context, req *pfs.EnforceRetentionRequest, opts ...grpc.CallOption) (*pfs.EnforceRetentionResponse, error) {
+	return nil, unsupportedError("EnforceRetention")
+}
+func (c *pfsBuilderClient) RenameRepo(ctx context.Context, req *pfs.RenameRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("RenameRepo")
+}
+func (c *pfsBuilderClient) RenameBranch(ctx context.Context, req *pfs.RenameBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("RenameBranch")
+}
+func (c *pfsBuilderClient) ForkRepo(ctx context.Context, req *pfs.ForkRepoRequest, opts ...grpc.CallOption) (*pfs.Commit, error) {
+	return nil, unsupportedError("ForkRepo")
+}
+func (c *pfsBuilderClient) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest, opts ...grpc.CallOption) (*pfs.MergeBranchResponse, error) {
+	return nil, unsupportedError("MergeBranch")
+}
+func (c *pfsBuilderClient) CreateTag(ctx context.Context, req *pfs.CreateTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CreateTag")
+}
+func (c *pfsBuilderClient) InspectTag(ctx context.Context, req *pfs.InspectTagRequest, opts ...grpc.CallOption) (*pfs.TagInfo, error) {
+	return nil, unsupportedError("InspectTag")
+}
+func (c *pfsBuilderClient) ListTag(ctx context.Context, req *pfs.ListTagRequest, opts ...grpc.CallOption) (*pfs.TagInfos, error) {
+	return nil, unsupportedError("ListTag")
+}
+func (c *pfsBuilderClient) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteTag")
+}
+func (c *pfsBuilderClient) SetLabels(ctx context.Context, req *pfs.SetLabelsRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("SetLabels")
+}
 func (c *pfsBuilderClient) ModifyFile(ctx context.Context, opts ...grpc.CallOption) (pfs.API_ModifyFileClient, error) {
 	return nil, unsupportedError("ModifyFile")
 }
@@ -380,6 +417,9 @@ func (c *pfsBuilderClient) WalkFile(ctx context.Context, req *pfs.WalkFileReques
 func (c *pfsBuilderClient) GlobFile(ctx context.Context, req *pfs.GlobFileRequest, opts ...grpc.CallOption) (pfs.API_GlobFileClient, error) {
 	return nil, unsupportedError("GlobFile")
 }
+func (c *pfsBuilderClient) GrepFile(ctx context.Context, req *pfs.GrepFileRequest, opts ...grpc.CallOption) (pfs.API_GrepFileClient, error) {
+	return nil, unsupportedError("GrepFile")
+}
 func (c *pfsBuilderClient) DiffFile(ctx context.Context, req *pfs.DiffFileRequest, opts ...grpc.CallOption) (pfs.API_DiffFileClient, error) {
 	return nil, unsupportedError("DiffFile")
 }
@@ -429,8 +469,8 @@ func (c *ppsBuilderClient) ListDatum(ctx context.Context, req *pps.ListDatumRequ
 func (c *ppsBuilderClient) RestartDatum(ctx context.Context, req *pps.RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RestartDatum")
 }
-func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("CreatePipeline")
+func (c *ppsBuilderClient) FileLineage(ctx context.Context, req *pps.FileLineageRequest, opts ...grpc.CallOption) (pps.API_FileLineageClient, error) {
+	return nil, unsupportedError("FileLineage")
 }
 func (c *ppsBuilderClient) InspectPipeline(ctx context.Context, req *pps.InspectPipelineRequest, opts ...grpc.CallOption) (*pps.PipelineInfo, error) {
 	return nil, unsupportedError("InspectPipeline")
@@ -447,6 +487,9 @@ func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipe
 func (c *ppsBuilderClient) StopPipeline(ctx context.Context, req *pps.StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("StopPipeline")
 }
+func (c *ppsBuilderClient) RollbackPipeline(ctx context.Context, req *pps.RollbackPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("RollbackPipeline")
+}
 func (c *ppsBuilderClient) RunPipeline(ctx context.Context, req *pps.RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RunPipeline")
 }
@@ -197,7 +197,12 @@ type EnterpriseRecord struct {
 	// the pachd is no longer registered with an enterprise server.
 	// This is the same as the expired state, where auth is locked
 	// but not disabled.
-	HeartbeatFailed      bool     `protobuf:"varint,3,opt,name=heartbeat_failed,json=heartbeatFailed,proto3" json:"heartbeat_failed,omitempty"`
+	HeartbeatFailed bool `protobuf:"varint,3,opt,name=heartbeat_failed,json=heartbeatFailed,proto3" json:"heartbeat_failed,omitempty"`
+	// limits_exceeded is set if the clusters registered with the license
+	// server are collectively over the license's usage limits, or at its
+	// repo or pipeline limit. New pipelines can't be created while this is
+	// set.
+	LimitsExceeded       []string `protobuf:"bytes,4,rep,name=limits_exceeded,json=limitsExceeded,proto3" json:"limits_exceeded,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -257,6 +262,13 @@ func (m *EnterpriseRecord) GetHeartbeatFailed() bool {
 	return false
 }
 
+func (m *EnterpriseRecord) GetLimitsExceeded() []string {
+	if m != nil {
+		return m.LimitsExceeded
+	}
+	return nil
+}
+
 // TokenInfo contains information about the currently active enterprise token
 type TokenInfo struct {
 	// expires indicates when the current token expires (unset if there is no
@@ -453,7 +465,10 @@ type GetStateResponse struct {
 	Info  *TokenInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
 	// activation_code will always be an empty string,
 	// call GetEnterpriseCode to get the activation code
-	ActivationCode       string   `protobuf:"bytes,3,opt,name=activation_code,json=activationCode,proto3" json:"activation_code,omitempty"`
+	ActivationCode string `protobuf:"bytes,3,opt,name=activation_code,json=activationCode,proto3" json:"activation_code,omitempty"`
+	// limits_exceeded describes each of the license's usage limits that
+	// have been exceeded, as of the last heartbeat.
+	LimitsExceeded       []string `protobuf:"bytes,4,rep,name=limits_exceeded,json=limitsExceeded,proto3" json:"limits_exceeded,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -513,6 +528,13 @@ func (m *GetStateResponse) GetActivationCode() string {
 	return ""
 }
 
+func (m *GetStateResponse) GetLimitsExceeded() []string {
+	if m != nil {
+		return m.LimitsExceeded
+	}
+	return nil
+}
+
 type GetActivationCodeRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -795,48 +817,49 @@ func init() {
 func init() { proto.RegisterFile("enterprise/enterprise.proto", fileDescriptor_33f079c38c86fe97) }
 
 var fileDescriptor_33f079c38c86fe97 = []byte{
-	// 650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0x66, 0xbb, 0x05, 0xda, 0x43, 0x68, 0xb7, 0x03, 0x9a, 0xb2, 0x68, 0x25, 0x1b, 0x09, 0xe0,
-	0x45, 0x6b, 0x8a, 0xd7, 0xc6, 0x05, 0x16, 0x28, 0x41, 0x24, 0x4b, 0x63, 0x8c, 0x37, 0xcd, 0x76,
-	0xf7, 0xb4, 0x4c, 0x2c, 0x3b, 0xeb, 0xce, 0x40, 0xf4, 0x29, 0xbc, 0x33, 0xbe, 0x88, 0xef, 0xe0,
-	0xa5, 0x8f, 0x60, 0x78, 0x12, 0xc3, 0xfe, 0x6f, 0xd9, 0x46, 0x2e, 0xbd, 0x9b, 0x7e, 0xe7, 0x9b,
-	0x73, 0xbe, 0xf9, 0xce, 0xd7, 0x16, 0xd6, 0xd1, 0x15, 0xe8, 0x7b, 0x3e, 0xe5, 0xd8, 0x49, 0x8f,
-	0x6d, 0xcf, 0x67, 0x82, 0x11, 0x48, 0x11, 0xf5, 0xd9, 0x98, 0xb1, 0xf1, 0x04, 0x3b, 0x41, 0x65,
-	0x78, 0x3d, 0xea, 0x08, 0x7a, 0x85, 0x5c, 0x58, 0x57, 0x5e, 0x48, 0xd6, 0x5c, 0x58, 0x3e, 0xa5,
-	0x36, 0xba, 0x1c, 0x4d, 0xb4, 0x99, 0xef, 0x90, 0x2d, 0xa8, 0x5b, 0xb6, 0xa0, 0x37, 0x96, 0xa0,
-	0xcc, 0x1d, 0xd8, 0xcc, 0xc1, 0xa6, 0xb4, 0x21, 0x6d, 0x57, 0xcd, 0x5a, 0x0a, 0xef, 0x33, 0x07,
-	0xc9, 0x2b, 0x58, 0xc4, 0x2f, 0x1e, 0xf5, 0x91, 0x37, 0x4b, 0x1b, 0xd2, 0xf6, 0x52, 0x57, 0x6d,
-	0x87, 0xc3, 0xda, 0xf1, 0xb0, 0x76, 0x3f, 0x1e, 0x66, 0xc6, 0x54, 0xcd, 0x02, 0xc5, 0x48, 0xe4,
-	0xed, 0x33, 0x77, 0x44, 0xc7, 0x64, 0x13, 0x6a, 0x93, 0x50, 0xc3, 0x80, 0xa3, 0x7f, 0x83, 0x7e,
-	0x34, 0x71, 0x39, 0x42, 0x2f, 0x02, 0x90, 0xd4, 0xa0, 0x44, 0x9d, 0x60, 0x56, 0xd5, 0x2c, 0x51,
-	0x87, 0x3c, 0x86, 0x05, 0x8e, 0xb6, 0x8f, 0xa2, 0x29, 0x07, 0x58, 0xf4, 0x49, 0xfb, 0x29, 0x65,
-	0x67, 0x44, 0xcf, 0xda, 0x85, 0xc5, 0xa8, 0x5b, 0xd0, 0x7c, 0xa9, 0xbb, 0xd6, 0xce, 0x18, 0x97,
-	0xb3, 0xc0, 0x8c, 0x99, 0x44, 0x87, 0xda, 0xc4, 0xe2, 0x62, 0x70, 0x89, 0x96, 0x2f, 0x86, 0x68,
-	0x89, 0x07, 0xbc, 0x74, 0xf9, 0xee, 0xc6, 0x71, 0x7c, 0x81, 0xec, 0x80, 0x92, 0xdc, 0x1e, 0x8c,
-	0x2c, 0x3a, 0x41, 0x27, 0x90, 0x5b, 0x31, 0xeb, 0x09, 0x7e, 0x18, 0xc0, 0x9a, 0x0e, 0xd5, 0x3e,
-	0xfb, 0x84, 0x6e, 0xcf, 0x1d, 0xb1, 0xac, 0xbb, 0xd2, 0xc3, 0xdd, 0x9d, 0x40, 0x5d, 0x0f, 0xb7,
-	0x84, 0x26, 0x7e, 0xbe, 0x46, 0x2e, 0x0a, 0xcc, 0x95, 0x67, 0x9b, 0x5b, 0x2e, 0x30, 0x77, 0x3e,
-	0x6b, 0xee, 0x49, 0xb9, 0x22, 0x29, 0xa5, 0x93, 0x72, 0xa5, 0xa4, 0xc8, 0x1a, 0x01, 0x25, 0x9d,
-	0xc6, 0x3d, 0xe6, 0x72, 0xd4, 0x1a, 0x50, 0x3f, 0x42, 0x71, 0x21, 0x52, 0x05, 0xda, 0x37, 0x09,
-	0x94, 0x14, 0x0b, 0x79, 0x64, 0x0b, 0xe6, 0xf9, 0x1d, 0x10, 0xbc, 0xae, 0xd6, 0x6d, 0x64, 0xb7,
-	0x11, 0x32, 0xc3, 0x3a, 0xd9, 0x81, 0x32, 0x75, 0x47, 0x2c, 0x72, 0xfe, 0x51, 0x96, 0x97, 0xb8,
-	0x65, 0x06, 0x94, 0xa2, 0xe8, 0xca, 0x45, 0xd1, 0xd5, 0x54, 0x68, 0x1e, 0xa1, 0xd0, 0x73, 0x60,
-	0xac, 0xf6, 0x87, 0x04, 0x6b, 0x05, 0xc5, 0xff, 0x41, 0x36, 0x01, 0x25, 0x09, 0x56, 0x2c, 0x77,
-	0x05, 0x1a, 0x19, 0x2c, 0x5a, 0xc2, 0x0a, 0x34, 0x0e, 0xd0, 0xca, 0x07, 0x41, 0x5b, 0x05, 0x92,
-	0x05, 0x43, 0xea, 0x8b, 0x37, 0x30, 0x1f, 0xe8, 0x26, 0x15, 0x28, 0x9f, 0xbd, 0x3b, 0x33, 0x94,
-	0x39, 0x02, 0xb0, 0xa0, 0xef, 0xf7, 0x7b, 0xef, 0x0d, 0x45, 0x22, 0x4b, 0xb0, 0x68, 0x7c, 0x38,
-	0xef, 0x99, 0xc6, 0x81, 0x52, 0x22, 0xab, 0xa0, 0x1c, 0x1b, 0xba, 0xd9, 0xdf, 0x33, 0xf4, 0xfe,
-	0xe0, 0x50, 0xef, 0x9d, 0x1a, 0x07, 0x8a, 0xdc, 0xfd, 0x2e, 0x83, 0xac, 0x9f, 0xf7, 0xc8, 0x11,
-	0x54, 0xe2, 0x34, 0x90, 0xf5, 0xec, 0x7b, 0xa7, 0x12, 0xa9, 0x3e, 0x29, 0x2e, 0x46, 0xda, 0xe7,
-	0xee, 0x1a, 0xc5, 0x71, 0xc9, 0x37, 0x9a, 0x0a, 0x56, 0xbe, 0xd1, 0x74, 0xc2, 0xb4, 0x39, 0x32,
-	0x84, 0xc6, 0xbd, 0x4d, 0x92, 0xe7, 0x53, 0x97, 0x0a, 0x53, 0xa0, 0x6e, 0xfe, 0x83, 0x95, 0xcc,
-	0x38, 0x81, 0x6a, 0xfa, 0x65, 0xcf, 0x09, 0x9a, 0x5e, 0x95, 0xfa, 0x74, 0x46, 0x35, 0xe9, 0xf5,
-	0x16, 0x20, 0xdd, 0x10, 0xc9, 0xd1, 0xef, 0xad, 0x53, 0x6d, 0xcd, 0x2a, 0xc7, 0xed, 0xf6, 0x5e,
-	0xff, 0xba, 0x6d, 0x49, 0xbf, 0x6f, 0x5b, 0xd2, 0x9f, 0xdb, 0x96, 0xf4, 0xf1, 0xe5, 0x98, 0x8a,
-	0xcb, 0xeb, 0x61, 0xdb, 0x66, 0x57, 0x1d, 0xcf, 0xb2, 0x2f, 0xbf, 0x3a, 0xe8, 0x67, 0x4f, 0x37,
-	0xdd, 0x0e, 0xf7, 0xed, 0xcc, 0xbf, 0xc9, 0x70, 0x21, 0xf8, 0xa5, 0xd9, 0xfd, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0xfd, 0x00, 0x9a, 0x99, 0x6d, 0x06, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x5d, 0x4f, 0xd3, 0x50,
+	0x18, 0xa6, 0xeb, 0x80, 0xed, 0x25, 0x6c, 0xdd, 0x01, 0xcd, 0x28, 0x38, 0x49, 0x23, 0x01, 0xbc,
+	0xd8, 0xcc, 0xf0, 0xda, 0x58, 0xa0, 0xc0, 0x08, 0x22, 0x29, 0x8b, 0x31, 0xde, 0x2c, 0x5d, 0xfb,
+	0x6e, 0x9c, 0xb8, 0xf5, 0xcc, 0x9e, 0x03, 0xc1, 0x3f, 0x62, 0xfc, 0x29, 0xfe, 0x04, 0x2f, 0xfd,
+	0x05, 0xc6, 0xf0, 0x4b, 0xcc, 0xfa, 0xdd, 0x51, 0x22, 0x97, 0xde, 0x75, 0xcf, 0xfb, 0xbc, 0x9f,
+	0xcf, 0x93, 0x33, 0x58, 0x47, 0x57, 0xa0, 0x37, 0xf1, 0x28, 0xc7, 0x56, 0xf2, 0xd9, 0x9c, 0x78,
+	0x4c, 0x30, 0x02, 0x09, 0xa2, 0x3e, 0x1f, 0x32, 0x36, 0x1c, 0x61, 0xcb, 0x8f, 0xf4, 0xaf, 0x07,
+	0x2d, 0x41, 0xc7, 0xc8, 0x85, 0x35, 0x9e, 0x04, 0x64, 0xcd, 0x85, 0xe5, 0x33, 0x6a, 0xa3, 0xcb,
+	0xd1, 0x44, 0x9b, 0x79, 0x0e, 0xd9, 0x86, 0xaa, 0x65, 0x0b, 0x7a, 0x63, 0x09, 0xca, 0xdc, 0x9e,
+	0xcd, 0x1c, 0xac, 0x4b, 0x9b, 0xd2, 0x4e, 0xd9, 0xac, 0x24, 0xf0, 0x01, 0x73, 0x90, 0xbc, 0x86,
+	0x45, 0xbc, 0x9d, 0x50, 0x0f, 0x79, 0xbd, 0xb0, 0x29, 0xed, 0x2c, 0xb5, 0xd5, 0x66, 0xd0, 0xac,
+	0x19, 0x35, 0x6b, 0x76, 0xa3, 0x66, 0x66, 0x44, 0xd5, 0x2c, 0x50, 0x8c, 0x78, 0xbc, 0x03, 0xe6,
+	0x0e, 0xe8, 0x90, 0x6c, 0x41, 0x65, 0x14, 0xcc, 0xd0, 0xe3, 0xe8, 0xdd, 0xa0, 0x17, 0x76, 0x5c,
+	0x0e, 0xd1, 0x4b, 0x1f, 0x24, 0x15, 0x28, 0x50, 0xc7, 0xef, 0x55, 0x36, 0x0b, 0xd4, 0x21, 0x4f,
+	0x61, 0x81, 0xa3, 0xed, 0xa1, 0xa8, 0xcb, 0x3e, 0x16, 0xfe, 0xd2, 0x7e, 0x4b, 0xe9, 0x1e, 0xe1,
+	0x5a, 0x7b, 0xb0, 0x18, 0x56, 0xf3, 0x8b, 0x2f, 0xb5, 0xd7, 0x9a, 0xa9, 0xc3, 0x65, 0x4e, 0x60,
+	0x46, 0x4c, 0xa2, 0x43, 0x65, 0x64, 0x71, 0xd1, 0xbb, 0x42, 0xcb, 0x13, 0x7d, 0xb4, 0xc4, 0x23,
+	0x36, 0x5d, 0x9e, 0x66, 0x9c, 0x44, 0x09, 0x64, 0x17, 0x94, 0x38, 0xbb, 0x37, 0xb0, 0xe8, 0x08,
+	0x1d, 0x7f, 0xdc, 0x92, 0x59, 0x8d, 0xf1, 0x23, 0x1f, 0x9e, 0x5e, 0x7e, 0x44, 0xc7, 0x54, 0xf0,
+	0x1e, 0xde, 0xda, 0x88, 0x0e, 0x3a, 0xf5, 0xe2, 0xa6, 0x3c, 0xbd, 0x7c, 0x00, 0x1b, 0x21, 0xaa,
+	0xe9, 0x50, 0xee, 0xb2, 0xcf, 0xe8, 0x76, 0xdc, 0x01, 0x4b, 0xcb, 0x20, 0x3d, 0x5e, 0x86, 0x11,
+	0x54, 0xf5, 0x40, 0x4e, 0x34, 0xf1, 0xcb, 0x35, 0x72, 0x91, 0xa3, 0x82, 0xfc, 0xb0, 0x0a, 0xc5,
+	0x1c, 0x15, 0xe6, 0xd3, 0x2a, 0x9c, 0x16, 0x4b, 0x92, 0x52, 0x38, 0x2d, 0x96, 0x0a, 0x8a, 0xac,
+	0x11, 0x50, 0x92, 0x6e, 0x7c, 0xc2, 0x5c, 0x8e, 0x5a, 0x0d, 0xaa, 0xc7, 0x28, 0x2e, 0x45, 0x32,
+	0x81, 0xf6, 0x43, 0x02, 0x25, 0xc1, 0x02, 0x1e, 0xd9, 0x86, 0x79, 0x3e, 0x05, 0xfc, 0xed, 0x2a,
+	0xed, 0x5a, 0x5a, 0xb6, 0x80, 0x19, 0xc4, 0xc9, 0x2e, 0x14, 0xa9, 0x3b, 0x60, 0xa1, 0x44, 0x4f,
+	0xd2, 0xbc, 0xf8, 0x5a, 0xa6, 0x4f, 0xc9, 0xf3, 0xb8, 0x9c, 0xeb, 0xf1, 0x47, 0x4b, 0xa2, 0x42,
+	0xfd, 0x18, 0x85, 0x9e, 0xc9, 0x8e, 0xd6, 0xfa, 0x2e, 0xc1, 0x5a, 0x4e, 0xf0, 0x3f, 0xd8, 0x6f,
+	0x2a, 0x4c, 0x6c, 0xd5, 0x68, 0xdc, 0x15, 0xa8, 0xa5, 0xb0, 0x50, 0xad, 0x15, 0xa8, 0x1d, 0xa2,
+	0x95, 0x75, 0x8c, 0xb6, 0x0a, 0x24, 0x0d, 0x06, 0xd4, 0x97, 0x6f, 0x61, 0xde, 0x9f, 0x9b, 0x94,
+	0xa0, 0x78, 0xfe, 0xfe, 0xdc, 0x50, 0xe6, 0x08, 0xc0, 0x82, 0x7e, 0xd0, 0xed, 0x7c, 0x30, 0x14,
+	0x89, 0x2c, 0xc1, 0xa2, 0xf1, 0xf1, 0xa2, 0x63, 0x1a, 0x87, 0x4a, 0x81, 0xac, 0x82, 0x72, 0x62,
+	0xe8, 0x66, 0x77, 0xdf, 0xd0, 0xbb, 0xbd, 0x23, 0xbd, 0x73, 0x66, 0x1c, 0x2a, 0x72, 0xfb, 0x9b,
+	0x0c, 0xb2, 0x7e, 0xd1, 0x21, 0xc7, 0x50, 0x8a, 0x6c, 0x43, 0xd6, 0xd3, 0xfb, 0xce, 0x58, 0x57,
+	0xdd, 0xc8, 0x0f, 0x86, 0xb3, 0xcf, 0x4d, 0x0b, 0x45, 0xbe, 0xca, 0x16, 0x9a, 0x71, 0xa0, 0xba,
+	0x91, 0x1f, 0x8c, 0x0b, 0xf5, 0xa1, 0x76, 0x4f, 0x49, 0xf2, 0x62, 0x26, 0x29, 0xd7, 0x05, 0xea,
+	0xd6, 0x3f, 0x58, 0x71, 0x8f, 0x53, 0x28, 0x27, 0xcf, 0x47, 0x66, 0xa0, 0x59, 0xa9, 0xd4, 0x67,
+	0x0f, 0x44, 0xe3, 0x5a, 0xef, 0x00, 0x12, 0x85, 0x48, 0x86, 0x7e, 0x4f, 0x4e, 0xb5, 0xf1, 0x50,
+	0x38, 0x2a, 0xb7, 0xff, 0xe6, 0xe7, 0x5d, 0x43, 0xfa, 0x75, 0xd7, 0x90, 0xfe, 0xdc, 0x35, 0xa4,
+	0x4f, 0xaf, 0x86, 0x54, 0x5c, 0x5d, 0xf7, 0x9b, 0x36, 0x1b, 0xb7, 0x26, 0x96, 0x7d, 0xf5, 0xd5,
+	0x41, 0x2f, 0xfd, 0x75, 0xd3, 0x6e, 0x71, 0xcf, 0x4e, 0xfd, 0x3f, 0xf5, 0x17, 0xfc, 0x27, 0x69,
+	0xef, 0xef, 0x00, 0xe2, 0x9a, 0x21, 0x95, 0xbf, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1193,6 +1216,15 @@ func (m *EnterpriseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LimitsExceeded) > 0 {
+		for iNdEx := len(m.LimitsExceeded) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LimitsExceeded[iNdEx])
+			copy(dAtA[i:], m.LimitsExceeded[iNdEx])
+			i = encodeVarintEnterprise(dAtA, i, uint64(len(m.LimitsExceeded[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.HeartbeatFailed {
 		i--
 		if m.HeartbeatFailed {
@@ -1395,6 +1427,15 @@ func (m *GetStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LimitsExceeded) > 0 {
+		for iNdEx := len(m.LimitsExceeded) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LimitsExceeded[iNdEx])
+			copy(dAtA[i:], m.LimitsExceeded[iNdEx])
+			i = encodeVarintEnterprise(dAtA, i, uint64(len(m.LimitsExceeded[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ActivationCode) > 0 {
 		i -= len(m.ActivationCode)
 		copy(dAtA[i:], m.ActivationCode)
@@ -1680,6 +1721,12 @@ func (m *EnterpriseRecord) Size() (n int) {
 	if m.HeartbeatFailed {
 		n += 2
 	}
+	if len(m.LimitsExceeded) > 0 {
+		for _, s := range m.LimitsExceeded {
+			l = len(s)
+			n += 1 + l + sovEnterprise(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1767,6 +1814,12 @@ func (m *GetStateResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEnterprise(uint64(l))
 	}
+	if len(m.LimitsExceeded) > 0 {
+		for _, s := range m.LimitsExceeded {
+			l = len(s)
+			n += 1 + l + sovEnterprise(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2249,6 +2302,38 @@ func (m *EnterpriseRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HeartbeatFailed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitsExceeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitsExceeded = append(m.LimitsExceeded, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnterprise(dAtA[iNdEx:])
@@ -2723,6 +2808,38 @@ func (m *GetStateResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ActivationCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitsExceeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitsExceeded = append(m.LimitsExceeded, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnterprise(dAtA[iNdEx:])
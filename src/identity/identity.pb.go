@@ -33,11 +33,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // User represents an IDP user that has authenticated via OIDC
 type User struct {
-	Email                string     `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	LastAuthenticated    *time.Time `protobuf:"bytes,2,opt,name=last_authenticated,json=lastAuthenticated,proto3,stdtime" json:"last_authenticated,omitempty" db:"last_authenticated"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Email             string     `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	LastAuthenticated *time.Time `protobuf:"bytes,2,opt,name=last_authenticated,json=lastAuthenticated,proto3,stdtime" json:"last_authenticated,omitempty" db:"last_authenticated"`
+	// Enabled is false if the user has been disabled, in which case they
+	// are no longer able to log in through the identity server.
+	Enabled              bool     `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty" db:"enabled"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *User) Reset()         { *m = User{} }
@@ -87,6 +90,13 @@ func (m *User) GetLastAuthenticated() *time.Time {
 	return nil
 }
 
+func (m *User) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 // IdentityServerConfig is the configuration for the identity web server.
 // When the configuration is changed the web server is reloaded automatically.
 type IdentityServerConfig struct {
@@ -1359,6 +1369,360 @@ func (m *DeleteOIDCClientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteOIDCClientResponse proto.InternalMessageInfo
 
+type ListUsersRequest struct {
+	// IncludeDisabled returns users that have been disabled as well as
+	// active users.
+	IncludeDisabled      bool     `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{28}
+}
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetIncludeDisabled() bool {
+	if m != nil {
+		return m.IncludeDisabled
+	}
+	return false
+}
+
+type ListUsersResponse struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{29}
+}
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
+}
+func (m *ListUsersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
+
+func (m *ListUsersResponse) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type DisableUserRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableUserRequest) Reset()         { *m = DisableUserRequest{} }
+func (m *DisableUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableUserRequest) ProtoMessage()    {}
+func (*DisableUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{30}
+}
+func (m *DisableUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisableUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableUserRequest.Merge(m, src)
+}
+func (m *DisableUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableUserRequest proto.InternalMessageInfo
+
+func (m *DisableUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type DisableUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableUserResponse) Reset()         { *m = DisableUserResponse{} }
+func (m *DisableUserResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableUserResponse) ProtoMessage()    {}
+func (*DisableUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{31}
+}
+func (m *DisableUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisableUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableUserResponse.Merge(m, src)
+}
+func (m *DisableUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableUserResponse proto.InternalMessageInfo
+
+type EnableUserRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnableUserRequest) Reset()         { *m = EnableUserRequest{} }
+func (m *EnableUserRequest) String() string { return proto.CompactTextString(m) }
+func (*EnableUserRequest) ProtoMessage()    {}
+func (*EnableUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{32}
+}
+func (m *EnableUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnableUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableUserRequest.Merge(m, src)
+}
+func (m *EnableUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableUserRequest proto.InternalMessageInfo
+
+func (m *EnableUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type EnableUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnableUserResponse) Reset()         { *m = EnableUserResponse{} }
+func (m *EnableUserResponse) String() string { return proto.CompactTextString(m) }
+func (*EnableUserResponse) ProtoMessage()    {}
+func (*EnableUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{33}
+}
+func (m *EnableUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnableUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableUserResponse.Merge(m, src)
+}
+func (m *EnableUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableUserResponse proto.InternalMessageInfo
+
+type DeleteUserRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
+func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{34}
+}
+func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserRequest.Merge(m, src)
+}
+func (m *DeleteUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
+
+func (m *DeleteUserRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserResponse) Reset()         { *m = DeleteUserResponse{} }
+func (m *DeleteUserResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserResponse) ProtoMessage()    {}
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{35}
+}
+func (m *DeleteUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserResponse.Merge(m, src)
+}
+func (m *DeleteUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserResponse proto.InternalMessageInfo
+
 type DeleteAllRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1369,7 +1733,7 @@ func (m *DeleteAllRequest) Reset()         { *m = DeleteAllRequest{} }
 func (m *DeleteAllRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllRequest) ProtoMessage()    {}
 func (*DeleteAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{28}
+	return fileDescriptor_2433c1f46177a3e0, []int{36}
 }
 func (m *DeleteAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,7 +1772,7 @@ func (m *DeleteAllResponse) Reset()         { *m = DeleteAllResponse{} }
 func (m *DeleteAllResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllResponse) ProtoMessage()    {}
 func (*DeleteAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2433c1f46177a3e0, []int{29}
+	return fileDescriptor_2433c1f46177a3e0, []int{37}
 }
 func (m *DeleteAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1466,6 +1830,14 @@ func init() {
 	proto.RegisterType((*UpdateOIDCClientResponse)(nil), "identity.UpdateOIDCClientResponse")
 	proto.RegisterType((*DeleteOIDCClientRequest)(nil), "identity.DeleteOIDCClientRequest")
 	proto.RegisterType((*DeleteOIDCClientResponse)(nil), "identity.DeleteOIDCClientResponse")
+	proto.RegisterType((*ListUsersRequest)(nil), "identity.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "identity.ListUsersResponse")
+	proto.RegisterType((*DisableUserRequest)(nil), "identity.DisableUserRequest")
+	proto.RegisterType((*DisableUserResponse)(nil), "identity.DisableUserResponse")
+	proto.RegisterType((*EnableUserRequest)(nil), "identity.EnableUserRequest")
+	proto.RegisterType((*EnableUserResponse)(nil), "identity.EnableUserResponse")
+	proto.RegisterType((*DeleteUserRequest)(nil), "identity.DeleteUserRequest")
+	proto.RegisterType((*DeleteUserResponse)(nil), "identity.DeleteUserResponse")
 	proto.RegisterType((*DeleteAllRequest)(nil), "identity.DeleteAllRequest")
 	proto.RegisterType((*DeleteAllResponse)(nil), "identity.DeleteAllResponse")
 }
@@ -1473,65 +1845,76 @@ func init() {
 func init() { proto.RegisterFile("identity/identity.proto", fileDescriptor_2433c1f46177a3e0) }
 
 var fileDescriptor_2433c1f46177a3e0 = []byte{
-	// 917 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0xdc, 0x40, 0xce, 0xbd, 0x85, 0xdb, 0x21, 0x24, 0x8e, 0xb9, 0x72, 0x52, 0xb7,
-	0x42, 0xa9, 0x40, 0x8e, 0x14, 0x10, 0x0b, 0xc4, 0xa6, 0x4d, 0x21, 0x44, 0x42, 0x6a, 0x9b, 0x52,
-	0x54, 0x40, 0xa2, 0x72, 0xec, 0x69, 0xea, 0x2a, 0xb1, 0xcd, 0xcc, 0xa4, 0x52, 0xdf, 0x80, 0x05,
-	0x0b, 0xc4, 0x53, 0xb1, 0x64, 0xcb, 0x06, 0x50, 0x1f, 0x81, 0x27, 0x40, 0xb6, 0xc7, 0xf6, 0xc4,
-	0x7f, 0x2d, 0xe5, 0xee, 0xc6, 0x67, 0xbe, 0x73, 0xbe, 0x99, 0xef, 0x9c, 0xf9, 0xa2, 0x40, 0xc7,
-	0xb6, 0xb0, 0xc3, 0x6c, 0x76, 0x37, 0x8c, 0x16, 0xba, 0x47, 0x5c, 0xe6, 0xa2, 0x37, 0xa3, 0x6f,
-	0xa5, 0xb7, 0x70, 0xdd, 0xc5, 0x12, 0x0f, 0x83, 0xf8, 0x7c, 0x7d, 0x35, 0x64, 0xf6, 0x0a, 0x53,
-	0x66, 0xac, 0xbc, 0x10, 0xaa, 0xb4, 0x16, 0xee, 0xc2, 0x0d, 0x96, 0x43, 0x7f, 0x15, 0x46, 0xb5,
-	0x9f, 0x24, 0xa8, 0x9f, 0x53, 0x4c, 0x50, 0x0b, 0x9e, 0xe1, 0x95, 0x61, 0x2f, 0x65, 0xa9, 0x2f,
-	0x0d, 0x9a, 0xb3, 0xf0, 0x03, 0xdd, 0x00, 0x5a, 0x1a, 0x94, 0x5d, 0x1a, 0x6b, 0x76, 0xed, 0x13,
-	0x99, 0x06, 0xc3, 0x96, 0x5c, 0xed, 0x4b, 0x83, 0xe7, 0x23, 0x45, 0x0f, 0x29, 0xf5, 0x88, 0x52,
-	0xff, 0x3a, 0xa2, 0x3c, 0xec, 0xfd, 0xf3, 0x67, 0xaf, 0x63, 0xcd, 0x3f, 0xd5, 0xb2, 0xd9, 0xda,
-	0x2f, 0x7f, 0xf5, 0xa4, 0xd9, 0xb6, 0xbf, 0x71, 0xb0, 0x11, 0xd7, 0xa1, 0x35, 0xe5, 0xb7, 0x39,
-	0xc3, 0xe4, 0x16, 0x93, 0xb1, 0xeb, 0x5c, 0xd9, 0x0b, 0xd4, 0x86, 0x86, 0x4d, 0xe9, 0x1a, 0x13,
-	0x7e, 0x34, 0xfe, 0xa5, 0x5d, 0x80, 0x7a, 0x86, 0x59, 0x5e, 0xca, 0x0c, 0xff, 0xb8, 0xc6, 0x94,
-	0xa1, 0x4f, 0xa0, 0x61, 0x06, 0x81, 0x20, 0xf3, 0xf9, 0x48, 0xd5, 0x63, 0xf9, 0x72, 0xd3, 0x38,
-	0x5a, 0xdb, 0x81, 0x5e, 0x61, 0x65, 0xea, 0xb9, 0x0e, 0xc5, 0x5a, 0x1f, 0xd4, 0x49, 0x29, 0xb9,
-	0xf6, 0x2d, 0xf4, 0x26, 0xe5, 0x45, 0x9e, 0x7c, 0xbe, 0x9f, 0x25, 0x78, 0x31, 0x3d, 0x3a, 0x19,
-	0xbb, 0x8e, 0x83, 0x4d, 0xe6, 0x12, 0xf4, 0x16, 0x54, 0x6d, 0x8b, 0xcb, 0x53, 0xb5, 0x2d, 0x84,
-	0xa0, 0xee, 0x18, 0x2b, 0x1c, 0x34, 0xaa, 0x39, 0x0b, 0xd6, 0x7e, 0x8c, 0xdd, 0x79, 0x58, 0xae,
-	0x85, 0x31, 0x7f, 0x8d, 0xf6, 0x60, 0x2b, 0x2c, 0xf9, 0x0d, 0x26, 0xd4, 0x76, 0x1d, 0xb9, 0xde,
-	0x97, 0x06, 0xb5, 0xd9, 0x66, 0x10, 0xa9, 0x00, 0x37, 0xd4, 0x75, 0xc2, 0x43, 0xc8, 0xcf, 0x82,
-	0x7c, 0x21, 0xa2, 0x9d, 0x42, 0x77, 0x4c, 0xb0, 0xc1, 0xb0, 0x78, 0xa6, 0xa8, 0x07, 0x1f, 0x43,
-	0xd3, 0x8c, 0x62, 0xfc, 0x9a, 0x6d, 0xe1, 0x9a, 0x62, 0x46, 0x02, 0xd4, 0x5e, 0x81, 0x92, 0x57,
-	0x92, 0x8b, 0x7f, 0x0a, 0xdd, 0x73, 0xcf, 0x7a, 0xdd, 0x84, 0x79, 0x25, 0x39, 0xa1, 0x02, 0xf2,
-	0x57, 0x36, 0x65, 0xe2, 0x1e, 0x8d, 0xfa, 0x7c, 0x06, 0xdd, 0x9c, 0xbd, 0xb8, 0xc3, 0x10, 0x73,
-	0x50, 0x59, 0xea, 0xd7, 0x4a, 0x4e, 0x23, 0x20, 0xb5, 0x01, 0xb4, 0xfd, 0xe1, 0xc9, 0xb9, 0x5e,
-	0xaa, 0xd5, 0xda, 0x31, 0x74, 0x32, 0x48, 0x4e, 0xfe, 0x34, 0x25, 0x3e, 0x80, 0xee, 0x11, 0x5e,
-	0xe2, 0x7c, 0x71, 0xd3, 0xec, 0xaf, 0x40, 0xc9, 0x03, 0x73, 0xd9, 0x7e, 0x95, 0x00, 0x8e, 0xa7,
-	0x47, 0xe3, 0xf1, 0xd2, 0xc6, 0x4e, 0x26, 0x19, 0xed, 0xc2, 0x16, 0xc1, 0x96, 0x4d, 0xb0, 0xc9,
-	0x2e, 0xd7, 0xc4, 0xa6, 0x72, 0xb5, 0x5f, 0x1b, 0x34, 0x67, 0x2f, 0xa2, 0xe0, 0x39, 0xb1, 0xa9,
-	0x0f, 0x62, 0x64, 0x4d, 0x19, 0xb6, 0x2e, 0x3d, 0x8c, 0x09, 0x95, 0x6b, 0x21, 0x88, 0x07, 0x4f,
-	0xfc, 0x58, 0x3c, 0xef, 0x75, 0x61, 0xde, 0xdb, 0xd0, 0xa0, 0xd8, 0x24, 0x98, 0xf1, 0x89, 0xe5,
-	0x5f, 0xda, 0x04, 0x3a, 0xe1, 0x68, 0x25, 0x27, 0x8b, 0x6e, 0xf7, 0x21, 0x34, 0xcc, 0x20, 0xc0,
-	0xd5, 0x6a, 0x25, 0x6a, 0x09, 0x60, 0x8e, 0xd1, 0xbe, 0x04, 0x39, 0x5b, 0x88, 0x4b, 0xff, 0xdf,
-	0x2a, 0xbd, 0x0f, 0xad, 0x09, 0x66, 0xd9, 0xf3, 0xa4, 0xd5, 0xfe, 0x1c, 0xde, 0x4d, 0xe1, 0x9e,
-	0x44, 0x27, 0x43, 0xdb, 0x9f, 0xd8, 0x64, 0x27, 0x9e, 0xe5, 0x29, 0x74, 0x32, 0x3b, 0x9c, 0x42,
-	0x87, 0x37, 0xc2, 0xf4, 0x68, 0x8c, 0xf3, 0x39, 0x22, 0x90, 0x2f, 0x73, 0xf8, 0xa0, 0xfe, 0xaf,
-	0xcc, 0x0a, 0xc8, 0xd9, 0x42, 0x7c, 0xc0, 0xf6, 0xa1, 0x13, 0x8e, 0xdf, 0xc3, 0xda, 0x29, 0x20,
-	0x67, 0xa1, 0xbc, 0x0c, 0x82, 0x97, 0xe1, 0xde, 0xc1, 0x72, 0x19, 0x49, 0xf1, 0x0e, 0x6c, 0x0b,
-	0xb1, 0x10, 0x38, 0xfa, 0xa3, 0x09, 0xb5, 0x83, 0x93, 0x29, 0xf2, 0xa0, 0x53, 0xf0, 0x03, 0x81,
-	0x06, 0xc9, 0x65, 0xca, 0x7f, 0x9d, 0x94, 0xfd, 0x47, 0x20, 0xf9, 0x01, 0x2b, 0x3e, 0xe3, 0xe4,
-	0x61, 0xc6, 0xc9, 0xa3, 0x19, 0x27, 0x0f, 0x32, 0x1a, 0x80, 0xb2, 0x16, 0x8c, 0x76, 0x93, 0x12,
-	0x85, 0x9e, 0xaf, 0xec, 0x95, 0x83, 0x44, 0x8a, 0xac, 0xe9, 0x8a, 0x14, 0x85, 0x2e, 0x2f, 0x52,
-	0x94, 0xf8, 0x76, 0x05, 0xfd, 0x00, 0xdb, 0x19, 0x77, 0x46, 0x5a, 0x92, 0x5c, 0x64, 0xeb, 0xca,
-	0x6e, 0x29, 0x26, 0xae, 0x7f, 0x01, 0x6f, 0xa7, 0xec, 0x17, 0xf5, 0x37, 0x55, 0xce, 0x39, 0xfc,
-	0x4e, 0x09, 0x42, 0x14, 0x27, 0x6b, 0xad, 0xa2, 0x38, 0x85, 0x2e, 0x2d, 0x8a, 0x53, 0xe2, 0xce,
-	0x15, 0xf4, 0x3d, 0xbc, 0x4c, 0x3b, 0x18, 0xda, 0x49, 0xf7, 0x2e, 0xf3, 0xb4, 0x14, 0xad, 0x0c,
-	0x22, 0x16, 0x4f, 0xbf, 0x5b, 0xb1, 0x78, 0x81, 0x39, 0x88, 0xc5, 0x0b, 0x9f, 0x7d, 0x05, 0xcd,
-	0x60, 0x6b, 0xc3, 0x09, 0x91, 0xba, 0x21, 0x69, 0xb6, 0x6c, 0xaf, 0x70, 0x5f, 0x6c, 0x65, 0xca,
-	0xfc, 0xc4, 0x56, 0xe6, 0x3b, 0xa6, 0xd8, 0xca, 0x02, 0xe7, 0x0c, 0xa5, 0x48, 0x7b, 0x8f, 0x28,
-	0x45, 0x81, 0x85, 0x89, 0x52, 0x14, 0x5a, 0x57, 0x05, 0x7d, 0x01, 0xcd, 0xd8, 0xa8, 0x90, 0x92,
-	0x4e, 0x49, 0x1c, 0x4d, 0x79, 0x2f, 0x77, 0x2f, 0xaa, 0x73, 0xf8, 0xd9, 0x6f, 0xf7, 0xaa, 0xf4,
-	0xfb, 0xbd, 0x2a, 0xfd, 0x7d, 0xaf, 0x4a, 0xdf, 0xe9, 0x0b, 0x9b, 0x5d, 0xaf, 0xe7, 0xba, 0xe9,
-	0xae, 0x86, 0x9e, 0x61, 0x5e, 0xdf, 0x59, 0x98, 0x88, 0xab, 0xdb, 0xd1, 0x90, 0x12, 0x33, 0xfe,
-	0x3b, 0x32, 0x6f, 0x04, 0x7f, 0x02, 0x3e, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0x79, 0xfc, 0xea,
-	0x93, 0xaa, 0x0c, 0x00, 0x00,
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x4d, 0xb7, 0xdb, 0x9c, 0xb6, 0xbb, 0xed, 0x6c, 0x36, 0x71, 0x67, 0x43, 0x92, 0x4e,
+	0x23, 0x94, 0x2e, 0x28, 0x91, 0x0a, 0x42, 0x02, 0xc1, 0x45, 0x9b, 0x96, 0x10, 0x69, 0xa5, 0xed,
+	0xba, 0x14, 0x2d, 0x20, 0x51, 0x39, 0xf6, 0x6c, 0xea, 0x55, 0x62, 0x07, 0xcf, 0x64, 0xa5, 0x3e,
+	0x00, 0x77, 0x5c, 0x20, 0x9e, 0x85, 0x87, 0xe0, 0x92, 0x27, 0x58, 0x50, 0x1f, 0x61, 0x9f, 0x00,
+	0xd9, 0x1e, 0xdb, 0x13, 0xff, 0x35, 0x14, 0xee, 0xc6, 0xe7, 0x7c, 0xf3, 0x7d, 0x33, 0xdf, 0x9c,
+	0x9c, 0xa3, 0x40, 0xcd, 0x32, 0xa9, 0xcd, 0x2d, 0x7e, 0xdd, 0x0b, 0x17, 0xdd, 0x99, 0xeb, 0x70,
+	0x07, 0xad, 0x87, 0xdf, 0xb8, 0x39, 0x76, 0x9c, 0xf1, 0x84, 0xf6, 0xfc, 0xf8, 0x68, 0xfe, 0xaa,
+	0xc7, 0xad, 0x29, 0x65, 0x5c, 0x9f, 0xce, 0x02, 0x28, 0xae, 0x8c, 0x9d, 0xb1, 0xe3, 0x2f, 0x7b,
+	0xde, 0x2a, 0x88, 0x92, 0xdf, 0x15, 0x58, 0xbd, 0x60, 0xd4, 0x45, 0x15, 0xb8, 0x47, 0xa7, 0xba,
+	0x35, 0x51, 0x95, 0x96, 0xd2, 0x29, 0x6b, 0xc1, 0x07, 0x7a, 0x0d, 0x68, 0xa2, 0x33, 0x7e, 0xa9,
+	0xcf, 0xf9, 0x95, 0x27, 0x64, 0xe8, 0x9c, 0x9a, 0xea, 0x4a, 0x4b, 0xe9, 0x6c, 0x1c, 0xe2, 0x6e,
+	0x20, 0xd9, 0x0d, 0x25, 0xbb, 0xdf, 0x84, 0x92, 0xc7, 0xcd, 0x77, 0x6f, 0x9b, 0x35, 0x73, 0xf4,
+	0x39, 0x49, 0xef, 0x26, 0xbf, 0xfe, 0xd5, 0x54, 0xb4, 0x1d, 0x2f, 0x71, 0x24, 0xc7, 0xd1, 0x53,
+	0xb8, 0x4f, 0x6d, 0x7d, 0x34, 0xa1, 0xa6, 0x5a, 0x6a, 0x29, 0x9d, 0xf5, 0xe3, 0xed, 0x77, 0x6f,
+	0x9b, 0x9b, 0x1e, 0x89, 0x08, 0x13, 0x2d, 0x04, 0x90, 0x2e, 0x54, 0x86, 0xe2, 0xe6, 0xe7, 0xd4,
+	0x7d, 0x43, 0xdd, 0xbe, 0x63, 0xbf, 0xb2, 0xc6, 0xa8, 0x0a, 0x6b, 0x16, 0x63, 0x73, 0xea, 0x8a,
+	0x6b, 0x88, 0x2f, 0xf2, 0x12, 0x1a, 0xe7, 0x94, 0x67, 0x6d, 0xd1, 0xe8, 0x4f, 0x73, 0xca, 0x38,
+	0xfa, 0x14, 0xd6, 0x0c, 0x3f, 0xe0, 0xef, 0xdc, 0x38, 0x6c, 0x74, 0x23, 0xab, 0x33, 0xb7, 0x09,
+	0x34, 0xd9, 0x83, 0x66, 0x2e, 0x33, 0x9b, 0x39, 0x36, 0xa3, 0xa4, 0x05, 0x8d, 0x41, 0xa1, 0x38,
+	0xf9, 0x0e, 0x9a, 0x83, 0x62, 0x92, 0x3b, 0x9f, 0xef, 0x17, 0x05, 0x36, 0x87, 0x27, 0x67, 0x7d,
+	0xc7, 0xb6, 0xa9, 0xc1, 0x1d, 0x17, 0x3d, 0x80, 0x15, 0xcb, 0x14, 0xf6, 0xac, 0x58, 0x26, 0x42,
+	0xb0, 0x6a, 0xeb, 0x53, 0xea, 0x3f, 0x6a, 0x59, 0xf3, 0xd7, 0x5e, 0x8c, 0x5f, 0xcf, 0xa8, 0xff,
+	0x0e, 0x65, 0xcd, 0x5f, 0xa3, 0x36, 0x6c, 0x05, 0x94, 0xdf, 0x52, 0x97, 0x59, 0x8e, 0xad, 0xae,
+	0xb6, 0x94, 0x4e, 0x49, 0x5b, 0x0c, 0xa2, 0x06, 0xc0, 0x6b, 0xe6, 0xd8, 0xc1, 0x21, 0xd4, 0x7b,
+	0xfe, 0x7e, 0x29, 0x42, 0x5e, 0xc0, 0x6e, 0xdf, 0xa5, 0x3a, 0xa7, 0xf2, 0x99, 0xc2, 0x37, 0xf8,
+	0x04, 0xca, 0x46, 0x18, 0x13, 0xd7, 0xac, 0x4a, 0xd7, 0x94, 0x77, 0xc4, 0x40, 0x52, 0x07, 0x9c,
+	0x45, 0x29, 0xcc, 0x7f, 0x01, 0xbb, 0x17, 0x33, 0xf3, 0xff, 0x16, 0xcc, 0xa2, 0x14, 0x82, 0x18,
+	0xd4, 0x67, 0x16, 0xe3, 0x72, 0x8e, 0x85, 0xef, 0x7c, 0x0e, 0xbb, 0x19, 0xb9, 0xe8, 0x85, 0x21,
+	0xd2, 0x60, 0xaa, 0xd2, 0x2a, 0x15, 0x9c, 0x46, 0x42, 0x92, 0x0e, 0x54, 0xbd, 0xe2, 0xc9, 0xb8,
+	0x5e, 0xe2, 0xa9, 0xc9, 0x73, 0xa8, 0xa5, 0x90, 0x42, 0xfc, 0x6e, 0x4e, 0x7c, 0x08, 0xbb, 0x27,
+	0x74, 0x42, 0x39, 0x5d, 0x46, 0xbd, 0x0e, 0x38, 0x0b, 0x2c, 0x6c, 0xfb, 0x4d, 0x01, 0x78, 0x3e,
+	0x3c, 0xe9, 0xf7, 0x27, 0x16, 0xb5, 0x53, 0x9b, 0xd1, 0x3e, 0x6c, 0xb9, 0xd4, 0xb4, 0x5c, 0x6a,
+	0xf0, 0xcb, 0xb9, 0x6b, 0x31, 0x75, 0xa5, 0x55, 0xea, 0x94, 0xb5, 0xcd, 0x30, 0x78, 0xe1, 0x5a,
+	0xcc, 0x03, 0x71, 0x77, 0xce, 0x38, 0x35, 0x2f, 0x67, 0x94, 0xba, 0x4c, 0x2d, 0x05, 0x20, 0x11,
+	0x3c, 0xf3, 0x62, 0x51, 0xbd, 0xaf, 0x4a, 0xf5, 0x5e, 0x85, 0x35, 0x46, 0x0d, 0x97, 0x72, 0x51,
+	0xb1, 0xe2, 0x8b, 0x0c, 0xa0, 0x16, 0x94, 0x56, 0x7c, 0xb2, 0xf0, 0x76, 0x1f, 0xc1, 0x9a, 0xe1,
+	0x07, 0x84, 0x5b, 0x95, 0xd8, 0x2d, 0x09, 0x2c, 0x30, 0xe4, 0x6b, 0x50, 0xd3, 0x44, 0xc2, 0xfa,
+	0x7f, 0xc7, 0xf4, 0x01, 0x54, 0x06, 0x94, 0xa7, 0xcf, 0x93, 0x74, 0xfb, 0x14, 0x1e, 0x27, 0x70,
+	0x77, 0x92, 0x53, 0xa1, 0xea, 0x55, 0x6c, 0x9c, 0x89, 0x6a, 0x79, 0x08, 0xb5, 0x54, 0x46, 0x48,
+	0x74, 0xe1, 0x7e, 0xb0, 0x3d, 0x2c, 0xe3, 0x6c, 0x8d, 0x10, 0xe4, 0xd9, 0x1c, 0xfc, 0xa0, 0xfe,
+	0xab, 0xcd, 0x18, 0xd4, 0x34, 0x91, 0x28, 0xb0, 0x03, 0xa8, 0x05, 0xe5, 0x77, 0xbb, 0x77, 0x18,
+	0xd4, 0x34, 0x54, 0xd0, 0x7c, 0x09, 0xdb, 0xde, 0xb5, 0xbd, 0x99, 0x19, 0x5a, 0x81, 0x0e, 0x60,
+	0xdb, 0xb2, 0x8d, 0xc9, 0xdc, 0xa4, 0x97, 0xa6, 0xc5, 0x82, 0x11, 0xe6, 0xb1, 0xad, 0x6b, 0x0f,
+	0x45, 0xfc, 0x44, 0x84, 0xc9, 0x67, 0xb0, 0x23, 0x6d, 0x17, 0x7e, 0xb5, 0xe1, 0xde, 0xdc, 0x0b,
+	0x08, 0xb7, 0x1e, 0xc4, 0x77, 0xf4, 0x70, 0x5a, 0x90, 0x24, 0x4f, 0x01, 0x09, 0x1a, 0x3f, 0x2a,
+	0xb4, 0x33, 0xe7, 0x36, 0x79, 0x0c, 0x8f, 0x16, 0xb0, 0x91, 0x07, 0x3b, 0xa7, 0xf6, 0x72, 0x0c,
+	0x15, 0x40, 0xa7, 0x76, 0x16, 0x41, 0xe0, 0xcc, 0x52, 0x04, 0x32, 0x54, 0x10, 0x20, 0xd8, 0x0e,
+	0xa2, 0x47, 0x93, 0x49, 0x58, 0x49, 0x8f, 0x60, 0x47, 0x8a, 0x05, 0xc0, 0xc3, 0x9f, 0x37, 0xa1,
+	0x74, 0x74, 0x36, 0x44, 0x33, 0xa8, 0xe5, 0xcc, 0x57, 0xd4, 0x89, 0x7d, 0x2a, 0x1e, 0xee, 0xf8,
+	0x60, 0x09, 0xa4, 0x38, 0xe0, 0x7b, 0x68, 0x16, 0x74, 0xc9, 0x5b, 0x14, 0x07, 0x4b, 0x2b, 0x0e,
+	0x6e, 0x55, 0xd4, 0x01, 0xa5, 0x27, 0x18, 0xda, 0x8f, 0x29, 0x72, 0x47, 0x26, 0x6e, 0x17, 0x83,
+	0x64, 0x89, 0xf4, 0xcc, 0x92, 0x25, 0x72, 0x87, 0x24, 0x6e, 0x17, 0x83, 0x22, 0x89, 0x1f, 0x83,
+	0xd2, 0x96, 0xb3, 0x0c, 0x91, 0x78, 0x73, 0xde, 0x54, 0xc4, 0xfb, 0x85, 0x98, 0x88, 0xff, 0x25,
+	0x3c, 0x4c, 0x4c, 0x2f, 0xd4, 0x5a, 0x74, 0x39, 0xe3, 0xf0, 0x7b, 0x05, 0x08, 0xd9, 0x9c, 0xf4,
+	0x64, 0x92, 0xcd, 0xc9, 0x1d, 0x72, 0xb8, 0x5d, 0x0c, 0x8a, 0x24, 0x7e, 0x80, 0xed, 0xe4, 0x00,
+	0x40, 0x7b, 0xc9, 0xb7, 0x4b, 0x75, 0x26, 0x4c, 0x8a, 0x20, 0x32, 0x79, 0xb2, 0xed, 0xc9, 0xe4,
+	0x39, 0xbd, 0x15, 0x93, 0x22, 0x48, 0x44, 0xae, 0xc1, 0xd6, 0xc2, 0x20, 0x41, 0x8d, 0x05, 0x4b,
+	0xd3, 0xb4, 0xcd, 0xdc, 0xbc, 0xfc, 0x94, 0x89, 0xd9, 0x21, 0x3f, 0x65, 0xf6, 0xc0, 0xc1, 0x7b,
+	0x05, 0x08, 0xd9, 0x8a, 0x64, 0xeb, 0x96, 0xad, 0xc8, 0x99, 0x00, 0x98, 0x14, 0x41, 0x22, 0xf2,
+	0xaf, 0xa0, 0x1c, 0x35, 0x6f, 0x84, 0x17, 0x8f, 0x23, 0x0f, 0x04, 0xfc, 0x24, 0x33, 0x17, 0xf1,
+	0x3c, 0x83, 0x0d, 0xa9, 0x3b, 0xa3, 0xba, 0x24, 0x9e, 0x6a, 0xf0, 0xf8, 0xfd, 0x9c, 0x6c, 0xc4,
+	0x36, 0x04, 0x88, 0x3b, 0x35, 0x92, 0xa4, 0x53, 0xad, 0x1e, 0xd7, 0xb3, 0x93, 0x32, 0x55, 0xdc,
+	0xb3, 0x65, 0xaa, 0x54, 0xd3, 0xc7, 0xf5, 0xec, 0xa4, 0xec, 0x55, 0xd4, 0xd4, 0x65, 0xaf, 0x92,
+	0xdd, 0x1f, 0x3f, 0xc9, 0xcc, 0x85, 0x3c, 0xc7, 0x5f, 0xfc, 0x71, 0xd3, 0x50, 0xfe, 0xbc, 0x69,
+	0x28, 0x7f, 0xdf, 0x34, 0x94, 0xef, 0xbb, 0x63, 0x8b, 0x5f, 0xcd, 0x47, 0x5d, 0xc3, 0x99, 0xf6,
+	0x66, 0xba, 0x71, 0x75, 0x6d, 0x52, 0x57, 0x5e, 0xbd, 0x39, 0xec, 0x31, 0xd7, 0x88, 0xfe, 0x25,
+	0x8f, 0xd6, 0xfc, 0xff, 0xa6, 0x1f, 0xff, 0x33, 0x00, 0x46, 0xe8, 0x00, 0x30, 0x41, 0x0f, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1558,6 +1941,10 @@ type APIClient interface {
 	GetOIDCClient(ctx context.Context, in *GetOIDCClientRequest, opts ...grpc.CallOption) (*GetOIDCClientResponse, error)
 	ListOIDCClients(ctx context.Context, in *ListOIDCClientsRequest, opts ...grpc.CallOption) (*ListOIDCClientsResponse, error)
 	DeleteOIDCClient(ctx context.Context, in *DeleteOIDCClientRequest, opts ...grpc.CallOption) (*DeleteOIDCClientResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error)
+	EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*DeleteAllResponse, error)
 }
 
@@ -1677,6 +2064,42 @@ func (c *aPIClient) DeleteOIDCClient(ctx context.Context, in *DeleteOIDCClientRe
 	return out, nil
 }
 
+func (c *aPIClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/identity.API/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error) {
+	out := new(DisableUserResponse)
+	err := c.cc.Invoke(ctx, "/identity.API/DisableUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error) {
+	out := new(EnableUserResponse)
+	err := c.cc.Invoke(ctx, "/identity.API/EnableUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/identity.API/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*DeleteAllResponse, error) {
 	out := new(DeleteAllResponse)
 	err := c.cc.Invoke(ctx, "/identity.API/DeleteAll", in, out, opts...)
@@ -1700,6 +2123,10 @@ type APIServer interface {
 	GetOIDCClient(context.Context, *GetOIDCClientRequest) (*GetOIDCClientResponse, error)
 	ListOIDCClients(context.Context, *ListOIDCClientsRequest) (*ListOIDCClientsResponse, error)
 	DeleteOIDCClient(context.Context, *DeleteOIDCClientRequest) (*DeleteOIDCClientResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error)
+	EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	DeleteAll(context.Context, *DeleteAllRequest) (*DeleteAllResponse, error)
 }
 
@@ -1743,8 +2170,20 @@ func (*UnimplementedAPIServer) ListOIDCClients(ctx context.Context, req *ListOID
 func (*UnimplementedAPIServer) DeleteOIDCClient(ctx context.Context, req *DeleteOIDCClientRequest) (*DeleteOIDCClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOIDCClient not implemented")
 }
-func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*DeleteAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+func (*UnimplementedAPIServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedAPIServer) DisableUser(ctx context.Context, req *DisableUserRequest) (*DisableUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUser not implemented")
+}
+func (*UnimplementedAPIServer) EnableUser(ctx context.Context, req *EnableUserRequest) (*EnableUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
+}
+func (*UnimplementedAPIServer) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*DeleteAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -1967,6 +2406,78 @@ func _API_DeleteOIDCClient_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/identity.API/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DisableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DisableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/identity.API/DisableUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DisableUser(ctx, req.(*DisableUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_EnableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).EnableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/identity.API/EnableUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).EnableUser(ctx, req.(*EnableUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/identity.API/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAllRequest)
 	if err := dec(in); err != nil {
@@ -2037,6 +2548,22 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOIDCClient",
 			Handler:    _API_DeleteOIDCClient_Handler,
 		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _API_ListUsers_Handler,
+		},
+		{
+			MethodName: "DisableUser",
+			Handler:    _API_DisableUser_Handler,
+		},
+		{
+			MethodName: "EnableUser",
+			Handler:    _API_EnableUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _API_DeleteUser_Handler,
+		},
 		{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
@@ -2070,6 +2597,16 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.LastAuthenticated != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAuthenticated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAuthenticated):])
 		if err1 != nil {
@@ -3062,7 +3599,7 @@ func (m *DeleteOIDCClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListUsersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3072,12 +3609,12 @@ func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListUsersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3086,10 +3623,20 @@ func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IncludeDisabled {
+		i--
+		if m.IncludeDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListUsersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3099,12 +3646,12 @@ func (m *DeleteAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListUsersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListUsersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3113,101 +3660,355 @@ func (m *DeleteAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIdentity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIdentity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIdentity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DisableUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DisableUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	if m.LastAuthenticated != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAuthenticated)
-		n += 1 + l + sovIdentity(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IdentityServerConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DisableUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SetIdentityServerConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EnableUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovIdentity(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SetIdentityServerConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EnableUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetIdentityServerConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetIdentityServerConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *DeleteUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIdentity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIdentity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.LastAuthenticated != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAuthenticated)
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IdentityServerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIdentityServerConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIdentityServerConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetIdentityServerConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetIdentityServerConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Config != nil {
 		l = m.Config.Size()
@@ -3582,93 +4383,210 @@ func (m *DeleteOIDCClientResponse) Size() (n int) {
 	return n
 }
 
-func (m *DeleteAllRequest) Size() (n int) {
+func (m *ListUsersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.IncludeDisabled {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DeleteAllResponse) Size() (n int) {
+func (m *ListUsersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovIdentity(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovIdentity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DisableUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozIdentity(x uint64) (n int) {
-	return sovIdentity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DisableUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *User) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIdentity
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			if postIndex > l {
+
+func (m *EnableUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnableUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovIdentity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIdentity(x uint64) (n int) {
+	return sovIdentity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.Email = string(dAtA[iNdEx:postIndex])
@@ -3709,6 +4627,26 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -4615,7 +5553,607 @@ func (m *ListIDPConnectorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListIDPConnectorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListIDPConnectorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connectors = append(m.Connectors, &IDPConnector{})
+			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetIDPConnectorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetIDPConnectorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connector == nil {
+				m.Connector = &IDPConnector{}
+			}
+			if err := m.Connector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteIDPConnectorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteIDPConnectorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OIDCClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectUris = append(m.RedirectUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedPeers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedPeers = append(m.TrustedPeers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4638,15 +6176,15 @@ func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListIDPConnectorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateOIDCClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListIDPConnectorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connectors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4673,8 +6211,10 @@ func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Connectors = append(m.Connectors, &IDPConnector{})
-			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Client == nil {
+				m.Client = &OIDCClient{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4700,7 +6240,7 @@ func (m *ListIDPConnectorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,17 +6263,17 @@ func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetIDPConnectorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -4743,23 +6283,27 @@ func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Client == nil {
+				m.Client = &OIDCClient{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4783,7 +6327,7 @@ func (m *GetIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4806,17 +6350,17 @@ func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetIDPConnectorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -4826,27 +6370,23 @@ func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Connector == nil {
-				m.Connector = &IDPConnector{}
-			}
-			if err := m.Connector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4870,7 +6410,7 @@ func (m *GetIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4893,17 +6433,17 @@ func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteIDPConnectorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteIDPConnectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -4913,23 +6453,27 @@ func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Client == nil {
+				m.Client = &OIDCClient{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4953,7 +6497,7 @@ func (m *DeleteIDPConnectorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteIDPConnectorResponse) Unmarshal(dAtA []byte) error {
+func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4976,10 +6520,10 @@ func (m *DeleteIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteIDPConnectorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOIDCClientsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteIDPConnectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOIDCClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5004,7 +6548,7 @@ func (m *DeleteIDPConnectorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OIDCClient) Unmarshal(dAtA []byte) error {
+func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5027,17 +6571,17 @@ func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OIDCClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOIDCClientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OIDCClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOIDCClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -5047,93 +6591,82 @@ func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedirectUris", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Clients = append(m.Clients, &OIDCClient{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedirectUris = append(m.RedirectUris, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustedPeers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustedPeers = append(m.TrustedPeers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateOIDCClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -5143,56 +6676,79 @@ func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			if m.Client == nil {
+				m.Client = &OIDCClient{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secret = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateOIDCClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5215,7 +6771,7 @@ func (m *OIDCClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5238,17 +6794,17 @@ func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteOIDCClientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -5258,27 +6814,23 @@ func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &OIDCClient{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5302,7 +6854,7 @@ func (m *CreateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5325,48 +6877,12 @@ func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteOIDCClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Client == nil {
-				m.Client = &OIDCClient{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5389,7 +6905,7 @@ func (m *CreateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5412,17 +6928,17 @@ func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDisabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -5432,24 +6948,12 @@ func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IncludeDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5472,7 +6976,7 @@ func (m *GetOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *ListUsersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5495,15 +6999,15 @@ func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUsersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUsersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5530,10 +7034,8 @@ func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &OIDCClient{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5559,7 +7061,7 @@ func (m *GetOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
+func (m *DisableUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5582,12 +7084,44 @@ func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListOIDCClientsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListOIDCClientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5610,7 +7144,7 @@ func (m *ListOIDCClientsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
+func (m *DisableUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5633,46 +7167,12 @@ func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListOIDCClientsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListOIDCClientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIdentity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIdentity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Clients = append(m.Clients, &OIDCClient{})
-			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -5695,7 +7195,7 @@ func (m *ListOIDCClientsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *EnableUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5718,17 +7218,17 @@ func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -5738,27 +7238,23 @@ func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &OIDCClient{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5782,7 +7278,7 @@ func (m *UpdateOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *EnableUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5805,10 +7301,10 @@ func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5833,7 +7329,7 @@ func (m *UpdateOIDCClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteUserRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5856,15 +7352,15 @@ func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteOIDCClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteUserRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteOIDCClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5892,7 +7388,7 @@ func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5916,7 +7412,7 @@ func (m *DeleteOIDCClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteOIDCClientResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteUserResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5939,10 +7435,10 @@ func (m *DeleteOIDCClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteOIDCClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteUserResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteOIDCClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
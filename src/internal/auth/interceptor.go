@@ -97,6 +97,10 @@ var authHandlers = map[string]authHandler{
 	"/identity.API/GetOIDCClient":           clusterPermissions(auth.Permission_CLUSTER_IDENTITY_GET_OIDC_CLIENT),
 	"/identity.API/ListOIDCClients":         clusterPermissions(auth.Permission_CLUSTER_IDENTITY_LIST_OIDC_CLIENTS),
 	"/identity.API/DeleteOIDCClient":        clusterPermissions(auth.Permission_CLUSTER_IDENTITY_DELETE_OIDC_CLIENT),
+	"/identity.API/ListUsers":               clusterPermissions(auth.Permission_CLUSTER_IDENTITY_LIST_USERS),
+	"/identity.API/DisableUser":             clusterPermissions(auth.Permission_CLUSTER_IDENTITY_DISABLE_USER),
+	"/identity.API/EnableUser":              clusterPermissions(auth.Permission_CLUSTER_IDENTITY_DISABLE_USER),
+	"/identity.API/DeleteUser":              clusterPermissions(auth.Permission_CLUSTER_IDENTITY_DELETE_USER),
 	"/identity.API/DeleteAll":               clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL),
 
 	//
@@ -108,6 +112,7 @@ var authHandlers = map[string]authHandler{
 	"/license.API/UpdateCluster":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_UPDATE_CLUSTER)),
 	"/license.API/DeleteCluster":     authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_DELETE_CLUSTER)),
 	"/license.API/ListClusters":      authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_LIST_CLUSTERS)),
+	"/license.API/SetUsageLimits":    authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_LICENSE_SET_LIMITS)),
 	"/license.API/DeleteAll":         authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 	// Heartbeat relies on the shared secret generated at cluster registration-time
 	"/license.API/Heartbeat": unauthenticated,
@@ -117,36 +122,48 @@ var authHandlers = map[string]authHandler{
 	//
 
 	// TODO: Add methods to handle repo permissions
-	"/pfs.API/ActivateAuth":    clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pfs.API/CreateRepo":      authDisabledOr(authenticated),
-	"/pfs.API/InspectRepo":     authDisabledOr(authenticated),
-	"/pfs.API/ListRepo":        authDisabledOr(authenticated),
-	"/pfs.API/DeleteRepo":      authDisabledOr(authenticated),
-	"/pfs.API/StartCommit":     authDisabledOr(authenticated),
-	"/pfs.API/FinishCommit":    authDisabledOr(authenticated),
-	"/pfs.API/InspectCommit":   authDisabledOr(authenticated),
-	"/pfs.API/ListCommit":      authDisabledOr(authenticated),
-	"/pfs.API/SquashCommit":    authDisabledOr(authenticated),
-	"/pfs.API/FlushCommit":     authDisabledOr(authenticated),
-	"/pfs.API/SubscribeCommit": authDisabledOr(authenticated),
-	"/pfs.API/ClearCommit":     authDisabledOr(authenticated),
-	"/pfs.API/CreateBranch":    authDisabledOr(authenticated),
-	"/pfs.API/InspectBranch":   authDisabledOr(authenticated),
-	"/pfs.API/ListBranch":      authDisabledOr(authenticated),
-	"/pfs.API/DeleteBranch":    authDisabledOr(authenticated),
-	"/pfs.API/ModifyFile":      authDisabledOr(authenticated),
-	"/pfs.API/GetFile":         authDisabledOr(authenticated),
-	"/pfs.API/InspectFile":     authDisabledOr(authenticated),
-	"/pfs.API/ListFile":        authDisabledOr(authenticated),
-	"/pfs.API/WalkFile":        authDisabledOr(authenticated),
-	"/pfs.API/GlobFile":        authDisabledOr(authenticated),
-	"/pfs.API/DiffFile":        authDisabledOr(authenticated),
-	"/pfs.API/DeleteAll":       authDisabledOr(authenticated),
-	"/pfs.API/Fsck":            authDisabledOr(authenticated),
-	"/pfs.API/CreateFileset":   authDisabledOr(authenticated),
-	"/pfs.API/GetFileset":      authDisabledOr(authenticated),
-	"/pfs.API/AddFileset":      authDisabledOr(authenticated),
-	"/pfs.API/RenewFileset":    authDisabledOr(authenticated),
+	"/pfs.API/ActivateAuth":       clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pfs.API/CreateRepo":         authDisabledOr(authenticated),
+	"/pfs.API/InspectRepo":        authDisabledOr(authenticated),
+	"/pfs.API/ListRepo":           authDisabledOr(authenticated),
+	"/pfs.API/DeleteRepo":         authDisabledOr(authenticated),
+	"/pfs.API/RenameRepo":         authDisabledOr(authenticated),
+	"/pfs.API/StartCommit":        authDisabledOr(authenticated),
+	"/pfs.API/FinishCommit":       authDisabledOr(authenticated),
+	"/pfs.API/InspectCommit":      authDisabledOr(authenticated),
+	"/pfs.API/ListCommit":         authDisabledOr(authenticated),
+	"/pfs.API/SquashCommit":       authDisabledOr(authenticated),
+	"/pfs.API/FlushCommit":        authDisabledOr(authenticated),
+	"/pfs.API/SubscribeCommit":    authDisabledOr(authenticated),
+	"/pfs.API/ClearCommit":        authDisabledOr(authenticated),
+	"/pfs.API/CreateBranch":       authDisabledOr(authenticated),
+	"/pfs.API/InspectBranch":      authDisabledOr(authenticated),
+	"/pfs.API/ListBranch":         authDisabledOr(authenticated),
+	"/pfs.API/DeleteBranch":       authDisabledOr(authenticated),
+	"/pfs.API/RenameBranch":       authDisabledOr(authenticated),
+	"/pfs.API/SetRetentionPolicy": authDisabledOr(authenticated),
+	"/pfs.API/EnforceRetention":   authDisabledOr(authenticated),
+	"/pfs.API/ForkRepo":           authDisabledOr(authenticated),
+	"/pfs.API/MergeBranch":        authDisabledOr(authenticated),
+	"/pfs.API/SetLabels":          authDisabledOr(authenticated),
+	"/pfs.API/CreateTag":          authDisabledOr(authenticated),
+	"/pfs.API/InspectTag":         authDisabledOr(authenticated),
+	"/pfs.API/ListTag":            authDisabledOr(authenticated),
+	"/pfs.API/DeleteTag":          authDisabledOr(authenticated),
+	"/pfs.API/ModifyFile":         authDisabledOr(authenticated),
+	"/pfs.API/GetFile":            authDisabledOr(authenticated),
+	"/pfs.API/InspectFile":        authDisabledOr(authenticated),
+	"/pfs.API/ListFile":           authDisabledOr(authenticated),
+	"/pfs.API/WalkFile":           authDisabledOr(authenticated),
+	"/pfs.API/GlobFile":           authDisabledOr(authenticated),
+	"/pfs.API/GrepFile":           authDisabledOr(authenticated),
+	"/pfs.API/DiffFile":           authDisabledOr(authenticated),
+	"/pfs.API/DeleteAll":          authDisabledOr(authenticated),
+	"/pfs.API/Fsck":               authDisabledOr(authenticated),
+	"/pfs.API/CreateFileset":      authDisabledOr(authenticated),
+	"/pfs.API/GetFileset":         authDisabledOr(authenticated),
+	"/pfs.API/AddFileset":         authDisabledOr(authenticated),
+	"/pfs.API/RenewFileset":       authDisabledOr(authenticated),
 
 	//
 	// PPS API
@@ -154,34 +171,36 @@ var authHandlers = map[string]authHandler{
 
 	// TODO: Add per-repo permissions checks for these
 	// TODO: split GetLogs into master and not-master and add check for pipeline permissions
-	"/pps.API/CreateJob":       authDisabledOr(authenticated),
-	"/pps.API/InspectJob":      authDisabledOr(authenticated),
-	"/pps.API/ListJob":         authDisabledOr(authenticated),
-	"/pps.API/ListJobStream":   authDisabledOr(authenticated),
-	"/pps.API/FlushJob":        authDisabledOr(authenticated),
-	"/pps.API/DeleteJob":       authDisabledOr(authenticated),
-	"/pps.API/StopJob":         authDisabledOr(authenticated),
-	"/pps.API/InspectDatum":    authDisabledOr(authenticated),
-	"/pps.API/ListDatum":       authDisabledOr(authenticated),
-	"/pps.API/ListDatumStream": authDisabledOr(authenticated),
-	"/pps.API/RestartDatum":    authDisabledOr(authenticated),
-	"/pps.API/CreatePipeline":  authDisabledOr(authenticated),
-	"/pps.API/InspectPipeline": authDisabledOr(authenticated),
-	"/pps.API/DeletePipeline":  authDisabledOr(authenticated),
-	"/pps.API/StartPipeline":   authDisabledOr(authenticated),
-	"/pps.API/StopPipeline":    authDisabledOr(authenticated),
-	"/pps.API/RunPipeline":     authDisabledOr(authenticated),
-	"/pps.API/RunCron":         authDisabledOr(authenticated),
-	"/pps.API/CreateSecret":    authDisabledOr(authenticated),
-	"/pps.API/DeleteSecret":    authDisabledOr(authenticated),
-	"/pps.API/ListSecret":      authDisabledOr(authenticated),
-	"/pps.API/InspectSecret":   authDisabledOr(authenticated),
-	"/pps.API/GetLogs":         authDisabledOr(authenticated),
-	"/pps.API/GarbageCollect":  authDisabledOr(authenticated),
-	"/pps.API/UpdateJobState":  authDisabledOr(authenticated),
-	"/pps.API/ListPipeline":    authDisabledOr(authenticated),
-	"/pps.API/ActivateAuth":    clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
-	"/pps.API/DeleteAll":       authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
+	"/pps.API/CreateJob":        authDisabledOr(authenticated),
+	"/pps.API/InspectJob":       authDisabledOr(authenticated),
+	"/pps.API/ListJob":          authDisabledOr(authenticated),
+	"/pps.API/ListJobStream":    authDisabledOr(authenticated),
+	"/pps.API/FlushJob":         authDisabledOr(authenticated),
+	"/pps.API/DeleteJob":        authDisabledOr(authenticated),
+	"/pps.API/StopJob":          authDisabledOr(authenticated),
+	"/pps.API/InspectDatum":     authDisabledOr(authenticated),
+	"/pps.API/ListDatum":        authDisabledOr(authenticated),
+	"/pps.API/ListDatumStream":  authDisabledOr(authenticated),
+	"/pps.API/FileLineage":      authDisabledOr(authenticated),
+	"/pps.API/RestartDatum":     authDisabledOr(authenticated),
+	"/pps.API/CreatePipeline":   authDisabledOr(authenticated),
+	"/pps.API/InspectPipeline":  authDisabledOr(authenticated),
+	"/pps.API/DeletePipeline":   authDisabledOr(authenticated),
+	"/pps.API/StartPipeline":    authDisabledOr(authenticated),
+	"/pps.API/StopPipeline":     authDisabledOr(authenticated),
+	"/pps.API/RunPipeline":      authDisabledOr(authenticated),
+	"/pps.API/RollbackPipeline": authDisabledOr(authenticated),
+	"/pps.API/RunCron":          authDisabledOr(authenticated),
+	"/pps.API/CreateSecret":     authDisabledOr(authenticated),
+	"/pps.API/DeleteSecret":     authDisabledOr(authenticated),
+	"/pps.API/ListSecret":       authDisabledOr(authenticated),
+	"/pps.API/InspectSecret":    authDisabledOr(authenticated),
+	"/pps.API/GetLogs":          authDisabledOr(authenticated),
+	"/pps.API/GarbageCollect":   authDisabledOr(authenticated),
+	"/pps.API/UpdateJobState":   authDisabledOr(authenticated),
+	"/pps.API/ListPipeline":     authDisabledOr(authenticated),
+	"/pps.API/ActivateAuth":     clusterPermissions(auth.Permission_CLUSTER_AUTH_ACTIVATE),
+	"/pps.API/DeleteAll":        authDisabledOr(clusterPermissions(auth.Permission_CLUSTER_DELETE_ALL)),
 
 	//
 	// TransactionAPI
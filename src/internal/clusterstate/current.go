@@ -59,4 +59,7 @@ var DesiredClusterState migrations.State = migrations.InitialState().
 	}).
 	Apply("create auth tokens table v0", func(ctx context.Context, env migrations.Env) error {
 		return auth.CreateAuthTokensTable(ctx, env.Tx)
+	}).
+	Apply("license clusters usage v0", func(ctx context.Context, env migrations.Env) error {
+		return license.AddClusterUsageColumns(ctx, env.Tx)
 	})
@@ -515,6 +515,21 @@ func (c *readonlyCollection) Get(key string, val proto.Message) error {
 	return proto.Unmarshal(resp.Kvs[0].Value, val)
 }
 
+// GetRev is like Get, but also returns the revision at which key was created.
+func (c *readonlyCollection) GetRev(key string, val proto.Message) (int64, error) {
+	if err := watch.CheckType(c.template, val); err != nil {
+		return 0, err
+	}
+	resp, err := c.get(c.Path(key))
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, ErrNotFound{c.prefix, key}
+	}
+	return resp.Kvs[0].CreateRevision, proto.Unmarshal(resp.Kvs[0].Value, val)
+}
+
 func (c *readonlyCollection) GetByIndex(index *Index, indexVal interface{}, val proto.Message, opts *Options, f func(key string) error) error {
 	span, _ := tracing.AddSpanToAnyExisting(c.ctx, "/etcd.RO/GetByIndex", "col", c.prefix, "index", index, "indexVal", indexVal)
 	defer tracing.FinishAnySpan(span)
@@ -631,6 +646,27 @@ func (c *readonlyCollection) ListRev(val proto.Message, opts *Options, f func(ke
 	})
 }
 
+// ListRevFrom is like ListRev, but starts listing at the objects created at
+// fromRev rather than at the first object. opts must sort by create revision,
+// and can't be self sorted.
+func (c *readonlyCollection) ListRevFrom(val proto.Message, opts *Options, fromRev int64, f func(key string, createRev int64) error) error {
+	span, _ := tracing.AddSpanToAnyExisting(c.ctx, "/etcd.RO/List", "col", c.prefix)
+	defer tracing.FinishAnySpan(span)
+	if err := watch.CheckType(c.template, val); err != nil {
+		return err
+	}
+	if opts.Target != etcd.SortByCreateRevision || opts.SelfSort {
+		return errors.Errorf("ListRevFrom must be sorted by create revision in etcd")
+	}
+	from, _ := listFuncs(opts)
+	return listRevision(c, c.prefix, &c.limit, opts, func(kv *mvccpb.KeyValue) error {
+		if err := proto.Unmarshal(kv.Value, val); err != nil {
+			return err
+		}
+		return f(strings.TrimPrefix(string(kv.Key), c.prefix), kv.CreateRevision)
+	}, from(&mvccpb.KeyValue{CreateRevision: fromRev}))
+}
+
 func (c *readonlyCollection) list(prefix string, limitPtr *int64, opts *Options, f func(*mvccpb.KeyValue) error) error {
 	if opts.SelfSort {
 		return listSelfSortRevision(c, prefix, limitPtr, opts, f)
@@ -676,6 +676,33 @@ func TestIteration(t *testing.T) {
 		}
 		require.Equal(t, numVals, len(vals), "didn't receive every value")
 	})
+	t.Run("list-from-rev", func(t *testing.T) {
+		uuidPrefix := uuid.NewWithoutDashes()
+		testCol := col.NewCollection(etcdClient, uuidPrefix, nil, &types.Empty{}, nil, nil)
+		numVals := 10
+		for i := 0; i < numVals; i++ {
+			_, err := col.NewSTM(context.Background(), etcdClient, func(stm col.STM) error {
+				return testCol.ReadWrite(stm).Put(fmt.Sprintf("%d", i), &types.Empty{})
+			})
+			require.NoError(t, err)
+		}
+		ro := testCol.ReadOnly(context.Background())
+		val := &types.Empty{}
+		rev, err := ro.GetRev("4", val)
+		require.NoError(t, err)
+		var keys []string
+		require.NoError(t, ro.ListRevFrom(val, col.DefaultOptions, rev, func(key string, createRev int64) error {
+			keys = append(keys, key)
+			return nil
+		}))
+		require.Equal(t, []string{"4", "3", "2", "1", "0"}, keys)
+		keys = nil
+		require.NoError(t, ro.ListRevFrom(val, &col.Options{Target: etcd.SortByCreateRevision, Order: etcd.SortAscend}, rev, func(key string, createRev int64) error {
+			keys = append(keys, key)
+			return nil
+		}))
+		require.Equal(t, []string{"4", "5", "6", "7", "8", "9"}, keys)
+	})
 }
 
 func getEtcdClient(t *testing.T) *etcd.Client {
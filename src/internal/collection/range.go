@@ -54,8 +54,10 @@ func listFuncs(opts *Options) (func(*mvccpb.KeyValue) etcd.OpOption, func(kv1 *m
 	return from, compare
 }
 
-func listRevision(c *readonlyCollection, prefix string, limitPtr *int64, opts *Options, f func(*mvccpb.KeyValue) error) error {
-	etcdOpts := []etcd.OpOption{etcd.WithPrefix(), etcd.WithSort(opts.Target, opts.Order)}
+// listRevision lists the keys under prefix in revision order, in batches.
+// startOpts, if any, restrict the first batch, such as to start at a revision.
+func listRevision(c *readonlyCollection, prefix string, limitPtr *int64, opts *Options, f func(*mvccpb.KeyValue) error, startOpts ...etcd.OpOption) error {
+	etcdOpts := append([]etcd.OpOption{etcd.WithPrefix(), etcd.WithSort(opts.Target, opts.Order)}, startOpts...)
 	var fromKey *mvccpb.KeyValue
 	from, compare := listFuncs(opts)
 	for {
@@ -99,6 +99,9 @@ type ReadWriteIntCollection interface {
 // ReadonlyCollection is a collection interface that only supports read ops.
 type ReadonlyCollection interface {
 	Get(key string, val proto.Message) error
+	// GetRev is like Get, but also returns the revision at which key was
+	// created.
+	GetRev(key string, val proto.Message) (int64, error)
 	GetByIndex(index *Index, indexVal interface{}, val proto.Message, opts *Options, f func(key string) error) error
 	// GetBlock is like Get but waits for the key to exist if it doesn't already.
 	GetBlock(key string, val proto.Message) error
@@ -107,6 +110,9 @@ type ReadonlyCollection interface {
 	TTL(key string) (int64, error)
 	List(val proto.Message, opts *Options, f func(key string) error) error
 	ListRev(val proto.Message, opts *Options, f func(key string, createRev int64) error) error
+	// ListRevFrom is like ListRev, but starts at the objects created at
+	// fromRev.
+	ListRevFrom(val proto.Message, opts *Options, fromRev int64, f func(key string, createRev int64) error) error
 	ListPrefix(prefix string, val proto.Message, opts *Options, f func(string) error) error
 	Count() (int64, error)
 	Watch(opts ...watch.OpOption) (watch.Watcher, error)
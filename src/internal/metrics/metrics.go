@@ -235,6 +235,12 @@ func inputMetrics(input *pps.Input, metrics *Metrics) {
 	if input.Git != nil {
 		metrics.InputGit++
 	}
+	if input.SQL != nil {
+		metrics.InputSql++
+	}
+	if input.Bucket != nil {
+		metrics.InputBucket++
+	}
 	if input.Pfs != nil {
 		pfsInputMetrics(input.Pfs, metrics)
 	}
@@ -78,6 +78,8 @@ type Metrics struct {
 	MaxParallelism       uint64   `protobuf:"varint,51,opt,name=max_parallelism,json=maxParallelism,proto3" json:"max_parallelism,omitempty"`
 	MinParallelism       uint64   `protobuf:"varint,52,opt,name=min_parallelism,json=minParallelism,proto3" json:"min_parallelism,omitempty"`
 	NumParallelism       uint64   `protobuf:"varint,53,opt,name=num_parallelism,json=numParallelism,proto3" json:"num_parallelism,omitempty"`
+	InputSql             int64    `protobuf:"varint,54,opt,name=input_sql,json=inputSql,proto3" json:"input_sql,omitempty"`
+	InputBucket          int64    `protobuf:"varint,55,opt,name=input_bucket,json=inputBucket,proto3" json:"input_bucket,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -487,6 +489,20 @@ func (m *Metrics) GetNumParallelism() uint64 {
 	return 0
 }
 
+func (m *Metrics) GetInputSql() int64 {
+	if m != nil {
+		return m.InputSql
+	}
+	return 0
+}
+
+func (m *Metrics) GetInputBucket() int64 {
+	if m != nil {
+		return m.InputBucket
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Metrics)(nil), "metrics.Metrics")
 }
@@ -494,68 +510,69 @@ func init() {
 func init() { proto.RegisterFile("internal/metrics/metrics.proto", fileDescriptor_80696bde8ca4d1c7) }
 
 var fileDescriptor_80696bde8ca4d1c7 = []byte{
-	// 967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x95, 0xdb, 0x72, 0xdb, 0x36,
-	0x10, 0x86, 0x47, 0x89, 0x0f, 0x11, 0x7c, 0x90, 0x04, 0xbb, 0x29, 0x72, 0xb2, 0x15, 0xa7, 0xad,
-	0x15, 0xc7, 0xb5, 0xda, 0xa8, 0xed, 0x03, 0x58, 0x4e, 0x33, 0xee, 0xc4, 0x13, 0x8f, 0x9c, 0xde,
-	0xf4, 0x86, 0x43, 0x81, 0x10, 0x0d, 0x87, 0x04, 0x18, 0x00, 0x74, 0xa5, 0x5c, 0xf7, 0xe1, 0x7a,
-	0xd9, 0x27, 0xc8, 0x74, 0xf4, 0x24, 0x99, 0x5d, 0x90, 0x12, 0x25, 0x5f, 0x89, 0xfb, 0xef, 0x87,
-	0xdd, 0x7f, 0x77, 0x40, 0x8a, 0xec, 0x49, 0xe5, 0x84, 0x51, 0x61, 0xd2, 0x4d, 0x85, 0x33, 0x92,
-	0xdb, 0xf2, 0xf7, 0x24, 0x33, 0xda, 0x69, 0xba, 0x5e, 0x84, 0x8f, 0x77, 0x63, 0x1d, 0x6b, 0xd4,
-	0xba, 0xf0, 0xe4, 0xd3, 0x07, 0xff, 0x34, 0xc8, 0xfa, 0x85, 0x27, 0xe8, 0x31, 0x21, 0x3c, 0xc9,
-	0xad, 0x13, 0x26, 0x90, 0x11, 0xab, 0xb5, 0x6b, 0x9d, 0xfa, 0xe9, 0xd6, 0xf4, 0xcb, 0x7e, 0xbd,
-	0xef, 0xd5, 0xf3, 0xb3, 0x41, 0xbd, 0x00, 0xce, 0x23, 0xda, 0x26, 0x6b, 0x99, 0x8e, 0x80, 0xbc,
-	0x87, 0x64, 0x7d, 0xfa, 0x65, 0x7f, 0xf5, 0x52, 0x47, 0xe7, 0x67, 0x83, 0xd5, 0x4c, 0x47, 0xe7,
-	0x11, 0xdd, 0x25, 0xab, 0x4a, 0x47, 0xc2, 0xb2, 0xfb, 0xed, 0x5a, 0xe7, 0xfe, 0xc0, 0x07, 0x94,
-	0x91, 0xf5, 0x5b, 0x61, 0xac, 0xd4, 0x8a, 0xad, 0xc0, 0xc1, 0x41, 0x19, 0x02, 0x6f, 0x44, 0xa6,
-	0x2d, 0x5b, 0xf5, 0x3c, 0x06, 0xc0, 0x73, 0x9d, 0xa6, 0xd2, 0x59, 0xb6, 0x86, 0x7a, 0x19, 0x02,
-	0x3f, 0x92, 0x89, 0xb0, 0x6c, 0xdd, 0xf3, 0x18, 0x80, 0x3a, 0x9c, 0x38, 0x61, 0xd9, 0x83, 0x76,
-	0xad, 0xb3, 0x32, 0xf0, 0x01, 0xa5, 0x64, 0xe5, 0x46, 0x0f, 0x2d, 0xab, 0x23, 0x8a, 0xcf, 0xf4,
-	0x29, 0xa9, 0x67, 0x32, 0x13, 0x89, 0x54, 0xc2, 0x32, 0x82, 0x89, 0xb9, 0x40, 0x5f, 0x92, 0x66,
-	0x68, 0xf8, 0xb5, 0xbc, 0x15, 0x51, 0x50, 0x1a, 0xd8, 0x40, 0xa8, 0x51, 0xea, 0xfd, 0xc2, 0xc8,
-	0x2b, 0xd2, 0xe2, 0xa1, 0xe2, 0x22, 0x49, 0x2a, 0xec, 0x26, 0xb2, 0xcd, 0x59, 0xa2, 0x84, 0x0f,
-	0x49, 0x23, 0xe4, 0x4e, 0xde, 0x86, 0x4e, 0x6a, 0x15, 0x70, 0x1d, 0x09, 0xb6, 0x85, 0x7b, 0xd8,
-	0x9e, 0xcb, 0x7d, 0x1d, 0x09, 0xfa, 0x9c, 0x6c, 0xa6, 0xe1, 0x38, 0x18, 0x9a, 0x50, 0xf1, 0x6b,
-	0x61, 0xd9, 0x36, 0xce, 0xb3, 0x91, 0x86, 0xe3, 0xd3, 0x42, 0xa2, 0x4f, 0x48, 0x3d, 0xcb, 0x6c,
-	0x60, 0x33, 0x9d, 0x3b, 0xd6, 0xc0, 0x86, 0x0f, 0xb2, 0xcc, 0x5e, 0x41, 0x4c, 0x8f, 0x48, 0x6b,
-	0x96, 0x0c, 0xac, 0x30, 0xb7, 0x92, 0x0b, 0xd6, 0xf4, 0x13, 0x94, 0xd0, 0x95, 0x97, 0xcb, 0x42,
-	0xc3, 0x5c, 0x26, 0x11, 0x6b, 0xcd, 0x0a, 0x9d, 0x42, 0x4c, 0x9f, 0x11, 0xc2, 0x47, 0x71, 0x20,
-	0x62, 0x23, 0xac, 0x65, 0xd4, 0x2f, 0x8a, 0x8f, 0xe2, 0x37, 0x28, 0xd0, 0x7d, 0xb2, 0x01, 0x69,
-	0xeb, 0x42, 0x15, 0x0d, 0x27, 0x6c, 0x07, 0xf3, 0x70, 0xe2, 0xca, 0x2b, 0xf4, 0x05, 0xd9, 0x42,
-	0xa0, 0x17, 0x87, 0x4e, 0xfc, 0x1d, 0x4e, 0xd8, 0x2e, 0x22, 0x9b, 0x80, 0x94, 0x1a, 0x4c, 0x8b,
-	0x90, 0x37, 0x64, 0xd9, 0x37, 0xc8, 0x40, 0xe5, 0xc2, 0xa3, 0x9d, 0xf9, 0x30, 0x86, 0xa7, 0x11,
-	0x7b, 0x38, 0xf7, 0x81, 0x02, 0xcc, 0x50, 0xf8, 0x70, 0x96, 0x7d, 0xeb, 0x67, 0xf0, 0x2e, 0x9c,
-	0x2d, 0x93, 0x6e, 0x74, 0xa3, 0x87, 0x8c, 0xcd, 0x92, 0x1f, 0x20, 0x86, 0x09, 0xa4, 0xca, 0x72,
-	0x17, 0xc4, 0x46, 0xe7, 0x19, 0x7b, 0xe4, 0x27, 0x40, 0xe9, 0x2d, 0x28, 0xd0, 0xd9, 0x03, 0x37,
-	0x5a, 0x2a, 0xf6, 0xd8, 0x77, 0x46, 0xe5, 0x0f, 0x2d, 0xd5, 0xfc, 0x3c, 0x37, 0xda, 0x5a, 0xf6,
-	0xa4, 0x72, 0xbe, 0x0f, 0xca, 0x1c, 0xc8, 0x15, 0xdc, 0xfb, 0xa7, 0x15, 0xe0, 0x4f, 0x50, 0xe6,
-	0x0d, 0xb8, 0xd1, 0x8a, 0x3d, 0xab, 0x34, 0xe8, 0x1b, 0xad, 0xc0, 0x7d, 0x61, 0x50, 0x3a, 0xb6,
-	0xe7, 0xdd, 0x7b, 0x7b, 0xd2, 0xcd, 0x93, 0xd9, 0xc8, 0xb2, 0xfd, 0x4a, 0xf2, 0x72, 0x64, 0x61,
-	0xad, 0x45, 0x61, 0xbc, 0x7e, 0xac, 0xed, 0xd7, 0xea, 0x4b, 0xa3, 0x44, 0x0f, 0xc8, 0xd6, 0x7c,
-	0xb8, 0x40, 0x2b, 0xf6, 0xbc, 0xc2, 0xc0, 0x7c, 0xef, 0x15, 0xed, 0x90, 0xa6, 0x67, 0x74, 0x0e,
-	0x9f, 0x07, 0x5c, 0xc3, 0x01, 0x62, 0xdb, 0xa8, 0xbf, 0x07, 0x19, 0x77, 0x31, 0x9b, 0x24, 0x09,
-	0x3f, 0x4f, 0xd8, 0x8b, 0xca, 0x24, 0xef, 0xc2, 0xcf, 0x13, 0xb8, 0x94, 0x3e, 0x2d, 0xd2, 0xcc,
-	0x4d, 0x02, 0xff, 0xfe, 0x7e, 0xe7, 0x2f, 0x25, 0x26, 0xde, 0x80, 0xfe, 0x3b, 0xbe, 0xc9, 0x8f,
-	0x88, 0x9f, 0x23, 0xb0, 0x3d, 0xf6, 0xbd, 0x7f, 0xf5, 0x31, 0xbe, 0xea, 0xc1, 0x95, 0xf2, 0x29,
-	0x67, 0x64, 0x1c, 0x0b, 0xc3, 0x7e, 0xf0, 0x57, 0x0a, 0xc5, 0x0f, 0x5e, 0x03, 0xd3, 0x46, 0x58,
-	0x9d, 0x1b, 0x2e, 0x02, 0x9e, 0xe5, 0x81, 0x11, 0x9f, 0xd8, 0x61, 0xbb, 0xd6, 0xb9, 0x37, 0xd8,
-	0x2e, 0xf5, 0x7e, 0x96, 0x0f, 0xc4, 0x27, 0xda, 0x25, 0xbb, 0xcb, 0x64, 0x90, 0x86, 0x63, 0xd6,
-	0x41, 0xba, 0xb5, 0x48, 0x5f, 0x84, 0xe3, 0x85, 0xd2, 0xa9, 0x48, 0xb1, 0xf4, 0x4b, 0xff, 0x16,
-	0x97, 0xfa, 0x85, 0x48, 0xa1, 0x74, 0x95, 0x8c, 0x0b, 0x13, 0x47, 0x7e, 0x73, 0xa5, 0xfe, 0xf6,
-	0xae, 0x89, 0xb8, 0x62, 0xe2, 0x15, 0xd2, 0xad, 0x45, 0x1a, 0x4c, 0x1c, 0x91, 0x99, 0x18, 0x44,
-	0xd2, 0x7e, 0xc4, 0xda, 0xc7, 0xe8, 0xa2, 0x51, 0x26, 0xce, 0xa4, 0xfd, 0x08, 0xc5, 0x8f, 0x09,
-	0x5d, 0x98, 0x30, 0x91, 0x70, 0x1b, 0x7e, 0xc4, 0xf9, 0x9a, 0x95, 0xf9, 0xde, 0x81, 0x4e, 0x7b,
-	0xe4, 0xe1, 0x5d, 0x1a, 0xcd, 0x9c, 0xe0, 0x89, 0x9d, 0xe5, 0x13, 0x60, 0xa7, 0xda, 0x02, 0x76,
-	0xe2, 0x5b, 0x74, 0xd1, 0x4f, 0xb3, 0xb2, 0x15, 0xdf, 0xa2, 0x4a, 0xc7, 0x33, 0x43, 0x3f, 0xf9,
-	0x8f, 0x66, 0x65, 0xd6, 0xbb, 0x86, 0xe2, 0x05, 0x43, 0x3f, 0xe3, 0x89, 0x9d, 0xe5, 0x13, 0x60,
-	0xe8, 0x84, 0xec, 0x2c, 0xee, 0xc7, 0xf7, 0x78, 0x8d, 0x8e, 0x5a, 0xd5, 0x0d, 0xf9, 0x26, 0x87,
-	0xa4, 0x01, 0x1f, 0xdc, 0x2c, 0x34, 0x61, 0x92, 0x88, 0x44, 0xda, 0x94, 0xf5, 0xf0, 0x9b, 0xbb,
-	0x9d, 0x86, 0xe3, 0xcb, 0xb9, 0x8a, 0xa0, 0x54, 0x0b, 0xe0, 0x2f, 0x05, 0x28, 0xd5, 0x12, 0xa8,
-	0xf2, 0x74, 0x01, 0xfc, 0xd5, 0x83, 0x2a, 0x4f, 0x2b, 0xe0, 0xe9, 0xd9, 0xbf, 0xd3, 0xbd, 0xda,
-	0x7f, 0xd3, 0xbd, 0xda, 0xff, 0xd3, 0xbd, 0xda, 0x5f, 0xbf, 0xc5, 0xd2, 0x5d, 0xe7, 0xc3, 0x13,
-	0xae, 0xd3, 0x6e, 0x16, 0xf2, 0xeb, 0x49, 0x24, 0x4c, 0xf5, 0xe9, 0xf6, 0x75, 0xd7, 0x1a, 0xde,
-	0x5d, 0xfe, 0xe7, 0x1f, 0xae, 0xe1, 0x7f, 0x7a, 0xef, 0x6b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62,
-	0xb3, 0xa4, 0x9e, 0x14, 0x08, 0x00, 0x00,
+	// 991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x96, 0x5d, 0x53, 0x1b, 0x37,
+	0x17, 0xc7, 0xc7, 0x09, 0x2f, 0xb1, 0x78, 0xb1, 0x2d, 0x78, 0xf2, 0x28, 0x6f, 0xe0, 0x90, 0xb6,
+	0x38, 0x84, 0xe2, 0x36, 0x6e, 0xd3, 0x7b, 0x4c, 0x9a, 0xa1, 0x13, 0x26, 0x8c, 0x49, 0x6f, 0x7a,
+	0xb3, 0xb3, 0xd6, 0xca, 0x8b, 0x60, 0x57, 0x5a, 0x24, 0x2d, 0xc5, 0xf9, 0x7c, 0xbd, 0xe8, 0x65,
+	0x3f, 0x41, 0xa6, 0xc3, 0x27, 0xe9, 0x9c, 0xa3, 0x5d, 0xef, 0x1a, 0xae, 0xf0, 0xf9, 0x9f, 0x9f,
+	0xce, 0xf9, 0x9f, 0x33, 0xd2, 0x0e, 0x64, 0x4b, 0x2a, 0x27, 0x8c, 0x0a, 0x93, 0x7e, 0x2a, 0x9c,
+	0x91, 0xdc, 0x96, 0x7f, 0x0f, 0x32, 0xa3, 0x9d, 0xa6, 0xcb, 0x45, 0xf8, 0x74, 0x33, 0xd6, 0xb1,
+	0x46, 0xad, 0x0f, 0xbf, 0x7c, 0x7a, 0xe7, 0xaf, 0x16, 0x59, 0x3e, 0xf1, 0x04, 0xdd, 0x27, 0x84,
+	0x27, 0xb9, 0x75, 0xc2, 0x04, 0x32, 0x62, 0x8d, 0x6e, 0xa3, 0xd7, 0x3c, 0x5c, 0xbb, 0xfd, 0xba,
+	0xdd, 0x1c, 0x7a, 0xf5, 0xf8, 0x68, 0xd4, 0x2c, 0x80, 0xe3, 0x88, 0x76, 0xc9, 0x52, 0xa6, 0x23,
+	0x20, 0x1f, 0x20, 0xd9, 0xbc, 0xfd, 0xba, 0xbd, 0x78, 0xaa, 0xa3, 0xe3, 0xa3, 0xd1, 0x62, 0xa6,
+	0xa3, 0xe3, 0x88, 0x6e, 0x92, 0x45, 0xa5, 0x23, 0x61, 0xd9, 0xc3, 0x6e, 0xa3, 0xf7, 0x70, 0xe4,
+	0x03, 0xca, 0xc8, 0xf2, 0xb5, 0x30, 0x56, 0x6a, 0xc5, 0x16, 0xe0, 0xe0, 0xa8, 0x0c, 0x81, 0x37,
+	0x22, 0xd3, 0x96, 0x2d, 0x7a, 0x1e, 0x03, 0xe0, 0xb9, 0x4e, 0x53, 0xe9, 0x2c, 0x5b, 0x42, 0xbd,
+	0x0c, 0x81, 0x9f, 0xc8, 0x44, 0x58, 0xb6, 0xec, 0x79, 0x0c, 0x40, 0x1d, 0x4f, 0x9d, 0xb0, 0xec,
+	0x51, 0xb7, 0xd1, 0x5b, 0x18, 0xf9, 0x80, 0x52, 0xb2, 0x70, 0xa1, 0xc7, 0x96, 0x35, 0x11, 0xc5,
+	0xdf, 0xf4, 0x39, 0x69, 0x66, 0x32, 0x13, 0x89, 0x54, 0xc2, 0x32, 0x82, 0x89, 0x4a, 0xa0, 0xaf,
+	0x49, 0x3b, 0x34, 0xfc, 0x5c, 0x5e, 0x8b, 0x28, 0x28, 0x0d, 0xac, 0x20, 0xd4, 0x2a, 0xf5, 0x61,
+	0x61, 0xe4, 0x0d, 0xe9, 0xf0, 0x50, 0x71, 0x91, 0x24, 0x35, 0x76, 0x15, 0xd9, 0xf6, 0x2c, 0x51,
+	0xc2, 0xbb, 0xa4, 0x15, 0x72, 0x27, 0xaf, 0x43, 0x27, 0xb5, 0x0a, 0xb8, 0x8e, 0x04, 0x5b, 0xc3,
+	0x3d, 0xac, 0x57, 0xf2, 0x50, 0x47, 0x82, 0xbe, 0x24, 0xab, 0x69, 0x78, 0x13, 0x8c, 0x4d, 0xa8,
+	0xf8, 0xb9, 0xb0, 0x6c, 0x1d, 0xe7, 0x59, 0x49, 0xc3, 0x9b, 0xc3, 0x42, 0xa2, 0xcf, 0x48, 0x33,
+	0xcb, 0x6c, 0x60, 0x33, 0x9d, 0x3b, 0xd6, 0xc2, 0x86, 0x8f, 0xb2, 0xcc, 0x9e, 0x41, 0x4c, 0xf7,
+	0x48, 0x67, 0x96, 0x0c, 0xac, 0x30, 0xd7, 0x92, 0x0b, 0xd6, 0xf6, 0x13, 0x94, 0xd0, 0x99, 0x97,
+	0xcb, 0x42, 0xe3, 0x5c, 0x26, 0x11, 0xeb, 0xcc, 0x0a, 0x1d, 0x42, 0x4c, 0x5f, 0x10, 0xc2, 0x27,
+	0x71, 0x20, 0x62, 0x23, 0xac, 0x65, 0xd4, 0x2f, 0x8a, 0x4f, 0xe2, 0xf7, 0x28, 0xd0, 0x6d, 0xb2,
+	0x02, 0x69, 0xeb, 0x42, 0x15, 0x8d, 0xa7, 0x6c, 0x03, 0xf3, 0x70, 0xe2, 0xcc, 0x2b, 0xf4, 0x15,
+	0x59, 0x43, 0x60, 0x10, 0x87, 0x4e, 0xfc, 0x19, 0x4e, 0xd9, 0x26, 0x22, 0xab, 0x80, 0x94, 0x1a,
+	0x4c, 0x8b, 0x90, 0x37, 0x64, 0xd9, 0xff, 0x90, 0x81, 0xca, 0x85, 0x47, 0x3b, 0xf3, 0x61, 0x0c,
+	0x4f, 0x23, 0xf6, 0xb8, 0xf2, 0x81, 0x02, 0xcc, 0x50, 0xf8, 0x70, 0x96, 0xfd, 0xdf, 0xcf, 0xe0,
+	0x5d, 0x38, 0x5b, 0x26, 0xdd, 0xe4, 0x42, 0x8f, 0x19, 0x9b, 0x25, 0x3f, 0x43, 0x0c, 0x13, 0x48,
+	0x95, 0xe5, 0x2e, 0x88, 0x8d, 0xce, 0x33, 0xf6, 0xc4, 0x4f, 0x80, 0xd2, 0x07, 0x50, 0xa0, 0xb3,
+	0x07, 0x2e, 0xb4, 0x54, 0xec, 0xa9, 0xef, 0x8c, 0xca, 0x6f, 0x5a, 0xaa, 0xea, 0x3c, 0x37, 0xda,
+	0x5a, 0xf6, 0xac, 0x76, 0x7e, 0x08, 0x4a, 0x05, 0xe4, 0x0a, 0xee, 0xfd, 0xf3, 0x1a, 0xf0, 0x3b,
+	0x28, 0x55, 0x03, 0x6e, 0xb4, 0x62, 0x2f, 0x6a, 0x0d, 0x86, 0x46, 0x2b, 0x70, 0x5f, 0x18, 0x94,
+	0x8e, 0x6d, 0x79, 0xf7, 0xde, 0x9e, 0x74, 0x55, 0x32, 0x9b, 0x58, 0xb6, 0x5d, 0x4b, 0x9e, 0x4e,
+	0x2c, 0xac, 0xb5, 0x28, 0x8c, 0xd7, 0x8f, 0x75, 0xfd, 0x5a, 0x7d, 0x69, 0x94, 0xe8, 0x0e, 0x59,
+	0xab, 0x86, 0x0b, 0xb4, 0x62, 0x2f, 0x6b, 0x0c, 0xcc, 0xf7, 0x49, 0xd1, 0x1e, 0x69, 0x7b, 0x46,
+	0xe7, 0xf0, 0x79, 0xc0, 0x35, 0xec, 0x20, 0xb6, 0x8e, 0xfa, 0x27, 0x90, 0x71, 0x17, 0xb3, 0x49,
+	0x92, 0xf0, 0xcb, 0x94, 0xbd, 0xaa, 0x4d, 0xf2, 0x31, 0xfc, 0x32, 0x85, 0x4b, 0xe9, 0xd3, 0x22,
+	0xcd, 0xdc, 0x34, 0xf0, 0xef, 0xf7, 0x1b, 0x7f, 0x29, 0x31, 0xf1, 0x1e, 0xf4, 0x5f, 0x41, 0xa6,
+	0x4f, 0x88, 0x9f, 0x23, 0xb0, 0x03, 0xf6, 0xad, 0x7f, 0xfa, 0x18, 0x9f, 0x0d, 0xe0, 0x4a, 0xf9,
+	0x94, 0x33, 0x32, 0x8e, 0x85, 0x61, 0xdf, 0xf9, 0x2b, 0x85, 0xe2, 0x67, 0xaf, 0x81, 0x69, 0x23,
+	0xac, 0xce, 0x0d, 0x17, 0x01, 0xcf, 0xf2, 0xc0, 0x88, 0x2b, 0xb6, 0xdb, 0x6d, 0xf4, 0x1e, 0x8c,
+	0xd6, 0x4b, 0x7d, 0x98, 0xe5, 0x23, 0x71, 0x45, 0xfb, 0x64, 0xf3, 0x2e, 0x19, 0xa4, 0xe1, 0x0d,
+	0xeb, 0x21, 0xdd, 0x99, 0xa7, 0x4f, 0xc2, 0x9b, 0xb9, 0xd2, 0xa9, 0x48, 0xb1, 0xf4, 0x6b, 0xff,
+	0x8a, 0x4b, 0xfd, 0x44, 0xa4, 0x50, 0xba, 0x4e, 0xc6, 0x85, 0x89, 0x3d, 0xbf, 0xb9, 0x52, 0xff,
+	0x70, 0xdf, 0x44, 0x5c, 0x33, 0xf1, 0x06, 0xe9, 0xce, 0x3c, 0x0d, 0x26, 0xf6, 0xc8, 0x4c, 0x0c,
+	0x22, 0x69, 0x2f, 0xb1, 0xf6, 0x3e, 0xba, 0x68, 0x95, 0x89, 0x23, 0x69, 0x2f, 0xa1, 0xf8, 0x3e,
+	0xa1, 0x73, 0x13, 0x26, 0x12, 0x6e, 0xc3, 0xf7, 0x38, 0x5f, 0xbb, 0x36, 0xdf, 0x47, 0xd0, 0xe9,
+	0x80, 0x3c, 0xbe, 0x4f, 0xa3, 0x99, 0x03, 0x3c, 0xb1, 0x71, 0xf7, 0x04, 0xd8, 0xa9, 0xb7, 0x80,
+	0x9d, 0xf8, 0x16, 0x7d, 0xf4, 0xd3, 0xae, 0x6d, 0xc5, 0xb7, 0xa8, 0xd3, 0xf1, 0xcc, 0xd0, 0x0f,
+	0xfe, 0xa3, 0x59, 0x9b, 0xf5, 0xbe, 0xa1, 0x78, 0xce, 0xd0, 0x8f, 0x78, 0x62, 0xe3, 0xee, 0x09,
+	0x30, 0x74, 0x40, 0x36, 0xe6, 0xf7, 0xe3, 0x7b, 0xbc, 0x45, 0x47, 0x9d, 0xfa, 0x86, 0x7c, 0x93,
+	0x5d, 0xd2, 0x82, 0x0f, 0x6e, 0x16, 0x9a, 0x30, 0x49, 0x44, 0x22, 0x6d, 0xca, 0x06, 0xf8, 0xcd,
+	0x5d, 0x4f, 0xc3, 0x9b, 0xd3, 0x4a, 0x45, 0x50, 0xaa, 0x39, 0xf0, 0xa7, 0x02, 0x94, 0xea, 0x0e,
+	0xa8, 0xf2, 0x74, 0x0e, 0xfc, 0xd9, 0x83, 0x2a, 0x4f, 0xeb, 0xe0, 0xec, 0x0d, 0xdb, 0xab, 0x84,
+	0xbd, 0xab, 0xbd, 0xe1, 0xb3, 0xab, 0xa4, 0x7a, 0xc3, 0xe3, 0x9c, 0x5f, 0x0a, 0xc7, 0x7e, 0xa9,
+	0xbd, 0xcf, 0x43, 0x94, 0x0e, 0x8f, 0xfe, 0xbe, 0xdd, 0x6a, 0xfc, 0x73, 0xbb, 0xd5, 0xf8, 0xf7,
+	0x76, 0xab, 0xf1, 0xc7, 0xbb, 0x58, 0xba, 0xf3, 0x7c, 0x7c, 0xc0, 0x75, 0xda, 0xcf, 0x42, 0x7e,
+	0x3e, 0x8d, 0x84, 0xa9, 0xff, 0xba, 0x7e, 0xdb, 0xb7, 0x86, 0xf7, 0xef, 0xfe, 0xe7, 0x30, 0x5e,
+	0xc2, 0xff, 0x09, 0x06, 0xff, 0x0d, 0x00, 0x3e, 0x58, 0x6e, 0xa0, 0x54, 0x08, 0x00, 0x00,
 }
 
 func (m *Metrics) Marshal() (dAtA []byte, err error) {
@@ -582,6 +599,20 @@ func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.InputBucket != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.InputBucket))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.InputSql != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.InputSql))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.NumParallelism != 0 {
 		i = encodeVarintMetrics(dAtA, i, uint64(m.NumParallelism))
 		i--
@@ -1130,6 +1161,12 @@ func (m *Metrics) Size() (n int) {
 	if m.NumParallelism != 0 {
 		n += 2 + sovMetrics(uint64(m.NumParallelism))
 	}
+	if m.InputSql != 0 {
+		n += 2 + sovMetrics(uint64(m.InputSql))
+	}
+	if m.InputBucket != 0 {
+		n += 2 + sovMetrics(uint64(m.InputBucket))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2250,6 +2287,44 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputSql", wireType)
+			}
+			m.InputSql = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputSql |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputBucket", wireType)
+			}
+			m.InputBucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputBucket |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
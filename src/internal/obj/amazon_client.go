@@ -315,6 +315,36 @@ func (c *amazonClient) Walk(ctx context.Context, name string, fn func(name strin
 	return fnErr
 }
 
+func (c *amazonClient) walkInfo(ctx context.Context, name string, fn func(name string, info *ObjectInfo) error) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	var fnErr error
+	if err := c.s3.ListObjectsPagesWithContext(ctx,
+		&s3.ListObjectsInput{
+			Bucket: aws.String(c.bucket),
+			Prefix: aws.String(name),
+		},
+		func(listObjectsOutput *s3.ListObjectsOutput, lastPage bool) bool {
+			for _, object := range listObjectsOutput.Contents {
+				key := *object.Key
+				if strings.HasPrefix(key, name) {
+					info := &ObjectInfo{
+						Size: aws.Int64Value(object.Size),
+						ETag: aws.StringValue(object.ETag),
+					}
+					if err := fn(key, info); err != nil {
+						fnErr = err
+						return false
+					}
+				}
+			}
+			return true
+		},
+	); err != nil {
+		return err
+	}
+	return fnErr
+}
+
 func (c *amazonClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	var reader io.ReadCloser
@@ -402,6 +432,21 @@ func (c *amazonClient) Exists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
+func (c *amazonClient) stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	out, err := c.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size: aws.Int64Value(out.ContentLength),
+		ETag: aws.StringValue(out.ETag),
+	}, nil
+}
+
 func (c *amazonClient) transformError(err error, objectPath string) error {
 	const minWait = 250 * time.Millisecond
 	if err == nil {
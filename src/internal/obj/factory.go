@@ -538,6 +538,74 @@ func ParseURL(urlStr string) (*ObjectStoreURL, error) {
 	return nil, errors.Errorf("unrecognized object store: %s", url.Scheme)
 }
 
+// NewClientFromURLAndCreds constructs a client for url using the credentials
+// in creds, which are keyed like the files of a mounted storage secret
+// (e.g. "amazon-id" and "amazon-secret"). s3 URLs use a MinIO client if
+// creds has a "minio-endpoint".
+func NewClientFromURLAndCreds(url *ObjectStoreURL, creds map[string]string) (c Client, err error) {
+	switch url.Store {
+	case "s3":
+		if endpoint, ok := creds["minio-endpoint"]; ok {
+			c, err = NewMinioClient(endpoint, url.Bucket, creds["minio-id"], creds["minio-secret"], creds["minio-secure"] == "1", creds["minio-signature"] == "1")
+			break
+		}
+		region, ok := creds["amazon-region"]
+		if !ok {
+			return nil, errors.Errorf("amazon-region not found")
+		}
+		c, err = NewAmazonClient(region, url.Bucket, &AmazonCreds{
+			ID:     creds["amazon-id"],
+			Secret: creds["amazon-secret"],
+			Token:  creds["amazon-token"],
+		}, "", creds["custom-endpoint"])
+	case "gcs":
+		fallthrough
+	case "gs":
+		cred, ok := creds["google-cred"]
+		if !ok {
+			return nil, errors.Errorf("google-cred not found")
+		}
+		c, err = NewGoogleClient(url.Bucket, []option.ClientOption{option.WithCredentialsJSON([]byte(cred))})
+	case "as":
+		fallthrough
+	case "wasb":
+		id, ok := creds["microsoft-id"]
+		if !ok {
+			return nil, errors.Errorf("microsoft-id not found")
+		}
+		c, err = NewMicrosoftClient(url.Bucket, id, creds["microsoft-secret"])
+	case "local":
+		root := strings.ReplaceAll(url.Bucket, ".", "/")
+		c, err = NewLocalClient("/" + root)
+	}
+	switch {
+	case err != nil:
+		return nil, err
+	case c != nil:
+		return TracingObjClient(url.Store, c), nil
+	default:
+		return nil, errors.Errorf("unrecognized object store: %s", url.Store)
+	}
+}
+
+// SecretBucket returns the bucket (or container) that the mounted storage
+// secret configures for storageBackend, or "" for the local backend.
+func SecretBucket(storageBackend string) (string, error) {
+	switch storageBackend {
+	case Minio:
+		return readSecretFile("/minio-bucket")
+	case Amazon:
+		return readSecretFile("/amazon-bucket")
+	case Google:
+		return readSecretFile("/google-bucket")
+	case Microsoft:
+		return readSecretFile("/microsoft-container")
+	case Local:
+		return "", nil
+	}
+	return "", errors.Errorf("unrecognized storage backend: %s", storageBackend)
+}
+
 // NewClientFromEnv creates a client based on environment variables.
 func NewClientFromEnv(storageRoot string) (c Client, err error) {
 	storageBackend, ok := os.LookupEnv(StorageBackendEnvVar)
@@ -71,6 +71,33 @@ func (c *googleClient) Walk(ctx context.Context, name string, fn func(name strin
 	return nil
 }
 
+func (c *googleClient) walkInfo(ctx context.Context, name string, fn func(name string, info *ObjectInfo) error) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	objectIter := c.bucket.Objects(ctx, &storage.Query{Prefix: name})
+	for {
+		objectAttrs, err := objectIter.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			return err
+		}
+		if err := fn(objectAttrs.Name, &ObjectInfo{Size: objectAttrs.Size, ETag: objectAttrs.Etag}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *googleClient) stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	objectAttrs, err := c.bucket.Object(name).Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{Size: objectAttrs.Size, ETag: objectAttrs.Etag}, nil
+}
+
 func (c *googleClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	reader, err := c.bucket.Object(name).NewReader(ctx)
@@ -2,6 +2,7 @@ package obj
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -90,6 +91,20 @@ func (c *localClient) Delete(_ context.Context, path string) (retErr error) {
 }
 
 func (c *localClient) Walk(_ context.Context, dir string, walkFn func(name string) error) error {
+	return c.walk(dir, func(name string, _ os.FileInfo) error {
+		return walkFn(name)
+	})
+}
+
+func (c *localClient) walkInfo(_ context.Context, dir string, fn func(name string, info *ObjectInfo) error) error {
+	return c.walk(dir, func(name string, fileInfo os.FileInfo) error {
+		return fn(name, localObjectInfo(fileInfo))
+	})
+}
+
+// walk calls walkFn with the path relative to the root and the file info of
+// each file under dir.
+func (c *localClient) walk(dir string, walkFn func(name string, fileInfo os.FileInfo) error) error {
 	dir = c.normPath(dir)
 	fi, _ := os.Stat(dir)
 	prefix := ""
@@ -110,7 +125,7 @@ func (c *localClient) Walk(_ context.Context, dir string, walkFn func(name strin
 		if !strings.HasPrefix(filepath.Base(relPath), prefix) {
 			return nil
 		}
-		return walkFn(relPath)
+		return walkFn(relPath, fileInfo)
 	})
 	return err
 }
@@ -127,6 +142,24 @@ func (c *localClient) Exists(ctx context.Context, path string) (bool, error) {
 	return true, nil
 }
 
+func (c *localClient) stat(_ context.Context, path string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, path) }()
+	fi, err := os.Stat(c.normPath(path))
+	if err != nil {
+		return nil, err
+	}
+	return localObjectInfo(fi), nil
+}
+
+// localObjectInfo describes a file by its size and modification time, which
+// change whenever it's rewritten.
+func localObjectInfo(fi os.FileInfo) *ObjectInfo {
+	return &ObjectInfo{
+		Size: fi.Size(),
+		ETag: fmt.Sprintf("%x-%x", fi.ModTime().UnixNano(), fi.Size()),
+	}
+}
+
 func (c *localClient) transformError(err error, name string) error {
 	if err == nil {
 		return nil
@@ -97,6 +97,40 @@ func (c *microsoftClient) Walk(_ context.Context, name string, f func(name strin
 	return nil
 }
 
+// TODO: should respect context
+func (c *microsoftClient) walkInfo(_ context.Context, name string, f func(name string, info *ObjectInfo) error) error {
+	var marker string
+	for {
+		blobList, err := c.container.ListBlobs(storage.ListBlobsParameters{
+			Prefix: name,
+			Marker: marker,
+		})
+		if err != nil {
+			return err
+		}
+		for _, file := range blobList.Blobs {
+			if err := f(file.Name, &ObjectInfo{Size: file.Properties.ContentLength, ETag: file.Properties.Etag}); err != nil {
+				return err
+			}
+		}
+		// NextMarker is empty when all results have been returned
+		if blobList.NextMarker == "" {
+			break
+		}
+		marker = blobList.NextMarker
+	}
+	return nil
+}
+
+// TODO: should respect context
+func (c *microsoftClient) stat(_ context.Context, name string) (*ObjectInfo, error) {
+	blob := c.container.GetBlobReference(name)
+	if err := blob.GetProperties(nil); err != nil {
+		return nil, c.transformError(err, name)
+	}
+	return &ObjectInfo{Size: blob.Properties.ContentLength, ETag: blob.Properties.Etag}, nil
+}
+
 // TODO: should respect context
 func (c *microsoftClient) Exists(ctx context.Context, name string) (bool, error) {
 	exists, err := c.container.GetBlobReference(name).Exists()
@@ -68,6 +68,21 @@ func (c *minioClient) Walk(_ context.Context, name string, fn func(name string)
 	return nil
 }
 
+func (c *minioClient) walkInfo(_ context.Context, name string, fn func(name string, info *ObjectInfo) error) (retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for objInfo := range c.ListObjectsV2(c.bucket, name, true, doneCh) {
+		if objInfo.Err != nil {
+			return objInfo.Err
+		}
+		if err := fn(objInfo.Key, &ObjectInfo{Size: objInfo.Size, ETag: objInfo.ETag}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *minioClient) Get(ctx context.Context, name string, w io.Writer) (retErr error) {
 	defer func() { retErr = c.transformError(retErr, name) }()
 	rc, err := c.GetObjectWithContext(ctx, c.bucket, name, minio.GetObjectOptions{})
@@ -102,6 +117,18 @@ func (c *minioClient) Exists(ctx context.Context, name string) (bool, error) {
 	return true, nil
 }
 
+func (c *minioClient) stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() { retErr = c.transformError(retErr, name) }()
+	info, err := c.StatObjectWithContext(ctx, c.bucket, name, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size: info.Size,
+		ETag: info.ETag,
+	}, nil
+}
+
 func (c *minioClient) transformError(err error, objectPath string) error {
 	if err == nil {
 		return nil
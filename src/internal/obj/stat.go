@@ -0,0 +1,74 @@
+package obj
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+)
+
+// ObjectInfo describes the version of an object.
+type ObjectInfo struct {
+	Size int64
+	// ETag changes whenever the object's content does.
+	ETag string
+}
+
+// statter is implemented by clients that can describe an object without
+// reading it.
+type statter interface {
+	stat(ctx context.Context, name string) (*ObjectInfo, error)
+}
+
+// walkInfoer is implemented by clients whose listings describe each object,
+// so that describing the objects under a prefix takes one listing rather than
+// a request per object.
+type walkInfoer interface {
+	walkInfo(ctx context.Context, prefix string, fn func(name string, info *ObjectInfo) error) error
+}
+
+// Stat returns the size and etag of the object at name. For clients that
+// can't describe an object without reading it, the etag is the MD5 of the
+// object's content, which is what most object stores use for objects that
+// were uploaded in a single part.
+func Stat(ctx context.Context, c Client, name string) (*ObjectInfo, error) {
+	if s, ok := c.(statter); ok {
+		return s.stat(ctx, name)
+	}
+	h := md5.New()
+	cw := &countWriter{w: h}
+	if err := c.Get(ctx, name, cw); err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size: cw.n,
+		ETag: hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// WalkInfo calls fn with the name, size and etag of each object under
+// prefix. For clients that can't list objects with their versions, each
+// object is described with Stat.
+func WalkInfo(ctx context.Context, c Client, prefix string, fn func(name string, info *ObjectInfo) error) error {
+	if w, ok := c.(walkInfoer); ok {
+		return w.walkInfo(ctx, prefix, fn)
+	}
+	return c.Walk(ctx, prefix, func(name string) error {
+		info, err := Stat(ctx, c, name)
+		if err != nil {
+			return err
+		}
+		return fn(name, info)
+	})
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,57 @@
+package obj
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/pacherr"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestStat(t *testing.T) {
+	ctx := context.Background()
+	c := newTestLocalClient(t)
+	require.NoError(t, c.Put(ctx, "foo", strings.NewReader("foo")))
+	info, err := Stat(ctx, c, "foo")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), info.Size)
+	require.NotEqual(t, "", info.ETag)
+	require.NoError(t, c.Put(ctx, "foo", strings.NewReader("foobar")))
+	info2, err := Stat(ctx, c, "foo")
+	require.NoError(t, err)
+	require.Equal(t, int64(6), info2.Size)
+	require.NotEqual(t, info.ETag, info2.ETag)
+	_, err = Stat(ctx, c, "bar")
+	require.True(t, pacherr.IsNotExist(err))
+
+	// Clients that can't stat objects fall back to reading them.
+	info, err = Stat(ctx, NewLimitedClient(c, 0, 0), "foo")
+	require.NoError(t, err)
+	sum := md5.Sum([]byte("foobar"))
+	require.Equal(t, &ObjectInfo{Size: 6, ETag: hex.EncodeToString(sum[:])}, info)
+}
+
+func TestWalkInfo(t *testing.T) {
+	ctx := context.Background()
+	c := newTestLocalClient(t)
+	for _, name := range []string{"dir/a", "dir/sub/b", "other"} {
+		require.NoError(t, c.Put(ctx, name, strings.NewReader(name)))
+	}
+	// The listing describes each object the same way that Stat does, for
+	// clients that can list objects with their versions and for those that
+	// can't.
+	for _, c := range []Client{c, NewLimitedClient(c, 0, 0)} {
+		var names []string
+		require.NoError(t, WalkInfo(ctx, c, "dir", func(name string, info *ObjectInfo) error {
+			names = append(names, name)
+			expected, err := Stat(ctx, c, name)
+			require.NoError(t, err)
+			require.Equal(t, expected, info)
+			return nil
+		}))
+		require.ElementsEqual(t, []string{"dir/a", "dir/sub/b"}, names)
+	}
+}
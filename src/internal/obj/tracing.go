@@ -88,3 +88,21 @@ func (o *tracingObjClient) Exists(ctx context.Context, name string) (retVal bool
 	defer tracing.FinishAnySpan(span)
 	return o.Client.Exists(ctx, name)
 }
+
+func (o *tracingObjClient) stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Stat",
+		"name", name)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	return Stat(ctx, o.Client, name)
+}
+
+func (o *tracingObjClient) walkInfo(ctx context.Context, prefix string, fn func(name string, info *ObjectInfo) error) (retErr error) {
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/WalkInfo",
+		"prefix", prefix)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	return WalkInfo(ctx, o.Client, prefix, fn)
+}
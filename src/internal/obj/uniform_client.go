@@ -68,3 +68,18 @@ func (uc *uniformClient) Exists(ctx context.Context, p string) (_ bool, retErr e
 	}
 	return exists, err
 }
+
+func (uc *uniformClient) walkInfo(ctx context.Context, prefix string, fn func(name string, info *ObjectInfo) error) (retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	return WalkInfo(ctx, uc.c, prefix, fn)
+}
+
+func (uc *uniformClient) stat(ctx context.Context, name string) (_ *ObjectInfo, retErr error) {
+	defer func() {
+		retErr = errors.EnsureStack(retErr)
+	}()
+	name = strings.Trim(name, "/")
+	return Stat(ctx, uc.c, name)
+}
@@ -15,6 +15,7 @@ const (
 	reposPrefix       = "/repos"
 	commitsPrefix     = "/commits"
 	branchesPrefix    = "/branches"
+	tagsPrefix        = "/tags"
 	openCommitsPrefix = "/openCommits"
 	mergesPrefix      = "/merges"
 	shardsPrefix      = "/shards"
@@ -66,6 +67,23 @@ func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Colle
 	)
 }
 
+// Tags returns a collection of tags
+func Tags(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, tagsPrefix, repo),
+		nil,
+		&pfs.TagInfo{},
+		func(key string) error {
+			if uuid.IsUUIDWithoutDashes(key) {
+				return errors.Errorf("tag name cannot be a UUID V4")
+			}
+			return nil
+		},
+		nil,
+	)
+}
+
 // OpenCommits returns a collection of open commits
 func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	return col.NewCollection(
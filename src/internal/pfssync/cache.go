@@ -0,0 +1,168 @@
+package pfssync
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// Cache is an on-disk cache of downloaded files, keyed by their content hash
+// and bounded by the total size of the files in it. Files are copied in and
+// out of the cache, rather than linked, so that user code that writes to its
+// input files (which the file mode can't prevent when it runs as root) only
+// changes its own copy.
+type Cache struct {
+	root    string
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	entries map[string]*list.Element
+	// lru holds the cacheEntries, most recently used first.
+	lru *list.List
+}
+
+type cacheEntry struct {
+	key  string
+	size int64
+	// perm is the permissions of the file that was cached, which copies of it
+	// are given.
+	perm os.FileMode
+}
+
+// NewCache creates a Cache in root that holds at most maxSize bytes. Any
+// existing contents of root are removed.
+func NewCache(root string, maxSize int64) (*Cache, error) {
+	if err := os.RemoveAll(root); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	return &Cache{
+		root:    root,
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}, nil
+}
+
+func cacheKey(fi *pfs.FileInfo) string {
+	return fmt.Sprintf("%x-%o", fi.Hash, fi.Mode)
+}
+
+// cacheable returns true if the file described by fi can be cached.
+// Directories and symlinks aren't, since their hashes don't cover everything
+// that is downloaded for them.
+func cacheable(fi *pfs.FileInfo) bool {
+	return fi != nil && fi.FileType == pfs.FileType_FILE && len(fi.Hash) > 0
+}
+
+// copyOut copies the cached file for fi to dst, and returns false if it isn't
+// cached.
+func (c *Cache) copyOut(fi *pfs.FileInfo, dst string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := cacheKey(fi)
+	elem, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	if err := os.MkdirAll(path.Dir(dst), 0700); err != nil {
+		return false
+	}
+	if err := copyFile(filepath.Join(c.root, key), dst, elem.Value.(*cacheEntry).perm); err != nil {
+		// The entry is unusable, so drop it and let the caller download the
+		// file instead.
+		os.Remove(dst)
+		c.remove(elem)
+		return false
+	}
+	c.lru.MoveToFront(elem)
+	return true
+}
+
+// put copies the downloaded file at src, described by fi, into the cache.
+// Least recently used files are evicted to make room for it.
+func (c *Cache) put(fi *pfs.FileInfo, src string) error {
+	size := int64(fi.SizeBytes)
+	if size > c.maxSize {
+		return nil
+	}
+	info, err := os.Stat(src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The download didn't produce the file where it was expected, so
+			// there's nothing to cache.
+			return nil
+		}
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := cacheKey(fi)
+	if _, ok := c.entries[key]; ok {
+		// Another download cached the file first.
+		return nil
+	}
+	for c.size+size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+	// The file is copied to a temporary name first, so that a failed copy
+	// never leaves a partial file under the key.
+	tmp, err := ioutil.TempFile(c.root, "tmp-")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+	if err := copyFile(src, tmp.Name(), 0444); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(c.root, key)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, size: size, perm: info.Mode().Perm()})
+	c.size += size
+	return nil
+}
+
+// copyFile copies the file at src to dst, which is created or truncated and
+// given the permissions perm.
+func copyFile(src, dst string, perm os.FileMode) (retErr error) {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	if _, err := io.Copy(w, r); err != nil {
+		return err
+	}
+	return w.Chmod(perm)
+}
+
+// remove evicts elem from the cache. Copies of the file that were made from
+// the cache are unaffected.
+func (c *Cache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+	os.Remove(filepath.Join(c.root, entry.key))
+}
@@ -0,0 +1,63 @@
+package pfssync
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+func writeCacheTestFile(t *testing.T, p, content string) *pfs.FileInfo {
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0700))
+	require.NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+	h := pfs.NewHash()
+	h.Write([]byte(content))
+	return &pfs.FileInfo{
+		FileType:  pfs.FileType_FILE,
+		SizeBytes: uint64(len(content)),
+		Hash:      h.Sum(nil),
+	}
+}
+
+func TestCache(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewCache(filepath.Join(dir, "cache"), 10)
+	require.NoError(t, err)
+
+	a := writeCacheTestFile(t, filepath.Join(dir, "1", "a"), "aaaaaa")
+	require.False(t, c.copyOut(a, filepath.Join(dir, "2", "a")))
+	require.NoError(t, c.put(a, filepath.Join(dir, "1", "a")))
+	require.True(t, c.copyOut(a, filepath.Join(dir, "2", "a")))
+	data, err := ioutil.ReadFile(filepath.Join(dir, "2", "a"))
+	require.NoError(t, err)
+	require.Equal(t, "aaaaaa", string(data))
+	// Copies keep the file's mode, and writing to one doesn't change the
+	// cached file.
+	info, err := os.Stat(filepath.Join(dir, "2", "a"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0644), info.Mode().Perm())
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "2", "a"), []byte("changed"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "1", "a"), []byte("changed"), 0644))
+	require.True(t, c.copyOut(a, filepath.Join(dir, "5", "a")))
+	data, err = ioutil.ReadFile(filepath.Join(dir, "5", "a"))
+	require.NoError(t, err)
+	require.Equal(t, "aaaaaa", string(data))
+
+	// Adding b evicts a, since both don't fit.
+	b := writeCacheTestFile(t, filepath.Join(dir, "1", "b"), "bbbbbb")
+	require.NoError(t, c.put(b, filepath.Join(dir, "1", "b")))
+	require.False(t, c.copyOut(a, filepath.Join(dir, "3", "a")))
+	require.True(t, c.copyOut(b, filepath.Join(dir, "3", "b")))
+	// Copies made before the eviction are unaffected.
+	_, err = os.Stat(filepath.Join(dir, "2", "a"))
+	require.NoError(t, err)
+
+	// Files larger than the cache aren't cached.
+	big := writeCacheTestFile(t, filepath.Join(dir, "1", "big"), "0123456789abcdef")
+	require.NoError(t, c.put(big, filepath.Join(dir, "1", "big")))
+	require.False(t, c.copyOut(big, filepath.Join(dir, "4", "big")))
+	require.True(t, c.copyOut(b, filepath.Join(dir, "4", "b")))
+}
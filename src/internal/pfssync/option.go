@@ -1,6 +1,10 @@
 package pfssync
 
-import "archive/tar"
+import (
+	"archive/tar"
+
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
 
 // DownloadOption configures a download call.
 type DownloadOption func(*downloadConfig)
@@ -25,3 +29,13 @@ func WithHeaderCallback(cb func(*tar.Header) error) DownloadOption {
 		dc.headerCallback = cb
 	}
 }
+
+// WithCache configures the download call to copy the file described by
+// fileInfo out of cache, or to add it to cache once it's downloaded. Only
+// regular files are cached.
+func WithCache(cache *Cache, fileInfo *pfs.FileInfo) DownloadOption {
+	return func(dc *downloadConfig) {
+		dc.cache = cache
+		dc.cacheInfo = fileInfo
+	}
+}
@@ -69,6 +69,8 @@ func (d *downloader) closePipes() (retErr error) {
 type downloadConfig struct {
 	lazy, empty    bool
 	headerCallback func(*tar.Header) error
+	cache          *Cache
+	cacheInfo      *pfs.FileInfo
 }
 
 // Download a PFS file to a location on the local filesystem.
@@ -83,6 +85,13 @@ func (d *downloader) Download(storageRoot string, file *pfs.File, opts ...Downlo
 	if dc.lazy || dc.empty {
 		return d.downloadInfo(storageRoot, file, dc)
 	}
+	if dc.cache != nil && cacheable(dc.cacheInfo) {
+		return d.downloadCached(storageRoot, file, dc)
+	}
+	return d.downloadTar(storageRoot, file, dc)
+}
+
+func (d *downloader) downloadTar(storageRoot string, file *pfs.File, dc *downloadConfig) error {
 	r, err := d.pachClient.GetFileTar(file.Commit.Repo.Name, file.Commit.ID, file.Path)
 	if err != nil {
 		return err
@@ -93,6 +102,20 @@ func (d *downloader) Download(storageRoot string, file *pfs.File, opts ...Downlo
 	return tarutil.Import(storageRoot, r)
 }
 
+// downloadCached downloads a regular file through dc.cache. Files that are
+// copied out of the cache aren't passed to the header callback, since they
+// aren't downloaded.
+func (d *downloader) downloadCached(storageRoot string, file *pfs.File, dc *downloadConfig) error {
+	fullPath := path.Join(storageRoot, path.Join("/", file.Path))
+	if dc.cache.copyOut(dc.cacheInfo, fullPath) {
+		return nil
+	}
+	if err := d.downloadTar(storageRoot, file, dc); err != nil {
+		return err
+	}
+	return dc.cache.put(dc.cacheInfo, fullPath)
+}
+
 func (d *downloader) downloadInfo(storageRoot string, file *pfs.File, config *downloadConfig) (retErr error) {
 	repo := file.Commit.Repo.Name
 	commit := file.Commit.ID
@@ -102,8 +125,11 @@ func (d *downloader) downloadInfo(storageRoot string, file *pfs.File, config *do
 			return err
 		}
 		fullPath := path.Join(storageRoot, basePath)
-		if fi.FileType == pfs.FileType_DIR {
+		switch fi.FileType {
+		case pfs.FileType_DIR:
 			return os.MkdirAll(fullPath, 0700)
+		case pfs.FileType_SYMLINK:
+			return makeSymlink(fullPath, fi)
 		}
 		if config.lazy {
 			return d.makePipe(fullPath, func(w io.Writer) error {
@@ -129,6 +155,26 @@ func (d *downloader) downloadInfo(storageRoot string, file *pfs.File, config *do
 		if err != nil {
 			return err
 		}
-		return f.Close()
+		if err := f.Close(); err != nil {
+			return err
+		}
+		if fi.Mode != 0 {
+			return os.Chmod(fullPath, os.FileMode(fi.Mode).Perm())
+		}
+		return nil
 	})
 }
+
+// makeSymlink creates the symlink described by fi at fullPath. The target is
+// resolved within the commit and written relative to the link.
+func makeSymlink(fullPath string, fi *pfs.FileInfo) error {
+	linkDir := path.Dir(path.Join("/", fi.File.Path))
+	relTarget, err := filepath.Rel(linkDir, pfs.ResolveSymlink(fi.File.Path, fi.SymlinkTarget))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(fullPath), 0700); err != nil {
+		return err
+	}
+	return os.Symlink(relTarget, fullPath)
+}
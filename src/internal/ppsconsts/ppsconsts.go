@@ -21,4 +21,16 @@ const (
 
 	// SpoutMarkerBranch is the branch that spouts use for keeping track of spout marker files
 	SpoutMarkerBranch = "marker"
+
+	// SQLQueryLabel is the commit label that holds the query that a SQL
+	// input's commit is the result of
+	SQLQueryLabel = "sql_query"
+
+	// SQLSnapshotTimeLabel is the commit label that holds the time that a SQL
+	// input's query was run, in RFC 3339 format
+	SQLSnapshotTimeLabel = "sql_snapshot_time"
+
+	// BucketStateBranch is the branch of a bucket input's repo that keeps
+	// track of the version of each object that was last committed
+	BucketStateBranch = "bucket_state"
 )
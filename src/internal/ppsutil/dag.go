@@ -0,0 +1,98 @@
+package ppsutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	ppsclient "github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// DAGManifest describes the repos, branches and pipelines of a DAG. It's used
+// by 'apply'.
+type DAGManifest struct {
+	Repos     []*pfs.CreateRepoRequest
+	Branches  []*pfs.CreateBranchRequest
+	Pipelines []*ppsclient.CreatePipelineRequest
+}
+
+// dagManifestHolder holds the undecoded entries of a DAG manifest, which are
+// decoded as protos individually.
+type dagManifestHolder struct {
+	Repos     []json.RawMessage `json:"repos"`
+	Branches  []json.RawMessage `json:"branches"`
+	Pipelines []json.RawMessage `json:"pipelines"`
+}
+
+// ReadDAGManifest reads a DAG manifest from a path. The manifest is a JSON
+// or YAML object whose "repos", "branches" and "pipelines" fields are lists
+// of CreateRepoRequests, CreateBranchRequests and pipeline specs. If args is
+// non-nil, the manifest is a Go template that is rendered as described by
+// NewPipelineManifestReader.
+func ReadDAGManifest(path string, args map[string]string) (*DAGManifest, error) {
+	manifestBytes, err := readManifest(path)
+	if err != nil {
+		return nil, err
+	}
+	var tmpl *ppsclient.PipelineTemplate
+	if args != nil {
+		manifestBytes, err = RenderPipelineTemplate(manifestBytes, args)
+		if err != nil {
+			return nil, err
+		}
+		tmpl = &ppsclient.PipelineTemplate{Source: path, Args: args}
+	}
+	holder := &dagManifestHolder{}
+	if isJSON(manifestBytes) {
+		err = serde.DecodeJSON(manifestBytes, holder)
+	} else {
+		err = serde.DecodeYAML(manifestBytes, holder)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed DAG manifest")
+	}
+	result := &DAGManifest{}
+	for _, data := range holder.Repos {
+		req := &pfs.CreateRepoRequest{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(data), req); err != nil {
+			return nil, errors.Wrapf(err, "malformed repo %s", data)
+		}
+		if req.Repo == nil || req.Repo.Name == "" {
+			return nil, errors.Errorf("no repo `name` specified in %s", data)
+		}
+		result.Repos = append(result.Repos, req)
+	}
+	for _, data := range holder.Branches {
+		req := &pfs.CreateBranchRequest{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(data), req); err != nil {
+			return nil, errors.Wrapf(err, "malformed branch %s", data)
+		}
+		if req.Branch == nil || req.Branch.Repo == nil || req.Branch.Name == "" {
+			return nil, errors.Errorf("no branch `repo` and `name` specified in %s", data)
+		}
+		result.Branches = append(result.Branches, req)
+	}
+	for _, data := range holder.Pipelines {
+		r := &PipelineManifestReader{
+			decoder:  serde.NewJSONDecoder(bytes.NewReader(data)),
+			template: tmpl,
+		}
+		req, err := r.NextCreatePipelineRequest()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if req.Pipeline == nil || req.Pipeline.Name == "" {
+			return nil, errors.Errorf("no pipeline `name` specified in %s", data)
+		}
+		result.Pipelines = append(result.Pipelines, req)
+	}
+	return result, nil
+}
@@ -2,12 +2,14 @@ package ppsutil
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
+	"text/template"
 	"unicode"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
@@ -18,11 +20,50 @@ import (
 // PipelineManifestReader helps with unmarshalling pipeline configs from JSON.
 // It's used by 'create pipeline' and 'update pipeline'
 type PipelineManifestReader struct {
-	decoder serde.Decoder
+	decoder  serde.Decoder
+	template *ppsclient.PipelineTemplate
 }
 
-// NewPipelineManifestReader creates a new manifest reader from a path.
-func NewPipelineManifestReader(path string) (result *PipelineManifestReader, retErr error) {
+// NewPipelineManifestReader creates a new manifest reader from a path. If args
+// is non-nil, the manifest is a Go template which is rendered with args
+// before it's decoded, and the requests that are read from it record the
+// template and args.
+func NewPipelineManifestReader(path string, args map[string]string) (*PipelineManifestReader, error) {
+	pipelineBytes, err := readManifest(path)
+	if err != nil {
+		return nil, err
+	}
+	var tmpl *ppsclient.PipelineTemplate
+	if args != nil {
+		pipelineBytes, err = RenderPipelineTemplate(pipelineBytes, args)
+		if err != nil {
+			return nil, err
+		}
+		tmpl = &ppsclient.PipelineTemplate{Source: path, Args: args}
+	}
+	if isJSON(pipelineBytes) {
+		return &PipelineManifestReader{
+			decoder:  serde.NewJSONDecoder(bytes.NewReader(pipelineBytes)),
+			template: tmpl,
+		}, nil
+	}
+	return &PipelineManifestReader{
+		decoder:  serde.NewYAMLDecoder(bytes.NewReader(pipelineBytes)),
+		template: tmpl,
+	}, nil
+}
+
+// isJSON returns true if data should be decoded as JSON rather than YAML.
+func isJSON(data []byte) bool {
+	// TODO(msteffen): if we can get the yaml decoder to handle leading tabs, as
+	// in pps/cmds/cmds_test.go, then we can get rid of this
+	idx := bytes.IndexFunc(data, func(r rune) bool {
+		return !unicode.IsSpace(r)
+	})
+	return idx >= 0 && data[idx] == '{'
+}
+
+func readManifest(path string) (result []byte, retErr error) {
 	var pipelineBytes []byte
 	if path == "-" {
 		fmt.Print("Reading from stdin.\n")
@@ -52,19 +93,29 @@ func NewPipelineManifestReader(path string) (result *PipelineManifestReader, ret
 			return nil, err
 		}
 	}
-	// TODO(msteffen): if we can get the yaml decoder to handle leading tabs, as
-	// in pps/cmds/cmds_test.go, then we can get rid of this
-	idx := bytes.IndexFunc(pipelineBytes, func(r rune) bool {
-		return !unicode.IsSpace(r)
-	})
-	if idx >= 0 && pipelineBytes[idx] == '{' {
-		return &PipelineManifestReader{
-			decoder: serde.NewJSONDecoder(bytes.NewReader(pipelineBytes)),
-		}, nil
+	return pipelineBytes, nil
+}
+
+// RenderPipelineTemplate renders a pipeline manifest that is a Go template.
+// Args are accessed by name (e.g. {{.region}}) and are always strings, so
+// they're inserted exactly as given. Referring to an arg that isn't set is an
+// error. The toJSON function encodes a value as JSON, so that it can be
+// safely inserted into a manifest.
+func RenderPipelineTemplate(manifest []byte, args map[string]string) ([]byte, error) {
+	tmpl, err := template.New("pipeline").Option("missingkey=error").Funcs(template.FuncMap{
+		"toJSON": func(v interface{}) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}).Parse(string(manifest))
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed pipeline template")
 	}
-	return &PipelineManifestReader{
-		decoder: serde.NewYAMLDecoder(bytes.NewReader(pipelineBytes)),
-	}, nil
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, args); err != nil {
+		return nil, errors.Wrapf(err, "error rendering pipeline template")
+	}
+	return buf.Bytes(), nil
 }
 
 // NextCreatePipelineRequest gets the next request from the manifest reader.
@@ -109,6 +160,7 @@ func (r *PipelineManifestReader) NextCreatePipelineRequest() (*ppsclient.CreateP
 	case err != nil:
 		return nil, errors.Wrapf(err, "malformed pipeline spec")
 	default:
+		result.Template = r.template
 		return &result, nil
 	}
 }
@@ -0,0 +1,56 @@
+package ppsutil
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestPipelineTemplate(t *testing.T) {
+	manifest := `{
+  "pipeline": {"name": {{toJSON .name}}},
+  "transform": {"cmd": ["process", "--region", {{toJSON .region}}]},
+  "parallelism_spec": {"constant": {{.shards}}},
+  "input": {"pfs": {"repo": "data", "glob": "/*"}},
+  "description": {{toJSON .version}}{{if eq .service "true"}},
+  "service": {"internal_port": 8000, "external_port": 30000}{{end}}
+}`
+	path := filepath.Join(t.TempDir(), "pipeline.json.tmpl")
+	require.NoError(t, ioutil.WriteFile(path, []byte(manifest), 0600))
+	args := map[string]string{
+		"name":    "edges-eu",
+		"region":  "eu-west-1",
+		"shards":  "4",
+		"version": "1.10",
+		"service": "false",
+	}
+	r, err := NewPipelineManifestReader(path, args)
+	require.NoError(t, err)
+	req, err := r.NextCreatePipelineRequest()
+	require.NoError(t, err)
+	require.Equal(t, "edges-eu", req.Pipeline.Name)
+	require.Equal(t, []string{"process", "--region", "eu-west-1"}, req.Transform.Cmd)
+	require.Equal(t, uint64(4), req.ParallelismSpec.Constant)
+	require.True(t, req.Service == nil)
+	// Args are strings, so they aren't reformatted as JSON numbers.
+	require.Equal(t, "1.10", req.Description)
+	require.Equal(t, path, req.Template.Source)
+	require.Equal(t, args, req.Template.Args)
+
+	// Referring to an arg that isn't set is an error.
+	delete(args, "shards")
+	_, err = NewPipelineManifestReader(path, args)
+	require.YesError(t, err)
+
+	// Without args, the manifest isn't rendered.
+	literal := filepath.Join(t.TempDir(), "pipeline.json")
+	require.NoError(t, ioutil.WriteFile(literal, []byte(`{"pipeline": {"name": "{{x}}"}}`), 0600))
+	r, err = NewPipelineManifestReader(literal, nil)
+	require.NoError(t, err)
+	req, err = r.NextCreatePipelineRequest()
+	require.NoError(t, err)
+	require.Equal(t, "{{x}}", req.Pipeline.Name)
+	require.True(t, req.Template == nil)
+}
@@ -288,6 +288,16 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommitInfo *pfs.CommitInfo)
 				input.Cron.Commit = commit.ID
 			}
 		}
+		if input.SQL != nil {
+			if commit, ok := branchToCommit[key(input.SQL.Repo, "master")]; ok {
+				input.SQL.Commit = commit.ID
+			}
+		}
+		if input.Bucket != nil {
+			if commit, ok := branchToCommit[key(input.Bucket.Repo, "master")]; ok {
+				input.Bucket.Commit = commit.ID
+			}
+		}
 		if input.Git != nil {
 			if commit, ok := branchToCommit[key(input.Git.Name, input.Git.Branch)]; ok {
 				input.Git.Commit = commit.ID
@@ -327,6 +337,11 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		S3Out:                 pipelineInfo.S3Out,
 		Metadata:              pipelineInfo.Metadata,
 		ReprocessSpec:         pipelineInfo.ReprocessSpec,
+		PropagateLabels:       pipelineInfo.PropagateLabels,
+		DatumConcurrency:      pipelineInfo.DatumConcurrency,
+		PrioritizeNewestJobs:  pipelineInfo.PrioritizeNewestJobs,
+		Template:              pipelineInfo.Template,
+		JobRetries:            pipelineInfo.JobRetries,
 	}
 }
 
@@ -113,6 +113,24 @@ func (c *trackedClient) Create(ctx context.Context, md Metadata, chunkData []byt
 
 // Get writes data for a chunk with ID chunkID to w.
 func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback) (retErr error) {
+	key, err := c.key(chunkID)
+	if err != nil {
+		return err
+	}
+	return c.store.Get(ctx, key, cb)
+}
+
+// Exists returns true if the object for a chunk is present in the store.
+func (c *trackedClient) Exists(ctx context.Context, chunkID ID) (bool, error) {
+	key, err := c.key(chunkID)
+	if err != nil {
+		return false, err
+	}
+	return c.store.Exists(ctx, key)
+}
+
+// key returns the key of the uploaded object for a chunk.
+func (c *trackedClient) key(chunkID ID) ([]byte, error) {
 	var gen uint64
 	err := c.db.Get(&gen, `
 	SELECT gen
@@ -124,10 +142,9 @@ func (c *trackedClient) Get(ctx context.Context, chunkID ID, cb kv.ValueCallback
 		if err == sql.ErrNoRows {
 			err = errors.Errorf("no objects for chunk %v", chunkID)
 		}
-		return err
+		return nil, err
 	}
-	key := chunkKey(chunkID, gen)
-	return c.store.Get(ctx, key, cb)
+	return chunkKey(chunkID, gen), nil
 }
 
 // Close closes the client, stopping the background renewal of created objects
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/kv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/track"
@@ -72,6 +73,30 @@ func (s *Storage) List(ctx context.Context, cb func(id ID) error) error {
 	})
 }
 
+// Check checks that the object for the chunk with ID chunkID is present in
+// object storage. If readData is true, the object is also read and its
+// content is verified against the ID.
+func (s *Storage) Check(ctx context.Context, chunkID ID, readData bool) error {
+	client := &trackedClient{
+		store:   s.store,
+		db:      s.db,
+		tracker: s.tracker,
+	}
+	if readData {
+		return client.Get(ctx, chunkID, func(data []byte) error {
+			return verifyData(chunkID, data)
+		})
+	}
+	exists, err := client.Exists(ctx, chunkID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("object for chunk %v is missing", chunkID)
+	}
+	return nil
+}
+
 // NewDeleter creates a deleter for use with a tracker.GC
 func (s *Storage) NewDeleter() track.Deleter {
 	return &deleter{}
@@ -0,0 +1,43 @@
+package fileset
+
+import "github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+
+// Attributes are the properties of a file other than its content. The zero
+// value of a field means that it is unset.
+type Attributes struct {
+	// Metadata is user-defined metadata.
+	Metadata map[string]string
+	// Mode holds the file's permission bits.
+	Mode uint32
+	// SymlinkTarget is the path the file links to if it is a symlink.
+	SymlinkTarget string
+}
+
+// FileAttributes returns the attributes stored in a file index.
+func FileAttributes(f *index.File) Attributes {
+	return Attributes{
+		Metadata:      f.GetMetadata(),
+		Mode:          f.GetMode(),
+		SymlinkTarget: f.GetSymlinkTarget(),
+	}
+}
+
+// Merge returns a with the fields that are set in b overwritten.
+func (a Attributes) Merge(b Attributes) Attributes {
+	if b.Metadata != nil {
+		a.Metadata = b.Metadata
+	}
+	if b.Mode != 0 {
+		a.Mode = b.Mode
+	}
+	if b.SymlinkTarget != "" {
+		a.SymlinkTarget = b.SymlinkTarget
+	}
+	return a
+}
+
+func setAttributes(f *index.File, attrs Attributes) {
+	f.Metadata = attrs.Metadata
+	f.Mode = attrs.Mode
+	f.SymlinkTarget = attrs.SymlinkTarget
+}
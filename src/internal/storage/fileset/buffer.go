@@ -15,6 +15,7 @@ type Buffer struct {
 type file struct {
 	path  string
 	parts map[string]*part
+	attrs Attributes
 }
 
 type part struct {
@@ -45,6 +46,23 @@ func (b *Buffer) Add(p, tag string) io.Writer {
 	return buf
 }
 
+// SetAttributes sets the attributes of a file which has been added to the
+// buffer. Unset fields in attrs leave the file's existing values in place.
+func (b *Buffer) SetAttributes(p string, attrs Attributes) {
+	p = Clean(p, false)
+	if file, ok := b.additive[p]; ok {
+		file.attrs = file.attrs.Merge(attrs)
+	}
+}
+
+// Attributes returns the attributes of a file in the buffer.
+func (b *Buffer) Attributes(p string) Attributes {
+	if file, ok := b.additive[Clean(p, false)]; ok {
+		return file.attrs
+	}
+	return Attributes{}
+}
+
 func (b *Buffer) Delete(p string, tag ...string) {
 	p = Clean(p, IsDir(p))
 	if IsDir(p) {
@@ -0,0 +1,107 @@
+package fileset
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/chunk"
+)
+
+// Checker checks that file sets and the chunks they reference are present and
+// intact in storage. File sets and chunks are only checked once by a checker,
+// so a checker should be reused for file sets which share data.
+type Checker struct {
+	storage    *Storage
+	sampleRate float64
+	primitives map[ID]error
+	chunks     map[string]error
+}
+
+// NewChecker creates a new checker. sampleRate is the fraction of chunks
+// whose content is read and verified, the remaining chunks are only checked
+// for existence in object storage.
+func (s *Storage) NewChecker(sampleRate float64) *Checker {
+	return &Checker{
+		storage:    s,
+		sampleRate: sampleRate,
+		primitives: make(map[ID]error),
+		chunks:     make(map[string]error),
+	}
+}
+
+// Check checks the file set with the passed in ID.
+func (c *Checker) Check(ctx context.Context, id ID) error {
+	ids, err := c.storage.Flatten(ctx, []ID{id})
+	if err != nil {
+		return errors.Wrapf(err, "error reading file set %v", id.HexString())
+	}
+	for _, id := range ids {
+		if err := c.checkPrimitive(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Checker) checkPrimitive(ctx context.Context, id ID) error {
+	if err, ok := c.primitives[id]; ok {
+		return err
+	}
+	err := func() error {
+		prim, err := c.storage.getPrimitive(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, chunkID := range prim.PointsTo() {
+			if err := c.checkChunk(ctx, chunkID); err != nil {
+				return err
+			}
+		}
+		// Iterating over the index also reads the lower levels of the index.
+		r := c.storage.newReader(id)
+		for _, deletive := range []bool{true, false} {
+			if err := r.Iterate(ctx, func(f File) error {
+				idx := f.Index()
+				dataRefs := append(getDataRefs(idx.File.Parts), idx.File.DataRefs...)
+				for _, dataRef := range dataRefs {
+					if err := c.checkChunk(ctx, dataRef.Ref.Id); err != nil {
+						return errors.Wrapf(err, "error checking file %v", idx.Path)
+					}
+				}
+				return nil
+			}, deletive); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		err = errors.Wrapf(err, "error checking file set %v", id.HexString())
+	}
+	c.primitives[id] = err
+	return err
+}
+
+func (c *Checker) checkChunk(ctx context.Context, id chunk.ID) error {
+	key := id.HexString()
+	if err, ok := c.chunks[key]; ok {
+		return err
+	}
+	err := c.storage.chunks.Check(ctx, id, c.sample(id))
+	c.chunks[key] = err
+	return err
+}
+
+// sample deterministically selects chunks by their ID, which is a uniformly
+// distributed hash.
+func (c *Checker) sample(id chunk.ID) bool {
+	if c.sampleRate >= 1 {
+		return true
+	}
+	if len(id) < 8 {
+		return false
+	}
+	return float64(binary.BigEndian.Uint64(id)) < c.sampleRate*math.MaxUint64
+}
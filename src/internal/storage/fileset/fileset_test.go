@@ -6,6 +6,7 @@ import (
 	"io"
 	"math/rand"
 	"testing"
+	"time"
 
 	units "github.com/docker/go-units"
 	"golang.org/x/sync/errgroup"
@@ -217,3 +218,27 @@ func countChunks(t *testing.T, s *Storage) (count int64) {
 	}))
 	return count
 }
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	storage := newTestStorage(t)
+	var files []*testFile
+	for _, fileName := range index.Generate("ab") {
+		files = append(files, &testFile{
+			path: "/" + fileName,
+			parts: []*testPart{{
+				tag:  "0",
+				data: chunk.RandSeq(rand.Intn(units.MB)),
+			}},
+		})
+	}
+	id := writeFileSet(t, storage, files)
+	compositeID, err := storage.Compose(ctx, []ID{id, id}, time.Hour)
+	require.NoError(t, err)
+	for _, sampleRate := range []float64{0, 0.5, 1} {
+		checker := storage.NewChecker(sampleRate)
+		require.NoError(t, checker.Check(ctx, id))
+		require.NoError(t, checker.Check(ctx, *compositeID))
+	}
+	require.YesError(t, storage.NewChecker(1).Check(ctx, newID()))
+}
@@ -151,11 +151,18 @@ func (m *Range) GetChunkRef() *chunk.DataRef {
 }
 
 type File struct {
-	Parts                []*Part          `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
-	DataRefs             []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Parts    []*Part          `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	DataRefs []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
+	// metadata is user-defined metadata attached to the file when it was
+	// written. When filesets are merged, the newest metadata wins.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// mode holds the file's permission bits, or zero if they were never set.
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target is set if the file is a symbolic link.
+	SymlinkTarget        string   `protobuf:"bytes,5,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *File) Reset()         { *m = File{} }
@@ -205,6 +212,27 @@ func (m *File) GetDataRefs() []*chunk.DataRef {
 	return nil
 }
 
+func (m *File) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *File) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *File) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 type Part struct {
 	Tag                  string           `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	SizeBytes            int64            `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -272,6 +300,7 @@ func init() {
 	proto.RegisterType((*Index)(nil), "index.Index")
 	proto.RegisterType((*Range)(nil), "index.Range")
 	proto.RegisterType((*File)(nil), "index.File")
+	proto.RegisterMapType((map[string]string)(nil), "index.File.MetadataEntry")
 	proto.RegisterType((*Part)(nil), "index.Part")
 }
 
@@ -280,29 +309,35 @@ func init() {
 }
 
 var fileDescriptor_dfa1b84c403551af = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4d, 0x4b, 0xf3, 0x40,
-	0x10, 0xc7, 0x49, 0x93, 0x94, 0x66, 0xfa, 0xf0, 0x20, 0x7b, 0x90, 0xa0, 0x58, 0x6b, 0x4e, 0x45,
-	0x21, 0x81, 0xfa, 0x0d, 0x4a, 0x11, 0xbc, 0x95, 0x3d, 0x78, 0xf0, 0x52, 0xb7, 0xc9, 0xe4, 0x05,
-	0x63, 0x12, 0x76, 0xa7, 0x62, 0xfd, 0x84, 0x1e, 0xfd, 0x08, 0xd2, 0x4f, 0x22, 0xbb, 0x9b, 0x43,
-	0xa5, 0xd0, 0xcb, 0x30, 0x2f, 0xff, 0x99, 0xdf, 0x3f, 0x2f, 0x70, 0x5b, 0x35, 0x84, 0xb2, 0x11,
-	0x75, 0xa2, 0xa8, 0x95, 0xa2, 0xc0, 0x24, 0xaf, 0x6a, 0x54, 0x48, 0x49, 0xd5, 0x64, 0xf8, 0x61,
-	0x63, 0xdc, 0xc9, 0x96, 0x5a, 0xe6, 0x9b, 0xe2, 0x22, 0x3a, 0x5a, 0x49, 0xcb, 0x6d, 0xf3, 0x6a,
-	0xa3, 0x95, 0x46, 0x2f, 0xe0, 0x3f, 0x6a, 0x31, 0x63, 0xe0, 0x75, 0x82, 0xca, 0xd0, 0x99, 0x3a,
-	0xb3, 0x80, 0x9b, 0x9c, 0x45, 0xe0, 0x4b, 0xd1, 0x14, 0x18, 0x0e, 0xa6, 0xce, 0x6c, 0x3c, 0xff,
-	0x17, 0x5b, 0x08, 0xd7, 0x3d, 0x6e, 0x47, 0xec, 0x1a, 0x3c, 0x6d, 0x24, 0x74, 0x8d, 0x64, 0xdc,
-	0x4b, 0x1e, 0xaa, 0x1a, 0xb9, 0x19, 0x44, 0x15, 0xf8, 0x66, 0x81, 0x9d, 0xc3, 0xb0, 0xcd, 0x73,
-	0x85, 0x64, 0x18, 0x2e, 0xef, 0x2b, 0x76, 0x09, 0x41, 0x2d, 0x14, 0xad, 0x0d, 0x7e, 0x60, 0xf0,
-	0x23, 0xdd, 0x58, 0x69, 0x0b, 0x77, 0x10, 0x18, 0xbb, 0x6b, 0x89, 0x79, 0xcf, 0xf8, 0x1f, 0xdb,
-	0x07, 0x58, 0x0a, 0x12, 0x1c, 0x73, 0x3e, 0x32, 0x25, 0xc7, 0x3c, 0x7a, 0x02, 0x4f, 0x83, 0xd9,
-	0x0d, 0xf8, 0x9d, 0x90, 0xa4, 0x42, 0x67, 0xea, 0x1e, 0x98, 0x5a, 0x09, 0x49, 0xdc, 0x4e, 0xf4,
-	0xdd, 0x4c, 0x90, 0xd0, 0x67, 0x55, 0x38, 0x30, 0xb2, 0xa3, 0xbb, 0x99, 0x4d, 0x54, 0x94, 0x81,
-	0xa7, 0x77, 0xd9, 0x19, 0xb8, 0x24, 0x8a, 0xfe, 0x15, 0xe9, 0x94, 0x5d, 0x01, 0xa8, 0xea, 0x13,
-	0xd7, 0x9b, 0x1d, 0xa1, 0x32, 0xe6, 0x5d, 0x1e, 0xe8, 0xce, 0x42, 0x37, 0xfe, 0x52, 0xdc, 0xd3,
-	0x94, 0x05, 0xff, 0xda, 0x4f, 0x9c, 0xef, 0xfd, 0xc4, 0xf9, 0xd9, 0x4f, 0x9c, 0xe7, 0x65, 0x51,
-	0x51, 0xb9, 0xdd, 0xc4, 0x69, 0xfb, 0x96, 0x74, 0x22, 0x2d, 0x77, 0x19, 0xca, 0xc3, 0xec, 0x7d,
-	0x9e, 0x28, 0x99, 0x26, 0xa7, 0xff, 0x8a, 0xcd, 0xd0, 0x7c, 0xe5, 0xfb, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xd2, 0x05, 0x62, 0x3d, 0x3e, 0x02, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xdf, 0x8b, 0xd3, 0x40,
+	0x10, 0x26, 0xbf, 0x8e, 0x76, 0xce, 0x1e, 0xb2, 0x88, 0xc4, 0x13, 0x6b, 0x0c, 0x08, 0x45, 0x21,
+	0x81, 0x13, 0x41, 0xf4, 0xed, 0x38, 0x05, 0x1f, 0x84, 0x63, 0xf1, 0xc9, 0x97, 0x3a, 0x6d, 0x26,
+	0x69, 0x68, 0x9a, 0x94, 0xdd, 0xe9, 0x61, 0x7c, 0xf7, 0x7f, 0xf3, 0xd1, 0x3f, 0x41, 0xfa, 0x97,
+	0xc8, 0xee, 0x46, 0xe9, 0xe1, 0xd1, 0x97, 0x30, 0xf3, 0xcd, 0xf7, 0xcd, 0x37, 0x33, 0x59, 0x78,
+	0x51, 0xb7, 0x4c, 0xaa, 0xc5, 0x26, 0xd7, 0xdc, 0x29, 0xac, 0x28, 0x2f, 0xeb, 0x86, 0x34, 0x71,
+	0x5e, 0xb7, 0x05, 0x7d, 0x73, 0xdf, 0x6c, 0xab, 0x3a, 0xee, 0x44, 0x64, 0x93, 0xf3, 0xf4, 0x3f,
+	0xc9, 0x72, 0xb5, 0x6b, 0xd7, 0xee, 0xeb, 0xa8, 0xe9, 0x57, 0x88, 0x3e, 0x1a, 0xb2, 0x10, 0x10,
+	0x6e, 0x91, 0x57, 0xb1, 0x97, 0x78, 0xb3, 0xb1, 0xb4, 0xb1, 0x48, 0x21, 0x52, 0xd8, 0x56, 0x14,
+	0xfb, 0x89, 0x37, 0x3b, 0xbd, 0xb8, 0x97, 0x39, 0x13, 0x69, 0x30, 0xe9, 0x4a, 0xe2, 0x29, 0x84,
+	0x66, 0x90, 0x38, 0xb0, 0x94, 0xd3, 0x81, 0xf2, 0xa1, 0x6e, 0x48, 0xda, 0x42, 0x5a, 0x43, 0x64,
+	0x05, 0xe2, 0x21, 0x9c, 0x74, 0x65, 0xa9, 0x89, 0xad, 0x47, 0x20, 0x87, 0x4c, 0x3c, 0x86, 0x71,
+	0x83, 0x9a, 0xe7, 0xd6, 0xde, 0xb7, 0xf6, 0x23, 0x03, 0x5c, 0x9b, 0x11, 0x5e, 0xc2, 0xd8, 0x8e,
+	0x3b, 0x57, 0x54, 0x0e, 0x1e, 0x67, 0x99, 0x5b, 0xe0, 0x0a, 0x19, 0x25, 0x95, 0x72, 0x64, 0x53,
+	0x49, 0x65, 0xfa, 0xc3, 0x87, 0xd0, 0x38, 0x8b, 0x67, 0x10, 0x6d, 0x51, 0xb1, 0x8e, 0xbd, 0x24,
+	0x38, 0x98, 0xea, 0x1a, 0x15, 0x4b, 0x57, 0x31, 0x8d, 0x0b, 0x64, 0x34, 0x7d, 0x75, 0xec, 0x27,
+	0xc1, 0x5d, 0x8d, 0x0b, 0x17, 0x68, 0xf1, 0x1a, 0x46, 0x1b, 0x62, 0x34, 0x79, 0x1c, 0x58, 0xee,
+	0xa3, 0x83, 0x45, 0xb3, 0x4f, 0x43, 0xed, 0x7d, 0xcb, 0xaa, 0x97, 0xff, 0xa8, 0xe6, 0xa6, 0x9b,
+	0xae, 0xa0, 0x38, 0x4c, 0xbc, 0xd9, 0x44, 0xda, 0x58, 0x3c, 0x87, 0x33, 0xdd, 0x6f, 0x9a, 0xba,
+	0x5d, 0xcf, 0x19, 0x55, 0x45, 0x1c, 0x47, 0x76, 0xe5, 0xc9, 0x80, 0x7e, 0xb6, 0xe0, 0xf9, 0x3b,
+	0x98, 0xdc, 0xea, 0x2a, 0xee, 0x43, 0xb0, 0xa6, 0x7e, 0xf8, 0x3d, 0x26, 0x14, 0x0f, 0x20, 0xba,
+	0xc1, 0x66, 0x47, 0xc3, 0xcd, 0x5c, 0xf2, 0xd6, 0x7f, 0xe3, 0xa5, 0x05, 0x84, 0x66, 0x55, 0xa3,
+	0x61, 0xac, 0xfe, 0x6a, 0x18, 0x2b, 0xf1, 0x04, 0x40, 0xd7, 0xdf, 0x69, 0xbe, 0xe8, 0x99, 0xb4,
+	0x15, 0x06, 0x72, 0x6c, 0x90, 0x4b, 0x03, 0xdc, 0x3e, 0x4a, 0x70, 0xfc, 0x28, 0x97, 0xf2, 0xe7,
+	0x7e, 0xea, 0xfd, 0xda, 0x4f, 0xbd, 0xdf, 0xfb, 0xa9, 0xf7, 0xe5, 0xaa, 0xaa, 0x79, 0xb5, 0x5b,
+	0x64, 0xcb, 0x6e, 0x93, 0x6f, 0x71, 0xb9, 0xea, 0x0b, 0x52, 0x87, 0xd1, 0xcd, 0x45, 0xae, 0xd5,
+	0x32, 0x3f, 0xfe, 0x8a, 0x17, 0x27, 0xf6, 0x55, 0xbe, 0xfa, 0x33, 0x00, 0xaa, 0xc1, 0x63, 0x40,
+	0xee, 0x02, 0x00, 0x00,
 }
 
 func (m *Index) Marshal() (dAtA []byte, err error) {
@@ -438,6 +473,37 @@ func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Mode != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintIndex(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintIndex(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintIndex(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DataRefs) > 0 {
 		for iNdEx := len(m.DataRefs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -598,6 +664,21 @@ func (m *File) Size() (n int) {
 			n += 1 + l + sovIndex(uint64(l))
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovIndex(uint64(len(k))) + 1 + len(v) + sovIndex(uint64(len(v)))
+			n += mapEntrySize + 1 + sovIndex(uint64(mapEntrySize))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovIndex(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1025,6 +1106,184 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipIndex(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthIndex
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndex(dAtA[iNdEx:])
@@ -78,6 +78,10 @@ func mergeFile(fss []*fileStream) *index.Index {
 		if fs.deletive && idx.File.Parts == nil {
 			break
 		}
+		// The streams are ordered from newest to oldest.
+		if !fs.deletive {
+			setAttributes(mergeIdx.File, FileAttributes(idx.File).Merge(FileAttributes(mergeIdx.File)))
+		}
 		ps = append(ps, &partStream{
 			parts:    idx.File.Parts,
 			deletive: fs.deletive,
@@ -43,7 +43,15 @@ func newUnorderedWriter(ctx context.Context, storage *Storage, memThreshold int6
 	return uw, nil
 }
 
-func (uw *UnorderedWriter) Put(p string, appendFile bool, r io.Reader, customTag ...string) (retErr error) {
+// Put writes the content of r to a file.
+func (uw *UnorderedWriter) Put(p string, appendFile bool, r io.Reader, customTag ...string) error {
+	return uw.PutWithAttributes(p, appendFile, r, Attributes{}, customTag...)
+}
+
+// PutWithAttributes is like Put, but also sets the file's attributes,
+// replacing those set by earlier writes. Unset fields in attrs leave the
+// existing values in place when appending.
+func (uw *UnorderedWriter) PutWithAttributes(p string, appendFile bool, r io.Reader, attrs Attributes, customTag ...string) (retErr error) {
 	// TODO: Validate
 	//if err := ppath.ValidatePath(hdr.Name); err != nil {
 	//	return nil, err
@@ -57,6 +65,7 @@ func (uw *UnorderedWriter) Put(p string, appendFile bool, r io.Reader, customTag
 		uw.buffer.Delete(p)
 	}
 	w := uw.buffer.Add(p, tag)
+	uw.buffer.SetAttributes(p, attrs)
 	for {
 		n, err := io.CopyN(w, r, uw.memAvailable)
 		uw.memAvailable -= n
@@ -91,6 +100,7 @@ func (uw *UnorderedWriter) serialize() error {
 				if err != nil {
 					return err
 				}
+				fw.SetAttributes(uw.buffer.Attributes(p))
 			}
 			prev = p
 			fw.Add(tag)
@@ -189,6 +199,7 @@ func (uw *UnorderedWriter) Copy(ctx context.Context, fs FileSet, appendFile bool
 			if err != nil {
 				return err
 			}
+			fw.SetAttributes(FileAttributes(f.Index().File))
 			fw.Add(tag)
 			return f.Content(fw)
 		})
@@ -51,7 +51,14 @@ func deleteIndex(w *Writer, idx *index.Index) error {
 func WriteTarEntry(w io.Writer, f File) error {
 	idx := f.Index()
 	tw := tar.NewWriter(w)
-	if err := tw.WriteHeader(tarutil.NewHeader(idx.Path, index.SizeBytes(idx))); err != nil {
+	hdr := tarutil.NewHeader(idx.Path, index.SizeBytes(idx))
+	hdr.Mode = int64(idx.File.GetMode())
+	if target := idx.File.GetSymlinkTarget(); target != "" {
+		hdr.Typeflag = tar.TypeSymlink
+		hdr.Linkname = target
+		hdr.Size = 0
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
 		return err
 	}
 	if err := f.Content(tw); err != nil {
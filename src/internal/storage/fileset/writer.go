@@ -27,6 +27,11 @@ func (fw *FileWriter) Add(tag string) {
 	fw.idx.File.Parts = append(fw.idx.File.Parts, &index.Part{Tag: tag})
 }
 
+// SetAttributes sets the attributes of the file.
+func (fw *FileWriter) SetAttributes(attrs Attributes) {
+	setAttributes(fw.idx.File, attrs)
+}
+
 func (fw *FileWriter) Write(data []byte) (int, error) {
 	parts := fw.idx.File.Parts
 	if len(parts) < 1 {
@@ -138,7 +143,10 @@ func (w *Writer) Copy(file File) error {
 	copyIdx := &index.Index{
 		Path: idx.Path,
 		File: &index.File{
-			Parts: idx.File.Parts,
+			Parts:         idx.File.Parts,
+			Metadata:      idx.File.Metadata,
+			Mode:          idx.File.Mode,
+			SymlinkTarget: idx.File.SymlinkTarget,
 		},
 	}
 	if err := w.nextIdx(copyIdx); err != nil {
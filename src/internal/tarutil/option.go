@@ -13,7 +13,8 @@ func WithHeaderCallback(cb func(*tar.Header) error) ExportOption {
 }
 
 // WithSymlink configures the export call to execute the callback for each symlink encountered.
-// The callback that is passed into the callback should be executed if the symlinked file should be written to the tar stream.
+// The callback that is passed into the callback should be executed if the symlink should be written to the tar stream,
+// which writes it as a symlink if its target is a file within the storage root, or as a copy of its target otherwise.
 func WithSymlinkCallback(cb func(string, string, func() error) error) ExportOption {
 	return func(ec *exportConfig) {
 		ec.symlinkCallback = cb
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 )
@@ -173,20 +174,49 @@ func Import(storageRoot string, r io.Reader, cb ...func(*tar.Header) error) erro
 				return err
 			}
 		}
-		// TODO: Use the tar header metadata.
 		fullPath := path.Join(storageRoot, hdr.Name)
-		if hdr.Typeflag == tar.TypeDir {
+		switch hdr.Typeflag {
+		case tar.TypeDir:
 			if err := os.MkdirAll(fullPath, 0700); err != nil {
 				return err
 			}
 			continue
+		case tar.TypeSymlink:
+			if err := writeSymlink(storageRoot, hdr); err != nil {
+				return err
+			}
+			continue
 		}
 		if err := writeFile(fullPath, tr); err != nil {
 			return err
 		}
+		if hdr.Mode != 0 {
+			if err := os.Chmod(fullPath, hdr.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		}
 	}
 }
 
+func writeSymlink(storageRoot string, hdr *tar.Header) error {
+	linkPath := path.Join(storageRoot, hdr.Name)
+	if err := os.MkdirAll(path.Dir(linkPath), 0700); err != nil {
+		return err
+	}
+	// Targets are resolved within the root of the tar stream, then written
+	// relative to the link so that they can't point outside of storageRoot.
+	linkDir := path.Dir(path.Join("/", hdr.Name))
+	target := hdr.Linkname
+	if !path.IsAbs(target) {
+		target = path.Join(linkDir, target)
+	}
+	target, err := filepath.Rel(linkDir, path.Clean("/"+target))
+	if err != nil {
+		return err
+	}
+	return os.Symlink(target, linkPath)
+}
+
 func writeFile(filePath string, r io.Reader) (retErr error) {
 	if err := os.MkdirAll(path.Dir(filePath), 0700); err != nil {
 		return err
@@ -260,24 +290,54 @@ func Export(storageRoot string, w io.Writer, opts ...ExportOption) (retErr error
 				return err
 			}
 			if fi.Mode()&os.ModeSymlink != 0 {
-				var err error
-				file, err = os.Readlink(file)
+				link := file
+				target, err := os.Readlink(link)
 				if err != nil {
 					return err
 				}
+				if !filepath.IsAbs(target) {
+					target = filepath.Join(filepath.Dir(link), target)
+				}
+				file = filepath.Clean(target)
 				fi, err = os.Stat(file)
 				if err != nil {
 					return err
 				}
+				// Symlinks to files within the storage root are written as
+				// symlinks, others are written as a copy of their target.
+				linkFunc := copyFunc
+				if relTarget, err := filepath.Rel(filepath.Dir(link), file); err == nil && isWithin(storageRoot, file) && !fi.IsDir() {
+					linkFunc = func() error {
+						hdr := &tar.Header{
+							Typeflag: tar.TypeSymlink,
+							Name:     relPath,
+							Linkname: relTarget,
+							Mode:     0777,
+						}
+						if ec.headerCallback != nil {
+							if err := ec.headerCallback(hdr); err != nil {
+								return err
+							}
+						}
+						return tw.WriteHeader(hdr)
+					}
+				}
 				if ec.symlinkCallback != nil {
-					return ec.symlinkCallback(relPath, file, copyFunc)
+					return ec.symlinkCallback(relPath, file, linkFunc)
 				}
+				return linkFunc()
 			}
 			return copyFunc()
 		})
 	})
 }
 
+// isWithin returns true if file is within dir.
+func isWithin(dir, file string) bool {
+	relPath, err := filepath.Rel(dir, file)
+	return err == nil && relPath != ".." && !strings.HasPrefix(relPath, "../")
+}
+
 // Reader converts a set of files to a tar stream.
 // TODO: Probably should just go to disk for this.
 func NewReader(files []File) (io.Reader, error) {
@@ -386,9 +386,11 @@ func (api *enterpriseServerAPI) Heartbeat(ctx context.Context, req *enterprise.H
 
 type activateAuthPFSFunc func(context.Context, *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error)
 type createRepoFunc func(context.Context, *pfs.CreateRepoRequest) (*types.Empty, error)
+type forkRepoFunc func(context.Context, *pfs.ForkRepoRequest) (*pfs.Commit, error)
 type inspectRepoFunc func(context.Context, *pfs.InspectRepoRequest) (*pfs.RepoInfo, error)
 type listRepoFunc func(context.Context, *pfs.ListRepoRequest) (*pfs.ListRepoResponse, error)
 type deleteRepoFunc func(context.Context, *pfs.DeleteRepoRequest) (*types.Empty, error)
+type renameRepoFunc func(context.Context, *pfs.RenameRepoRequest) (*types.Empty, error)
 type startCommitFunc func(context.Context, *pfs.StartCommitRequest) (*pfs.Commit, error)
 type finishCommitFunc func(context.Context, *pfs.FinishCommitRequest) (*types.Empty, error)
 type inspectCommitFunc func(context.Context, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
@@ -401,12 +403,22 @@ type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Em
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(context.Context, *pfs.ListBranchRequest) (*pfs.BranchInfos, error)
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*types.Empty, error)
+type renameBranchFunc func(context.Context, *pfs.RenameBranchRequest) (*types.Empty, error)
+type mergeBranchFunc func(context.Context, *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error)
+type createTagFunc func(context.Context, *pfs.CreateTagRequest) (*types.Empty, error)
+type inspectTagFunc func(context.Context, *pfs.InspectTagRequest) (*pfs.TagInfo, error)
+type listTagFunc func(context.Context, *pfs.ListTagRequest) (*pfs.TagInfos, error)
+type deleteTagFunc func(context.Context, *pfs.DeleteTagRequest) (*types.Empty, error)
+type setRetentionPolicyFunc func(context.Context, *pfs.SetRetentionPolicyRequest) (*types.Empty, error)
+type enforceRetentionFunc func(context.Context, *pfs.EnforceRetentionRequest) (*pfs.EnforceRetentionResponse, error)
+type setLabelsFunc func(context.Context, *pfs.SetLabelsRequest) (*types.Empty, error)
 type modifyFileFunc func(pfs.API_ModifyFileServer) error
 type getFileFunc func(*pfs.GetFileRequest, pfs.API_GetFileServer) error
 type inspectFileFunc func(context.Context, *pfs.InspectFileRequest) (*pfs.FileInfo, error)
 type listFileFunc func(*pfs.ListFileRequest, pfs.API_ListFileServer) error
 type walkFileFunc func(*pfs.WalkFileRequest, pfs.API_WalkFileServer) error
 type globFileFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileServer) error
+type grepFileFunc func(*pfs.GrepFileRequest, pfs.API_GrepFileServer) error
 type diffFileFunc func(*pfs.DiffFileRequest, pfs.API_DiffFileServer) error
 type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
@@ -417,9 +429,11 @@ type renewFilesetFunc func(context.Context, *pfs.RenewFilesetRequest) (*types.Em
 
 type mockActivateAuthPFS struct{ handler activateAuthPFSFunc }
 type mockCreateRepo struct{ handler createRepoFunc }
+type mockForkRepo struct{ handler forkRepoFunc }
 type mockInspectRepo struct{ handler inspectRepoFunc }
 type mockListRepo struct{ handler listRepoFunc }
 type mockDeleteRepo struct{ handler deleteRepoFunc }
+type mockRenameRepo struct{ handler renameRepoFunc }
 type mockStartCommit struct{ handler startCommitFunc }
 type mockFinishCommit struct{ handler finishCommitFunc }
 type mockInspectCommit struct{ handler inspectCommitFunc }
@@ -432,12 +446,22 @@ type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockRenameBranch struct{ handler renameBranchFunc }
+type mockMergeBranch struct{ handler mergeBranchFunc }
+type mockCreateTag struct{ handler createTagFunc }
+type mockInspectTag struct{ handler inspectTagFunc }
+type mockListTag struct{ handler listTagFunc }
+type mockDeleteTag struct{ handler deleteTagFunc }
+type mockSetRetentionPolicy struct{ handler setRetentionPolicyFunc }
+type mockEnforceRetention struct{ handler enforceRetentionFunc }
+type mockSetLabels struct{ handler setLabelsFunc }
 type mockModifyFile struct{ handler modifyFileFunc }
 type mockGetFile struct{ handler getFileFunc }
 type mockInspectFile struct{ handler inspectFileFunc }
 type mockListFile struct{ handler listFileFunc }
 type mockWalkFile struct{ handler walkFileFunc }
 type mockGlobFile struct{ handler globFileFunc }
+type mockGrepFile struct{ handler grepFileFunc }
 type mockDiffFile struct{ handler diffFileFunc }
 type mockDeleteAllPFS struct{ handler deleteAllPFSFunc }
 type mockFsck struct{ handler fsckFunc }
@@ -446,73 +470,97 @@ type mockAddFileset struct{ handler addFilesetFunc }
 type mockGetFileset struct{ handler getFilesetFunc }
 type mockRenewFileset struct{ handler renewFilesetFunc }
 
-func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc) { mock.handler = cb }
-func (mock *mockCreateRepo) Use(cb createRepoFunc)           { mock.handler = cb }
-func (mock *mockInspectRepo) Use(cb inspectRepoFunc)         { mock.handler = cb }
-func (mock *mockListRepo) Use(cb listRepoFunc)               { mock.handler = cb }
-func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)           { mock.handler = cb }
-func (mock *mockStartCommit) Use(cb startCommitFunc)         { mock.handler = cb }
-func (mock *mockFinishCommit) Use(cb finishCommitFunc)       { mock.handler = cb }
-func (mock *mockInspectCommit) Use(cb inspectCommitFunc)     { mock.handler = cb }
-func (mock *mockListCommit) Use(cb listCommitFunc)           { mock.handler = cb }
-func (mock *mockSquashCommit) Use(cb squashCommitFunc)       { mock.handler = cb }
-func (mock *mockFlushCommit) Use(cb flushCommitFunc)         { mock.handler = cb }
-func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc) { mock.handler = cb }
-func (mock *mockClearCommit) Use(cb clearCommitFunc)         { mock.handler = cb }
-func (mock *mockCreateBranch) Use(cb createBranchFunc)       { mock.handler = cb }
-func (mock *mockInspectBranch) Use(cb inspectBranchFunc)     { mock.handler = cb }
-func (mock *mockListBranch) Use(cb listBranchFunc)           { mock.handler = cb }
-func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)       { mock.handler = cb }
-func (mock *mockModifyFile) Use(cb modifyFileFunc)           { mock.handler = cb }
-func (mock *mockGetFile) Use(cb getFileFunc)                 { mock.handler = cb }
-func (mock *mockInspectFile) Use(cb inspectFileFunc)         { mock.handler = cb }
-func (mock *mockListFile) Use(cb listFileFunc)               { mock.handler = cb }
-func (mock *mockWalkFile) Use(cb walkFileFunc)               { mock.handler = cb }
-func (mock *mockGlobFile) Use(cb globFileFunc)               { mock.handler = cb }
-func (mock *mockDiffFile) Use(cb diffFileFunc)               { mock.handler = cb }
-func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)       { mock.handler = cb }
-func (mock *mockFsck) Use(cb fsckFunc)                       { mock.handler = cb }
-func (mock *mockCreateFileset) Use(cb createFilesetFunc)     { mock.handler = cb }
-func (mock *mockAddFileset) Use(cb addFilesetFunc)           { mock.handler = cb }
-func (mock *mockGetFileset) Use(cb getFilesetFunc)           { mock.handler = cb }
-func (mock *mockRenewFileset) Use(cb renewFilesetFunc)       { mock.handler = cb }
+func (mock *mockActivateAuthPFS) Use(cb activateAuthPFSFunc)       { mock.handler = cb }
+func (mock *mockCreateRepo) Use(cb createRepoFunc)                 { mock.handler = cb }
+func (mock *mockForkRepo) Use(cb forkRepoFunc)                     { mock.handler = cb }
+func (mock *mockInspectRepo) Use(cb inspectRepoFunc)               { mock.handler = cb }
+func (mock *mockListRepo) Use(cb listRepoFunc)                     { mock.handler = cb }
+func (mock *mockDeleteRepo) Use(cb deleteRepoFunc)                 { mock.handler = cb }
+func (mock *mockRenameRepo) Use(cb renameRepoFunc)                 { mock.handler = cb }
+func (mock *mockStartCommit) Use(cb startCommitFunc)               { mock.handler = cb }
+func (mock *mockFinishCommit) Use(cb finishCommitFunc)             { mock.handler = cb }
+func (mock *mockInspectCommit) Use(cb inspectCommitFunc)           { mock.handler = cb }
+func (mock *mockListCommit) Use(cb listCommitFunc)                 { mock.handler = cb }
+func (mock *mockSquashCommit) Use(cb squashCommitFunc)             { mock.handler = cb }
+func (mock *mockFlushCommit) Use(cb flushCommitFunc)               { mock.handler = cb }
+func (mock *mockSubscribeCommit) Use(cb subscribeCommitFunc)       { mock.handler = cb }
+func (mock *mockClearCommit) Use(cb clearCommitFunc)               { mock.handler = cb }
+func (mock *mockCreateBranch) Use(cb createBranchFunc)             { mock.handler = cb }
+func (mock *mockInspectBranch) Use(cb inspectBranchFunc)           { mock.handler = cb }
+func (mock *mockListBranch) Use(cb listBranchFunc)                 { mock.handler = cb }
+func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)             { mock.handler = cb }
+func (mock *mockRenameBranch) Use(cb renameBranchFunc)             { mock.handler = cb }
+func (mock *mockMergeBranch) Use(cb mergeBranchFunc)               { mock.handler = cb }
+func (mock *mockCreateTag) Use(cb createTagFunc)                   { mock.handler = cb }
+func (mock *mockInspectTag) Use(cb inspectTagFunc)                 { mock.handler = cb }
+func (mock *mockListTag) Use(cb listTagFunc)                       { mock.handler = cb }
+func (mock *mockDeleteTag) Use(cb deleteTagFunc)                   { mock.handler = cb }
+func (mock *mockSetRetentionPolicy) Use(cb setRetentionPolicyFunc) { mock.handler = cb }
+func (mock *mockEnforceRetention) Use(cb enforceRetentionFunc)     { mock.handler = cb }
+func (mock *mockSetLabels) Use(cb setLabelsFunc)                   { mock.handler = cb }
+func (mock *mockModifyFile) Use(cb modifyFileFunc)                 { mock.handler = cb }
+func (mock *mockGetFile) Use(cb getFileFunc)                       { mock.handler = cb }
+func (mock *mockInspectFile) Use(cb inspectFileFunc)               { mock.handler = cb }
+func (mock *mockListFile) Use(cb listFileFunc)                     { mock.handler = cb }
+func (mock *mockWalkFile) Use(cb walkFileFunc)                     { mock.handler = cb }
+func (mock *mockGlobFile) Use(cb globFileFunc)                     { mock.handler = cb }
+func (mock *mockGrepFile) Use(cb grepFileFunc)                     { mock.handler = cb }
+func (mock *mockDiffFile) Use(cb diffFileFunc)                     { mock.handler = cb }
+func (mock *mockDeleteAllPFS) Use(cb deleteAllPFSFunc)             { mock.handler = cb }
+func (mock *mockFsck) Use(cb fsckFunc)                             { mock.handler = cb }
+func (mock *mockCreateFileset) Use(cb createFilesetFunc)           { mock.handler = cb }
+func (mock *mockAddFileset) Use(cb addFilesetFunc)                 { mock.handler = cb }
+func (mock *mockGetFileset) Use(cb getFilesetFunc)                 { mock.handler = cb }
+func (mock *mockRenewFileset) Use(cb renewFilesetFunc)             { mock.handler = cb }
 
 type pfsServerAPI struct {
 	mock *mockPFSServer
 }
 
 type mockPFSServer struct {
-	api             pfsServerAPI
-	ActivateAuth    mockActivateAuthPFS
-	CreateRepo      mockCreateRepo
-	InspectRepo     mockInspectRepo
-	ListRepo        mockListRepo
-	DeleteRepo      mockDeleteRepo
-	StartCommit     mockStartCommit
-	FinishCommit    mockFinishCommit
-	InspectCommit   mockInspectCommit
-	ListCommit      mockListCommit
-	SquashCommit    mockSquashCommit
-	FlushCommit     mockFlushCommit
-	SubscribeCommit mockSubscribeCommit
-	ClearCommit     mockClearCommit
-	CreateBranch    mockCreateBranch
-	InspectBranch   mockInspectBranch
-	ListBranch      mockListBranch
-	DeleteBranch    mockDeleteBranch
-	ModifyFile      mockModifyFile
-	GetFile         mockGetFile
-	InspectFile     mockInspectFile
-	ListFile        mockListFile
-	WalkFile        mockWalkFile
-	GlobFile        mockGlobFile
-	DiffFile        mockDiffFile
-	DeleteAll       mockDeleteAllPFS
-	Fsck            mockFsck
-	CreateFileset   mockCreateFileset
-	AddFileset      mockAddFileset
-	GetFileset      mockGetFileset
-	RenewFileset    mockRenewFileset
+	api                pfsServerAPI
+	ActivateAuth       mockActivateAuthPFS
+	CreateRepo         mockCreateRepo
+	ForkRepo           mockForkRepo
+	InspectRepo        mockInspectRepo
+	ListRepo           mockListRepo
+	DeleteRepo         mockDeleteRepo
+	RenameRepo         mockRenameRepo
+	StartCommit        mockStartCommit
+	FinishCommit       mockFinishCommit
+	InspectCommit      mockInspectCommit
+	ListCommit         mockListCommit
+	SquashCommit       mockSquashCommit
+	FlushCommit        mockFlushCommit
+	SubscribeCommit    mockSubscribeCommit
+	ClearCommit        mockClearCommit
+	CreateBranch       mockCreateBranch
+	InspectBranch      mockInspectBranch
+	ListBranch         mockListBranch
+	DeleteBranch       mockDeleteBranch
+	RenameBranch       mockRenameBranch
+	MergeBranch        mockMergeBranch
+	CreateTag          mockCreateTag
+	InspectTag         mockInspectTag
+	ListTag            mockListTag
+	DeleteTag          mockDeleteTag
+	SetRetentionPolicy mockSetRetentionPolicy
+	EnforceRetention   mockEnforceRetention
+	SetLabels          mockSetLabels
+	ModifyFile         mockModifyFile
+	GetFile            mockGetFile
+	InspectFile        mockInspectFile
+	ListFile           mockListFile
+	WalkFile           mockWalkFile
+	GlobFile           mockGlobFile
+	GrepFile           mockGrepFile
+	DiffFile           mockDiffFile
+	DeleteAll          mockDeleteAllPFS
+	Fsck               mockFsck
+	CreateFileset      mockCreateFileset
+	AddFileset         mockAddFileset
+	GetFileset         mockGetFileset
+	RenewFileset       mockRenewFileset
 }
 
 func (api *pfsServerAPI) ActivateAuth(ctx context.Context, req *pfs.ActivateAuthRequest) (*pfs.ActivateAuthResponse, error) {
@@ -527,6 +575,12 @@ func (api *pfsServerAPI) CreateRepo(ctx context.Context, req *pfs.CreateRepoRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.CreateRepo")
 }
+func (api *pfsServerAPI) ForkRepo(ctx context.Context, req *pfs.ForkRepoRequest) (*pfs.Commit, error) {
+	if api.mock.ForkRepo.handler != nil {
+		return api.mock.ForkRepo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ForkRepo")
+}
 func (api *pfsServerAPI) InspectRepo(ctx context.Context, req *pfs.InspectRepoRequest) (*pfs.RepoInfo, error) {
 	if api.mock.InspectRepo.handler != nil {
 		return api.mock.InspectRepo.handler(ctx, req)
@@ -545,6 +599,12 @@ func (api *pfsServerAPI) DeleteRepo(ctx context.Context, req *pfs.DeleteRepoRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteRepo")
 }
+func (api *pfsServerAPI) RenameRepo(ctx context.Context, req *pfs.RenameRepoRequest) (*types.Empty, error) {
+	if api.mock.RenameRepo.handler != nil {
+		return api.mock.RenameRepo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RenameRepo")
+}
 func (api *pfsServerAPI) StartCommit(ctx context.Context, req *pfs.StartCommitRequest) (*pfs.Commit, error) {
 	if api.mock.StartCommit.handler != nil {
 		return api.mock.StartCommit.handler(ctx, req)
@@ -617,6 +677,60 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) RenameBranch(ctx context.Context, req *pfs.RenameBranchRequest) (*types.Empty, error) {
+	if api.mock.RenameBranch.handler != nil {
+		return api.mock.RenameBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RenameBranch")
+}
+func (api *pfsServerAPI) MergeBranch(ctx context.Context, req *pfs.MergeBranchRequest) (*pfs.MergeBranchResponse, error) {
+	if api.mock.MergeBranch.handler != nil {
+		return api.mock.MergeBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.MergeBranch")
+}
+func (api *pfsServerAPI) CreateTag(ctx context.Context, req *pfs.CreateTagRequest) (*types.Empty, error) {
+	if api.mock.CreateTag.handler != nil {
+		return api.mock.CreateTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CreateTag")
+}
+func (api *pfsServerAPI) InspectTag(ctx context.Context, req *pfs.InspectTagRequest) (*pfs.TagInfo, error) {
+	if api.mock.InspectTag.handler != nil {
+		return api.mock.InspectTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectTag")
+}
+func (api *pfsServerAPI) ListTag(ctx context.Context, req *pfs.ListTagRequest) (*pfs.TagInfos, error) {
+	if api.mock.ListTag.handler != nil {
+		return api.mock.ListTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.ListTag")
+}
+func (api *pfsServerAPI) DeleteTag(ctx context.Context, req *pfs.DeleteTagRequest) (*types.Empty, error) {
+	if api.mock.DeleteTag.handler != nil {
+		return api.mock.DeleteTag.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteTag")
+}
+func (api *pfsServerAPI) SetRetentionPolicy(ctx context.Context, req *pfs.SetRetentionPolicyRequest) (*types.Empty, error) {
+	if api.mock.SetRetentionPolicy.handler != nil {
+		return api.mock.SetRetentionPolicy.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetRetentionPolicy")
+}
+func (api *pfsServerAPI) EnforceRetention(ctx context.Context, req *pfs.EnforceRetentionRequest) (*pfs.EnforceRetentionResponse, error) {
+	if api.mock.EnforceRetention.handler != nil {
+		return api.mock.EnforceRetention.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.EnforceRetention")
+}
+func (api *pfsServerAPI) SetLabels(ctx context.Context, req *pfs.SetLabelsRequest) (*types.Empty, error) {
+	if api.mock.SetLabels.handler != nil {
+		return api.mock.SetLabels.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.SetLabels")
+}
 func (api *pfsServerAPI) ModifyFile(serv pfs.API_ModifyFileServer) error {
 	if api.mock.ModifyFile.handler != nil {
 		return api.mock.ModifyFile.handler(serv)
@@ -653,6 +767,12 @@ func (api *pfsServerAPI) GlobFile(req *pfs.GlobFileRequest, serv pfs.API_GlobFil
 	}
 	return errors.Errorf("unhandled pachd mock pfs.GlobFile")
 }
+func (api *pfsServerAPI) GrepFile(req *pfs.GrepFileRequest, serv pfs.API_GrepFileServer) error {
+	if api.mock.GrepFile.handler != nil {
+		return api.mock.GrepFile.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.GrepFile")
+}
 func (api *pfsServerAPI) DiffFile(req *pfs.DiffFileRequest, serv pfs.API_DiffFileServer) error {
 	if api.mock.DiffFile.handler != nil {
 		return api.mock.DiffFile.handler(req, serv)
@@ -708,6 +828,7 @@ type updateJobStateFunc func(context.Context, *pps.UpdateJobStateRequest) (*type
 type inspectDatumFunc func(context.Context, *pps.InspectDatumRequest) (*pps.DatumInfo, error)
 type listDatumFunc func(*pps.ListDatumRequest, pps.API_ListDatumServer) error
 type restartDatumFunc func(context.Context, *pps.RestartDatumRequest) (*types.Empty, error)
+type fileLineageFunc func(*pps.FileLineageRequest, pps.API_FileLineageServer) error
 type createPipelineFunc func(context.Context, *pps.CreatePipelineRequest) (*types.Empty, error)
 type inspectPipelineFunc func(context.Context, *pps.InspectPipelineRequest) (*pps.PipelineInfo, error)
 type listPipelineFunc func(context.Context, *pps.ListPipelineRequest) (*pps.PipelineInfos, error)
@@ -715,6 +836,7 @@ type deletePipelineFunc func(context.Context, *pps.DeletePipelineRequest) (*type
 type startPipelineFunc func(context.Context, *pps.StartPipelineRequest) (*types.Empty, error)
 type stopPipelineFunc func(context.Context, *pps.StopPipelineRequest) (*types.Empty, error)
 type runPipelineFunc func(context.Context, *pps.RunPipelineRequest) (*types.Empty, error)
+type rollbackPipelineFunc func(context.Context, *pps.RollbackPipelineRequest) (*types.Empty, error)
 type runCronFunc func(context.Context, *pps.RunCronRequest) (*types.Empty, error)
 type createSecretFunc func(context.Context, *pps.CreateSecretRequest) (*types.Empty, error)
 type deleteSecretFunc func(context.Context, *pps.DeleteSecretRequest) (*types.Empty, error)
@@ -734,6 +856,7 @@ type mockUpdateJobState struct{ handler updateJobStateFunc }
 type mockInspectDatum struct{ handler inspectDatumFunc }
 type mockListDatum struct{ handler listDatumFunc }
 type mockRestartDatum struct{ handler restartDatumFunc }
+type mockFileLineage struct{ handler fileLineageFunc }
 type mockCreatePipeline struct{ handler createPipelineFunc }
 type mockInspectPipeline struct{ handler inspectPipelineFunc }
 type mockListPipeline struct{ handler listPipelineFunc }
@@ -741,6 +864,7 @@ type mockDeletePipeline struct{ handler deletePipelineFunc }
 type mockStartPipeline struct{ handler startPipelineFunc }
 type mockStopPipeline struct{ handler stopPipelineFunc }
 type mockRunPipeline struct{ handler runPipelineFunc }
+type mockRollbackPipeline struct{ handler rollbackPipelineFunc }
 type mockRunCron struct{ handler runCronFunc }
 type mockCreateSecret struct{ handler createSecretFunc }
 type mockDeleteSecret struct{ handler deleteSecretFunc }
@@ -750,63 +874,67 @@ type mockDeleteAllPPS struct{ handler deleteAllPPSFunc }
 type mockGetLogs struct{ handler getLogsFunc }
 type mockActivateAuthPPS struct{ handler activateAuthPPSFunc }
 
-func (mock *mockCreateJob) Use(cb createJobFunc)             { mock.handler = cb }
-func (mock *mockInspectJob) Use(cb inspectJobFunc)           { mock.handler = cb }
-func (mock *mockListJob) Use(cb listJobFunc)                 { mock.handler = cb }
-func (mock *mockFlushJob) Use(cb flushJobFunc)               { mock.handler = cb }
-func (mock *mockDeleteJob) Use(cb deleteJobFunc)             { mock.handler = cb }
-func (mock *mockStopJob) Use(cb stopJobFunc)                 { mock.handler = cb }
-func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)   { mock.handler = cb }
-func (mock *mockInspectDatum) Use(cb inspectDatumFunc)       { mock.handler = cb }
-func (mock *mockListDatum) Use(cb listDatumFunc)             { mock.handler = cb }
-func (mock *mockRestartDatum) Use(cb restartDatumFunc)       { mock.handler = cb }
-func (mock *mockCreatePipeline) Use(cb createPipelineFunc)   { mock.handler = cb }
-func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc) { mock.handler = cb }
-func (mock *mockListPipeline) Use(cb listPipelineFunc)       { mock.handler = cb }
-func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)   { mock.handler = cb }
-func (mock *mockStartPipeline) Use(cb startPipelineFunc)     { mock.handler = cb }
-func (mock *mockStopPipeline) Use(cb stopPipelineFunc)       { mock.handler = cb }
-func (mock *mockRunPipeline) Use(cb runPipelineFunc)         { mock.handler = cb }
-func (mock *mockRunCron) Use(cb runCronFunc)                 { mock.handler = cb }
-func (mock *mockCreateSecret) Use(cb createSecretFunc)       { mock.handler = cb }
-func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)       { mock.handler = cb }
-func (mock *mockInspectSecret) Use(cb inspectSecretFunc)     { mock.handler = cb }
-func (mock *mockListSecret) Use(cb listSecretFunc)           { mock.handler = cb }
-func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)       { mock.handler = cb }
-func (mock *mockGetLogs) Use(cb getLogsFunc)                 { mock.handler = cb }
-func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc) { mock.handler = cb }
+func (mock *mockCreateJob) Use(cb createJobFunc)               { mock.handler = cb }
+func (mock *mockInspectJob) Use(cb inspectJobFunc)             { mock.handler = cb }
+func (mock *mockListJob) Use(cb listJobFunc)                   { mock.handler = cb }
+func (mock *mockFlushJob) Use(cb flushJobFunc)                 { mock.handler = cb }
+func (mock *mockDeleteJob) Use(cb deleteJobFunc)               { mock.handler = cb }
+func (mock *mockStopJob) Use(cb stopJobFunc)                   { mock.handler = cb }
+func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)     { mock.handler = cb }
+func (mock *mockInspectDatum) Use(cb inspectDatumFunc)         { mock.handler = cb }
+func (mock *mockListDatum) Use(cb listDatumFunc)               { mock.handler = cb }
+func (mock *mockRestartDatum) Use(cb restartDatumFunc)         { mock.handler = cb }
+func (mock *mockFileLineage) Use(cb fileLineageFunc)           { mock.handler = cb }
+func (mock *mockCreatePipeline) Use(cb createPipelineFunc)     { mock.handler = cb }
+func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc)   { mock.handler = cb }
+func (mock *mockListPipeline) Use(cb listPipelineFunc)         { mock.handler = cb }
+func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)     { mock.handler = cb }
+func (mock *mockStartPipeline) Use(cb startPipelineFunc)       { mock.handler = cb }
+func (mock *mockStopPipeline) Use(cb stopPipelineFunc)         { mock.handler = cb }
+func (mock *mockRunPipeline) Use(cb runPipelineFunc)           { mock.handler = cb }
+func (mock *mockRollbackPipeline) Use(cb rollbackPipelineFunc) { mock.handler = cb }
+func (mock *mockRunCron) Use(cb runCronFunc)                   { mock.handler = cb }
+func (mock *mockCreateSecret) Use(cb createSecretFunc)         { mock.handler = cb }
+func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)         { mock.handler = cb }
+func (mock *mockInspectSecret) Use(cb inspectSecretFunc)       { mock.handler = cb }
+func (mock *mockListSecret) Use(cb listSecretFunc)             { mock.handler = cb }
+func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)         { mock.handler = cb }
+func (mock *mockGetLogs) Use(cb getLogsFunc)                   { mock.handler = cb }
+func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc)   { mock.handler = cb }
 
 type ppsServerAPI struct {
 	mock *mockPPSServer
 }
 
 type mockPPSServer struct {
-	api             ppsServerAPI
-	CreateJob       mockCreateJob
-	InspectJob      mockInspectJob
-	ListJob         mockListJob
-	FlushJob        mockFlushJob
-	DeleteJob       mockDeleteJob
-	StopJob         mockStopJob
-	UpdateJobState  mockUpdateJobState
-	InspectDatum    mockInspectDatum
-	ListDatum       mockListDatum
-	RestartDatum    mockRestartDatum
-	CreatePipeline  mockCreatePipeline
-	InspectPipeline mockInspectPipeline
-	ListPipeline    mockListPipeline
-	DeletePipeline  mockDeletePipeline
-	StartPipeline   mockStartPipeline
-	StopPipeline    mockStopPipeline
-	RunPipeline     mockRunPipeline
-	RunCron         mockRunCron
-	CreateSecret    mockCreateSecret
-	DeleteSecret    mockDeleteSecret
-	InspectSecret   mockInspectSecret
-	ListSecret      mockListSecret
-	DeleteAll       mockDeleteAllPPS
-	GetLogs         mockGetLogs
-	ActivateAuth    mockActivateAuthPPS
+	api              ppsServerAPI
+	CreateJob        mockCreateJob
+	InspectJob       mockInspectJob
+	ListJob          mockListJob
+	FlushJob         mockFlushJob
+	DeleteJob        mockDeleteJob
+	StopJob          mockStopJob
+	UpdateJobState   mockUpdateJobState
+	InspectDatum     mockInspectDatum
+	ListDatum        mockListDatum
+	RestartDatum     mockRestartDatum
+	FileLineage      mockFileLineage
+	CreatePipeline   mockCreatePipeline
+	InspectPipeline  mockInspectPipeline
+	ListPipeline     mockListPipeline
+	DeletePipeline   mockDeletePipeline
+	StartPipeline    mockStartPipeline
+	StopPipeline     mockStopPipeline
+	RunPipeline      mockRunPipeline
+	RollbackPipeline mockRollbackPipeline
+	RunCron          mockRunCron
+	CreateSecret     mockCreateSecret
+	DeleteSecret     mockDeleteSecret
+	InspectSecret    mockInspectSecret
+	ListSecret       mockListSecret
+	DeleteAll        mockDeleteAllPPS
+	GetLogs          mockGetLogs
+	ActivateAuth     mockActivateAuthPPS
 }
 
 func (api *ppsServerAPI) CreateJob(ctx context.Context, req *pps.CreateJobRequest) (*pps.Job, error) {
@@ -869,6 +997,12 @@ func (api *ppsServerAPI) RestartDatum(ctx context.Context, req *pps.RestartDatum
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RestartDatum")
 }
+func (api *ppsServerAPI) FileLineage(req *pps.FileLineageRequest, serv pps.API_FileLineageServer) error {
+	if api.mock.FileLineage.handler != nil {
+		return api.mock.FileLineage.handler(req, serv)
+	}
+	return errors.Errorf("unhandled pachd mock pps.FileLineage")
+}
 func (api *ppsServerAPI) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest) (*types.Empty, error) {
 	if api.mock.CreatePipeline.handler != nil {
 		return api.mock.CreatePipeline.handler(ctx, req)
@@ -911,6 +1045,12 @@ func (api *ppsServerAPI) RunPipeline(ctx context.Context, req *pps.RunPipelineRe
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RunPipeline")
 }
+func (api *ppsServerAPI) RollbackPipeline(ctx context.Context, req *pps.RollbackPipelineRequest) (*types.Empty, error) {
+	if api.mock.RollbackPipeline.handler != nil {
+		return api.mock.RollbackPipeline.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.RollbackPipeline")
+}
 func (api *ppsServerAPI) RunCron(ctx context.Context, req *pps.RunCronRequest) (*types.Empty, error) {
 	if api.mock.RunCron.handler != nil {
 		return api.mock.RunCron.handler(ctx, req)
@@ -30,6 +30,17 @@ func (mock *mockCreatePipelineInTransaction) Use(cb createPipelineInTransactionF
 	mock.handler = cb
 }
 
+// This code can all go away if we ever get the ability to run a PPS server without external dependencies
+type renameInputInTransactionFunc func(*txnenv.TransactionContext, *pfs.Branch, *pfs.Branch, map[string]*pfs.Commit) error
+
+type mockRenameInputInTransaction struct {
+	handler renameInputInTransactionFunc
+}
+
+func (mock *mockRenameInputInTransaction) Use(cb renameInputInTransactionFunc) {
+	mock.handler = cb
+}
+
 type ppsTransactionAPI struct {
 	mock *MockPPSTransactionServer
 }
@@ -40,6 +51,7 @@ type MockPPSTransactionServer struct {
 	api                         ppsTransactionAPI
 	UpdateJobStateInTransaction mockUpdateJobStateInTransaction
 	CreatePipelineInTransaction mockCreatePipelineInTransaction
+	RenameInputInTransaction    mockRenameInputInTransaction
 }
 
 func (api *ppsTransactionAPI) UpdateJobStateInTransaction(txnCtx *txnenv.TransactionContext, req *pps.UpdateJobStateRequest) error {
@@ -56,6 +68,13 @@ func (api *ppsTransactionAPI) CreatePipelineInTransaction(txnCtx *txnenv.Transac
 	return fmt.Errorf("unhandled pachd mock: pps.CreatePipelineInTransaction")
 }
 
+func (api *ppsTransactionAPI) RenameInputInTransaction(txnCtx *txnenv.TransactionContext, from, to *pfs.Branch, specCommits map[string]*pfs.Commit) error {
+	if api.mock.RenameInputInTransaction.handler != nil {
+		return api.mock.RenameInputInTransaction.handler(txnCtx, from, to, specCommits)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.RenameInputInTransaction")
+}
+
 // NewMockPPSTransactionServer instantiates a MockPPSTransactionServer
 func NewMockPPSTransactionServer() *MockPPSTransactionServer {
 	result := &MockPPSTransactionServer{}
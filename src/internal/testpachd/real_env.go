@@ -58,6 +58,7 @@ func NewRealEnv(t testing.TB, customOpts ...serviceenv.ConfigOption) *RealEnv {
 	}
 	opts = append(opts, customOpts...) // Overwrite with any custom options
 	servEnv := serviceenv.InitServiceEnv(serviceenv.ConfigFromOptions(opts...))
+	realEnv.ServiceEnv = servEnv
 
 	// Overwrite the mock pach client with the ServiceEnv's client so it gets closed earlier
 	realEnv.PachClient = servEnv.GetPachClient(servEnv.Context())
@@ -98,6 +98,13 @@ func (t *TransactionContext) Pfs() PfsTransactionServer {
 	return t.txnEnv.pfsServer
 }
 
+// Pps returns a reference to the PPS API Server so that transactionally-
+// supported methods can be called across the API boundary without using RPCs
+// (which will not maintain transactional guarantees)
+func (t *TransactionContext) Pps() PpsTransactionServer {
+	return t.txnEnv.ppsServer
+}
+
 // PropagateCommit saves a branch to be propagated at the end of the transaction
 // (if all operations complete successfully).  This is used to batch together
 // propagations and dedupe downstream commits in PFS.
@@ -153,6 +160,8 @@ type AuthTransactionServer interface {
 	// Create and Delete are internal-only APIs used by other services when creating/destroying resources.
 	CreateRoleBindingInTransaction(*TransactionContext, string, []string, *auth.Resource) error
 	DeleteRoleBindingInTransaction(*TransactionContext, *auth.Resource) error
+	// RenameRoleBindingInTransaction is an internal-only API used by PFS to move the role binding of a renamed resource.
+	RenameRoleBindingInTransaction(*TransactionContext, *auth.Resource, *auth.Resource) error
 
 	// GetPipelineAuthTokenInTransaction is an internal API used by PPS to generate tokens for pipelines
 	GetPipelineAuthTokenInTransaction(*TransactionContext, string) (string, error)
@@ -184,6 +193,12 @@ type PfsTransactionServer interface {
 type PpsTransactionServer interface {
 	UpdateJobStateInTransaction(*TransactionContext, *pps.UpdateJobStateRequest) error
 	CreatePipelineInTransaction(*TransactionContext, *pps.CreatePipelineRequest, **pfs.Commit) error
+	// RenameInputInTransaction updates the inputs of pipelines which read from
+	// the old branch to read from the new one. If the old branch's name is
+	// empty, its whole repo was renamed. The map holds the spec commits created
+	// for each updated pipeline, so that they're reused if the transaction is
+	// retried.
+	RenameInputInTransaction(*TransactionContext, *pfs.Branch, *pfs.Branch, map[string]*pfs.Commit) error
 }
 
 // TransactionEnv contains the APIServer instances for each subsystem that may
@@ -2,6 +2,7 @@ package work
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -24,6 +25,7 @@ type subtaskBlockFunc func(context.Context) error
 type taskEntry struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
+	priority        int64
 	subtaskFuncChan chan subtaskFunc
 }
 
@@ -50,7 +52,7 @@ func (te *taskEntry) runSubtaskBlock(subtask subtaskBlockFunc) error {
 // The reason this design was chosen (as compared to a priority queue where the subtasks are the entries) is because it
 // has a much lower memory footprint at scale, and our use case is such that the number of tasks in general will be
 // significantly lower than the number of subtasks. Also, we are not concerned with the ordering of subtasks within a task,
-// only the ordering of subtasks across tasks.
+// only the ordering of subtasks across tasks. Tasks are ordered by priority, then by creation order.
 type taskQueue struct {
 	tasks                  *ordered_map.OrderedMap
 	mu                     sync.Mutex
@@ -75,9 +77,7 @@ func newTaskQueue(ctx context.Context) *taskQueue {
 			default:
 			}
 			tq.mu.Lock()
-			iter := tq.tasks.IterFunc()
-			for kv, ok := iter(); ok; kv, ok = iter() {
-				te := kv.Value.(*taskEntry)
+			for _, te := range tq.orderedTasks() {
 				select {
 				case f := <-te.subtaskFuncChan:
 					tq.mu.Unlock()
@@ -93,11 +93,25 @@ func newTaskQueue(ctx context.Context) *taskQueue {
 	return tq
 }
 
+// orderedTasks returns the task entries in the order their subtasks should be
+// processed in. It must be called with tq.mu held.
+func (tq *taskQueue) orderedTasks() []*taskEntry {
+	var tes []*taskEntry
+	iter := tq.tasks.IterFunc()
+	for kv, ok := iter(); ok; kv, ok = iter() {
+		tes = append(tes, kv.Value.(*taskEntry))
+	}
+	sort.SliceStable(tes, func(i, j int) bool {
+		return tes[i].priority > tes[j].priority
+	})
+	return tes
+}
+
 // runTask runs a new task in the task queue.
 // The task code should be contained within the passed in callback.
 // The callback will receive a taskEntry, which should be used for running subtasks in the task queue.
 // The task state will be cleaned up upon return of the callback.
-func (tq *taskQueue) runTask(ctx context.Context, taskID string, f func(*taskEntry)) error {
+func (tq *taskQueue) runTask(ctx context.Context, taskID string, priority int64, f func(*taskEntry)) error {
 	tq.mu.Lock()
 	defer tq.mu.Unlock()
 	if _, ok := tq.tasks.Get(taskID); ok {
@@ -107,6 +121,7 @@ func (tq *taskQueue) runTask(ctx context.Context, taskID string, f func(*taskEnt
 	te := &taskEntry{
 		ctx:             ctx,
 		cancel:          cancel,
+		priority:        priority,
 		subtaskFuncChan: make(chan subtaskFunc, 1),
 	}
 	tq.tasks.Set(taskID, te)
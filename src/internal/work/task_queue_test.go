@@ -32,7 +32,7 @@ func TestTaskQueue(t *testing.T) {
 	}
 	for i := 0; i < numTasks; i++ {
 		i := i
-		require.NoError(t, tq.runTask(context.Background(), strconv.Itoa(i), func(taskEntry *taskEntry) {
+		require.NoError(t, tq.runTask(context.Background(), strconv.Itoa(i), 0, func(taskEntry *taskEntry) {
 			for j := 0; j < numSubtasks; j++ {
 				if i == 0 {
 					// The first task will create subtasks that sleep a bit to allow the the subtasks
@@ -63,3 +63,45 @@ func TestTaskQueue(t *testing.T) {
 		}
 	}
 }
+
+func TestTaskQueuePriority(t *testing.T) {
+	tq := newTaskQueue(context.Background())
+	ready := make(chan struct{})
+	release := make(chan struct{})
+	// The first task blocks the queue while the other tasks queue up a
+	// subtask each.
+	require.NoError(t, tq.runTask(context.Background(), "block", 0, func(taskEntry *taskEntry) {
+		require.NoError(t, taskEntry.runSubtaskBlock(func(_ context.Context) error {
+			close(ready)
+			<-release
+			return nil
+		}))
+	}))
+	<-ready
+	order := make(chan string, 3)
+	queued := make(chan struct{}, 3)
+	for _, task := range []struct {
+		id       string
+		priority int64
+	}{{"low", 0}, {"high", 2}, {"medium", 1}} {
+		task := task
+		require.NoError(t, tq.runTask(context.Background(), task.id, task.priority, func(taskEntry *taskEntry) {
+			done := make(chan struct{})
+			// The queue is blocked, so runSubtask returns once the subtask
+			// is buffered in the task entry.
+			taskEntry.runSubtask(func(_ context.Context) {
+				order <- task.id
+				close(done)
+			})
+			queued <- struct{}{}
+			<-done
+		}))
+	}
+	for i := 0; i < 3; i++ {
+		<-queued
+	}
+	close(release)
+	require.Equal(t, "high", <-order)
+	require.Equal(t, "medium", <-order)
+	require.Equal(t, "low", <-order)
+}
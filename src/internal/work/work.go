@@ -15,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/internal/watch"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
 )
 
 const (
@@ -72,12 +73,26 @@ func newCollection(etcdClient *etcd.Client, etcdPrefix string, template proto.Me
 	)
 }
 
+// TaskOption configures a task.
+type TaskOption func(*Task)
+
+// WithPriority sets the priority of a task. Subtasks of tasks with a higher
+// priority are processed before those of tasks with a lower priority.
+func WithPriority(priority int64) TaskOption {
+	return func(task *Task) {
+		task.Priority = priority
+	}
+}
+
 // RunTask runs a task in the task queue.
 // The task code should be contained within the passed in callback.
 // The callback will receive a Master, which should be used for running subtasks in the task queue.
 // The task state will be cleaned up upon return of the callback.
-func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master)) (retErr error) {
+func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master), opts ...TaskOption) (retErr error) {
 	task := &Task{ID: uuid.NewWithoutDashes()}
+	for _, opt := range opts {
+		opt(task)
+	}
 	if _, err := col.NewSTM(ctx, tq.etcdClient, func(stm col.STM) error {
 		return tq.taskCol.ReadWrite(stm).Put(task.ID, task)
 	}); err != nil {
@@ -90,7 +105,7 @@ func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master)) (retErr error
 			}
 		}
 	}()
-	return tq.taskQueue.runTask(ctx, task.ID, func(te *taskEntry) {
+	return tq.taskQueue.runTask(ctx, task.ID, task.Priority, func(te *taskEntry) {
 		defer func() {
 			if err := tq.deleteTask(task.ID); err != nil {
 				fmt.Printf("errored deleting task %v: %v\n", task.ID, err)
@@ -105,11 +120,11 @@ func (tq *TaskQueue) RunTask(ctx context.Context, f func(*Master)) (retErr error
 }
 
 // RunTaskBlock is similar to RunTask, but blocks on the callback.
-func (tq *TaskQueue) RunTaskBlock(ctx context.Context, f func(*Master) error) error {
+func (tq *TaskQueue) RunTaskBlock(ctx context.Context, f func(*Master) error, opts ...TaskOption) error {
 	errChan := make(chan error)
 	if err := tq.RunTask(ctx, func(master *Master) {
 		errChan <- f(master)
-	}); err != nil {
+	}, opts...); err != nil {
 		return err
 	}
 	return <-errChan
@@ -154,13 +169,42 @@ func (m *Master) RunSubtasks(subtasks []*Task, collectFunc CollectFunc) (retErr
 	return nil
 }
 
+// SubtasksOption configures a RunSubtasksChan call.
+type SubtasksOption func(*subtasksConfig)
+
+type subtasksConfig struct {
+	maxQueueSize int64
+}
+
+// WithMaxQueueSize bounds the number of subtasks that have been created but
+// not yet collected. Subtasks aren't received from the subtask channel while
+// the bound is reached.
+func WithMaxQueueSize(maxQueueSize int64) SubtasksOption {
+	return func(sc *subtasksConfig) {
+		sc.maxQueueSize = maxQueueSize
+	}
+}
+
 // RunSubtasksChan runs a set of subtasks (provided through a channel) and collects the results with the passed in callback.
-func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc) (retErr error) {
+func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc, opts ...SubtasksOption) (retErr error) {
+	sc := &subtasksConfig{}
+	for _, opt := range opts {
+		opt(sc)
+	}
+	var queue *semaphore.Weighted
+	if sc.maxQueueSize > 0 {
+		queue = semaphore.NewWeighted(sc.maxQueueSize)
+	}
 	var eg errgroup.Group
 	var count int64
 	done := make(chan struct{})
 	ctx, cancel := context.WithCancel(m.taskEntry.ctx)
+	// queueCtx is canceled when the collect goroutine returns, so that waiting
+	// for room in the queue doesn't block forever.
+	queueCtx, queueCancel := context.WithCancel(ctx)
+	defer queueCancel()
 	eg.Go(func() error {
+		defer queueCancel()
 		return m.subtaskCol.ReadOnly(ctx).WatchOneF(m.taskID, func(e *watch.Event) error {
 			var key string
 			subtaskInfo := &TaskInfo{}
@@ -179,6 +223,9 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 				}
 			}
 			atomic.AddInt64(&count, -1)
+			if queue != nil {
+				queue.Release(1)
+			}
 			select {
 			case <-done:
 				if count == 0 {
@@ -204,13 +251,25 @@ func (m *Master) RunSubtasksChan(subtaskChan chan *Task, collectFunc CollectFunc
 		}
 	}()
 
-	for subtask := range subtaskChan {
+	for {
+		if queue != nil {
+			// Wait for room in the queue before receiving a subtask, so that
+			// the sender isn't unblocked until the subtask can be created.
+			if err := queue.Acquire(queueCtx, 1); err != nil {
+				// The collect goroutine returned, its error (if any) is
+				// returned when it's waited on.
+				return nil
+			}
+		}
+		subtask, ok := <-subtaskChan
+		if !ok {
+			return nil
+		}
 		if err := m.createSubtask(subtask); err != nil {
 			return err
 		}
 		atomic.AddInt64(&count, 1)
 	}
-	return nil
 }
 
 func (m *Master) createSubtask(subtask *Task) error {
@@ -284,7 +343,7 @@ func (w *Worker) Run(ctx context.Context, processFunc ProcessFunc) error {
 			taskQueue.deleteTask(taskID)
 			return nil
 		}
-		return taskQueue.runTask(ctx, taskID, func(taskEntry *taskEntry) {
+		return taskQueue.runTask(ctx, taskID, task.Priority, func(taskEntry *taskEntry) {
 			if err := w.taskFunc(task, taskEntry, processFunc); err != nil && !errors.Is(taskEntry.ctx.Err(), context.Canceled) {
 				fmt.Printf("errored in task callback: %v\n", err)
 			}
@@ -53,11 +53,15 @@ func (State) EnumDescriptor() ([]byte, []int) {
 }
 
 type Task struct {
-	ID                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data                 *types.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	ID   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *types.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// priority orders tasks in the task queue. Subtasks of tasks with a higher
+	// priority are processed first, and tasks with the same priority are
+	// processed in creation order.
+	Priority             int64    `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -107,6 +111,13 @@ func (m *Task) GetData() *types.Any {
 	return nil
 }
 
+func (m *Task) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type TaskInfo struct {
 	Task                 *Task      `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	State                State      `protobuf:"varint,2,opt,name=state,proto3,enum=work.State" json:"state,omitempty"`
@@ -275,29 +286,30 @@ func init() {
 func init() { proto.RegisterFile("internal/work/work.proto", fileDescriptor_6f2d069f3b08a810) }
 
 var fileDescriptor_6f2d069f3b08a810 = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x4b, 0x6a, 0xe3, 0x30,
-	0x18, 0x1e, 0x79, 0x9c, 0x97, 0x0c, 0x43, 0x10, 0x21, 0x78, 0xc2, 0xe0, 0x49, 0xbd, 0x32, 0xa5,
-	0xd8, 0xe0, 0x9c, 0x20, 0xaf, 0xb6, 0x86, 0x92, 0x85, 0x9c, 0x6c, 0xba, 0x53, 0x6c, 0xc5, 0x31,
-	0x71, 0xac, 0x20, 0x29, 0x2d, 0x39, 0x47, 0x2f, 0xd5, 0x65, 0x4f, 0x50, 0x8a, 0x4f, 0x52, 0x24,
-	0xa7, 0xb4, 0xdd, 0x74, 0x23, 0xbe, 0x17, 0x1f, 0x9f, 0xf8, 0xa1, 0x9d, 0x97, 0x92, 0xf2, 0x92,
-	0x14, 0xc1, 0x23, 0xe3, 0x3b, 0xfd, 0xf8, 0x07, 0xce, 0x24, 0x43, 0xa6, 0xc2, 0x83, 0x5e, 0xc6,
-	0x32, 0xa6, 0x85, 0x40, 0xa1, 0xda, 0x1b, 0xfc, 0xcd, 0x18, 0xcb, 0x0a, 0x1a, 0x68, 0xb6, 0x3e,
-	0x6e, 0x02, 0x52, 0x9e, 0x6a, 0xcb, 0xbd, 0x85, 0xe6, 0x92, 0x88, 0x1d, 0xea, 0x43, 0x23, 0x4f,
-	0x6d, 0x30, 0x04, 0x5e, 0x67, 0xd2, 0xac, 0x5e, 0xff, 0x1b, 0xd1, 0x0c, 0x1b, 0x79, 0x8a, 0x3c,
-	0x68, 0xa6, 0x44, 0x12, 0xdb, 0x18, 0x02, 0xcf, 0x0a, 0x7b, 0x7e, 0xdd, 0xe4, 0x7f, 0x34, 0xf9,
-	0xe3, 0xf2, 0x84, 0x75, 0xc2, 0x7d, 0x02, 0xb0, 0xad, 0xaa, 0xa2, 0x72, 0xc3, 0x90, 0x03, 0x4d,
-	0x49, 0xc4, 0x4e, 0x17, 0x5a, 0x21, 0xf4, 0xf5, 0x50, 0xe5, 0x62, 0xad, 0xa3, 0x0b, 0xd8, 0x10,
-	0x92, 0x48, 0xaa, 0x7b, 0xff, 0x84, 0x56, 0x1d, 0x88, 0x95, 0x84, 0x6b, 0x07, 0xf5, 0x61, 0x93,
-	0x53, 0x22, 0x58, 0x69, 0xff, 0x56, 0xab, 0xf0, 0x99, 0xa1, 0x2b, 0xa5, 0x8b, 0x63, 0x21, 0x6d,
-	0xf3, 0x87, 0x4d, 0xe7, 0x8c, 0xdb, 0x82, 0x8d, 0x69, 0x41, 0xf2, 0xbd, 0xeb, 0xc1, 0xf6, 0x92,
-	0x0a, 0x39, 0x23, 0x92, 0xa0, 0x7f, 0xb0, 0x73, 0xe0, 0x2c, 0xa1, 0x42, 0xd0, 0xfa, 0xcf, 0x6d,
-	0xfc, 0x29, 0x5c, 0xfa, 0xb0, 0xa1, 0x87, 0x20, 0x0b, 0xb6, 0xf0, 0x6a, 0xb1, 0x88, 0x16, 0x37,
-	0xdd, 0x5f, 0x8a, 0xc4, 0xab, 0xe9, 0x74, 0x1e, 0xc7, 0x5d, 0xa0, 0xc8, 0xf5, 0x38, 0xba, 0x5b,
-	0xe1, 0x79, 0xd7, 0x98, 0x8c, 0x9f, 0x2b, 0x07, 0xbc, 0x54, 0x0e, 0x78, 0xab, 0x1c, 0x70, 0x3f,
-	0xca, 0x72, 0xb9, 0x3d, 0xae, 0xfd, 0x84, 0xed, 0x83, 0x03, 0x49, 0xb6, 0xa7, 0x94, 0xf2, 0xaf,
-	0xe8, 0x21, 0x0c, 0x04, 0x4f, 0x82, 0x6f, 0x77, 0x5c, 0x37, 0xf5, 0xf6, 0xd1, 0x7b, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x19, 0x0e, 0xac, 0xb8, 0xdf, 0x01, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xdd, 0xca, 0x9b, 0x30,
+	0x18, 0x5e, 0xac, 0x6d, 0x6d, 0x84, 0x51, 0x42, 0x29, 0xae, 0x0c, 0xd7, 0x79, 0x24, 0x63, 0x28,
+	0xd8, 0x2b, 0xe8, 0xdf, 0x86, 0x30, 0x7a, 0x10, 0xdb, 0x93, 0x9d, 0xa5, 0x9a, 0x5a, 0xa9, 0x35,
+	0x92, 0xa4, 0x1b, 0x5e, 0xc7, 0x6e, 0x6a, 0x87, 0xbb, 0x82, 0x31, 0xbc, 0x92, 0x91, 0xd8, 0xef,
+	0xef, 0xe4, 0x3b, 0x91, 0xf7, 0xf9, 0xe1, 0x7d, 0x9e, 0xd7, 0x40, 0xa7, 0xa8, 0x24, 0xe5, 0x15,
+	0x29, 0xc3, 0x9f, 0x8c, 0x5f, 0xf4, 0x27, 0xa8, 0x39, 0x93, 0x0c, 0x99, 0x6a, 0x9e, 0x4d, 0x72,
+	0x96, 0x33, 0x4d, 0x84, 0x6a, 0xea, 0xb4, 0xd9, 0xbb, 0x9c, 0xb1, 0xbc, 0xa4, 0xa1, 0x46, 0xc7,
+	0xdb, 0x29, 0x24, 0x55, 0xd3, 0x49, 0x5e, 0x06, 0xcd, 0x3d, 0x11, 0x17, 0x34, 0x85, 0x46, 0x91,
+	0x39, 0x60, 0x0e, 0xfc, 0xd1, 0x6a, 0xd0, 0xfe, 0xfd, 0x60, 0xc4, 0x1b, 0x6c, 0x14, 0x19, 0xf2,
+	0xa1, 0x99, 0x11, 0x49, 0x1c, 0x63, 0x0e, 0x7c, 0x3b, 0x9a, 0x04, 0xdd, 0xa6, 0xe0, 0x61, 0x53,
+	0xb0, 0xac, 0x1a, 0xac, 0x1d, 0x68, 0x06, 0xad, 0x9a, 0x17, 0x8c, 0x17, 0xb2, 0x71, 0x7a, 0x73,
+	0xe0, 0xf7, 0xf0, 0x23, 0xf6, 0x7e, 0x01, 0x68, 0xa9, 0x98, 0xb8, 0x3a, 0x31, 0xe4, 0x42, 0x53,
+	0x12, 0x71, 0xd1, 0x61, 0x76, 0x04, 0x03, 0x7d, 0x84, 0x52, 0xb1, 0xe6, 0xd1, 0x47, 0xd8, 0x17,
+	0x92, 0x48, 0xaa, 0x33, 0xdf, 0x46, 0x76, 0x67, 0x48, 0x14, 0x85, 0x3b, 0x05, 0x4d, 0xe1, 0x80,
+	0x53, 0x22, 0x58, 0xa5, 0x93, 0x46, 0xf8, 0x8e, 0xd0, 0x67, 0xc5, 0x8b, 0x5b, 0x29, 0x1d, 0xf3,
+	0x95, 0xbe, 0x77, 0x8f, 0x37, 0x84, 0xfd, 0x75, 0x49, 0x8a, 0xab, 0xe7, 0x43, 0x6b, 0x4f, 0x85,
+	0xdc, 0xa8, 0x33, 0xde, 0xc3, 0x51, 0xcd, 0x59, 0x4a, 0x85, 0xa0, 0xdd, 0xff, 0xb0, 0xf0, 0x13,
+	0xf1, 0x29, 0x80, 0x7d, 0x5d, 0x04, 0xd9, 0x70, 0x88, 0x0f, 0xbb, 0x5d, 0xbc, 0xfb, 0x3a, 0x7e,
+	0xa3, 0x40, 0x72, 0x58, 0xaf, 0xb7, 0x49, 0x32, 0x06, 0x0a, 0x7c, 0x59, 0xc6, 0xdf, 0x0e, 0x78,
+	0x3b, 0x36, 0x56, 0xcb, 0xdf, 0xad, 0x0b, 0xfe, 0xb4, 0x2e, 0xf8, 0xd7, 0xba, 0xe0, 0xfb, 0x22,
+	0x2f, 0xe4, 0xf9, 0x76, 0x0c, 0x52, 0x76, 0x0d, 0x6b, 0x92, 0x9e, 0x9b, 0x8c, 0xf2, 0xe7, 0xd3,
+	0x8f, 0x28, 0x14, 0x3c, 0x0d, 0x5f, 0xbc, 0xf1, 0x71, 0xa0, 0xbb, 0x2f, 0xfe, 0x0f, 0x00, 0x27,
+	0xa3, 0xdf, 0x65, 0xfb, 0x01, 0x00, 0x00,
 }
 
 func (m *Task) Marshal() (dAtA []byte, err error) {
@@ -324,6 +336,11 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Priority != 0 {
+		i = encodeVarintWork(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -498,6 +515,9 @@ func (m *Task) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovWork(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovWork(uint64(m.Priority))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -661,6 +681,25 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWork
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWork(dAtA[iNdEx:])
@@ -521,23 +521,170 @@ func (m *DeleteClusterResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteClusterResponse proto.InternalMessageInfo
 
+// ClusterUsage is a summary of the resources a cluster is using, reported
+// to the license server in each heartbeat.
+type ClusterUsage struct {
+	Repos                int64    `protobuf:"varint,1,opt,name=repos,proto3" json:"repos,omitempty"`
+	Pipelines            int64    `protobuf:"varint,2,opt,name=pipelines,proto3" json:"pipelines,omitempty"`
+	ActiveWorkers        int64    `protobuf:"varint,3,opt,name=active_workers,json=activeWorkers,proto3" json:"active_workers,omitempty" db:"active_workers"`
+	StoredBytes          int64    `protobuf:"varint,4,opt,name=stored_bytes,json=storedBytes,proto3" json:"stored_bytes,omitempty" db:"stored_bytes"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterUsage) Reset()         { *m = ClusterUsage{} }
+func (m *ClusterUsage) String() string { return proto.CompactTextString(m) }
+func (*ClusterUsage) ProtoMessage()    {}
+func (*ClusterUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{10}
+}
+func (m *ClusterUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterUsage.Merge(m, src)
+}
+func (m *ClusterUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterUsage proto.InternalMessageInfo
+
+func (m *ClusterUsage) GetRepos() int64 {
+	if m != nil {
+		return m.Repos
+	}
+	return 0
+}
+
+func (m *ClusterUsage) GetPipelines() int64 {
+	if m != nil {
+		return m.Pipelines
+	}
+	return 0
+}
+
+func (m *ClusterUsage) GetActiveWorkers() int64 {
+	if m != nil {
+		return m.ActiveWorkers
+	}
+	return 0
+}
+
+func (m *ClusterUsage) GetStoredBytes() int64 {
+	if m != nil {
+		return m.StoredBytes
+	}
+	return 0
+}
+
+// UsageLimits bounds the combined usage of all of the clusters registered
+// with the license server. A limit of zero means the resource is unlimited.
+type UsageLimits struct {
+	MaxRepos             int64    `protobuf:"varint,1,opt,name=max_repos,json=maxRepos,proto3" json:"max_repos,omitempty"`
+	MaxPipelines         int64    `protobuf:"varint,2,opt,name=max_pipelines,json=maxPipelines,proto3" json:"max_pipelines,omitempty"`
+	MaxActiveWorkers     int64    `protobuf:"varint,3,opt,name=max_active_workers,json=maxActiveWorkers,proto3" json:"max_active_workers,omitempty"`
+	MaxStoredBytes       int64    `protobuf:"varint,4,opt,name=max_stored_bytes,json=maxStoredBytes,proto3" json:"max_stored_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UsageLimits) Reset()         { *m = UsageLimits{} }
+func (m *UsageLimits) String() string { return proto.CompactTextString(m) }
+func (*UsageLimits) ProtoMessage()    {}
+func (*UsageLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{11}
+}
+func (m *UsageLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageLimits.Merge(m, src)
+}
+func (m *UsageLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageLimits proto.InternalMessageInfo
+
+func (m *UsageLimits) GetMaxRepos() int64 {
+	if m != nil {
+		return m.MaxRepos
+	}
+	return 0
+}
+
+func (m *UsageLimits) GetMaxPipelines() int64 {
+	if m != nil {
+		return m.MaxPipelines
+	}
+	return 0
+}
+
+func (m *UsageLimits) GetMaxActiveWorkers() int64 {
+	if m != nil {
+		return m.MaxActiveWorkers
+	}
+	return 0
+}
+
+func (m *UsageLimits) GetMaxStoredBytes() int64 {
+	if m != nil {
+		return m.MaxStoredBytes
+	}
+	return 0
+}
+
 type ClusterStatus struct {
-	Id                   string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Version              string     `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	AuthEnabled          bool       `protobuf:"varint,4,opt,name=auth_enabled,json=authEnabled,proto3" json:"auth_enabled,omitempty" db:"auth_enabled"`
-	LastHeartbeat        *time.Time `protobuf:"bytes,5,opt,name=last_heartbeat,json=lastHeartbeat,proto3,stdtime" json:"last_heartbeat,omitempty" db:"last_heartbeat"`
-	CreatedAt            *time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string        `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Version              string        `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	AuthEnabled          bool          `protobuf:"varint,4,opt,name=auth_enabled,json=authEnabled,proto3" json:"auth_enabled,omitempty" db:"auth_enabled"`
+	LastHeartbeat        *time.Time    `protobuf:"bytes,5,opt,name=last_heartbeat,json=lastHeartbeat,proto3,stdtime" json:"last_heartbeat,omitempty" db:"last_heartbeat"`
+	CreatedAt            *time.Time    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty" db:"created_at"`
+	Usage                *ClusterUsage `protobuf:"bytes,7,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ClusterStatus) Reset()         { *m = ClusterStatus{} }
 func (m *ClusterStatus) String() string { return proto.CompactTextString(m) }
 func (*ClusterStatus) ProtoMessage()    {}
 func (*ClusterStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{10}
+	return fileDescriptor_36c97486aaafd691, []int{12}
 }
 func (m *ClusterStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,6 +755,13 @@ func (m *ClusterStatus) GetCreatedAt() *time.Time {
 	return nil
 }
 
+func (m *ClusterStatus) GetUsage() *ClusterUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type UpdateClusterRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -620,7 +774,7 @@ func (m *UpdateClusterRequest) Reset()         { *m = UpdateClusterRequest{} }
 func (m *UpdateClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateClusterRequest) ProtoMessage()    {}
 func (*UpdateClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{11}
+	return fileDescriptor_36c97486aaafd691, []int{13}
 }
 func (m *UpdateClusterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,7 +827,7 @@ func (m *UpdateClusterResponse) Reset()         { *m = UpdateClusterResponse{} }
 func (m *UpdateClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateClusterResponse) ProtoMessage()    {}
 func (*UpdateClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{12}
+	return fileDescriptor_36c97486aaafd691, []int{14}
 }
 func (m *UpdateClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +866,7 @@ func (m *ListClustersRequest) Reset()         { *m = ListClustersRequest{} }
 func (m *ListClustersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListClustersRequest) ProtoMessage()    {}
 func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{13}
+	return fileDescriptor_36c97486aaafd691, []int{15}
 }
 func (m *ListClustersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,17 +896,21 @@ func (m *ListClustersRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_ListClustersRequest proto.InternalMessageInfo
 
 type ListClustersResponse struct {
-	Clusters             []*ClusterStatus `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Clusters []*ClusterStatus `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// total_usage is the sum of the most recently reported usage of
+	// every registered cluster.
+	TotalUsage           *ClusterUsage `protobuf:"bytes,2,opt,name=total_usage,json=totalUsage,proto3" json:"total_usage,omitempty"`
+	Limits               *UsageLimits  `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ListClustersResponse) Reset()         { *m = ListClustersResponse{} }
 func (m *ListClustersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListClustersResponse) ProtoMessage()    {}
 func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{14}
+	return fileDescriptor_36c97486aaafd691, []int{16}
 }
 func (m *ListClustersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,6 +946,106 @@ func (m *ListClustersResponse) GetClusters() []*ClusterStatus {
 	return nil
 }
 
+func (m *ListClustersResponse) GetTotalUsage() *ClusterUsage {
+	if m != nil {
+		return m.TotalUsage
+	}
+	return nil
+}
+
+func (m *ListClustersResponse) GetLimits() *UsageLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type SetUsageLimitsRequest struct {
+	Limits               *UsageLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetUsageLimitsRequest) Reset()         { *m = SetUsageLimitsRequest{} }
+func (m *SetUsageLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUsageLimitsRequest) ProtoMessage()    {}
+func (*SetUsageLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{17}
+}
+func (m *SetUsageLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetUsageLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetUsageLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetUsageLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUsageLimitsRequest.Merge(m, src)
+}
+func (m *SetUsageLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetUsageLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUsageLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUsageLimitsRequest proto.InternalMessageInfo
+
+func (m *SetUsageLimitsRequest) GetLimits() *UsageLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type SetUsageLimitsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUsageLimitsResponse) Reset()         { *m = SetUsageLimitsResponse{} }
+func (m *SetUsageLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetUsageLimitsResponse) ProtoMessage()    {}
+func (*SetUsageLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36c97486aaafd691, []int{18}
+}
+func (m *SetUsageLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetUsageLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetUsageLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetUsageLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUsageLimitsResponse.Merge(m, src)
+}
+func (m *SetUsageLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetUsageLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUsageLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUsageLimitsResponse proto.InternalMessageInfo
+
 type DeleteAllRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -798,7 +1056,7 @@ func (m *DeleteAllRequest) Reset()         { *m = DeleteAllRequest{} }
 func (m *DeleteAllRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllRequest) ProtoMessage()    {}
 func (*DeleteAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{15}
+	return fileDescriptor_36c97486aaafd691, []int{19}
 }
 func (m *DeleteAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +1095,7 @@ func (m *DeleteAllResponse) Reset()         { *m = DeleteAllResponse{} }
 func (m *DeleteAllResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAllResponse) ProtoMessage()    {}
 func (*DeleteAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{16}
+	return fileDescriptor_36c97486aaafd691, []int{20}
 }
 func (m *DeleteAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,20 +1125,21 @@ func (m *DeleteAllResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteAllResponse proto.InternalMessageInfo
 
 type HeartbeatRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	AuthEnabled          bool     `protobuf:"varint,4,opt,name=auth_enabled,json=authEnabled,proto3" json:"auth_enabled,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret               string        `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Version              string        `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	AuthEnabled          bool          `protobuf:"varint,4,opt,name=auth_enabled,json=authEnabled,proto3" json:"auth_enabled,omitempty"`
+	Usage                *ClusterUsage `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *HeartbeatRequest) Reset()         { *m = HeartbeatRequest{} }
 func (m *HeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*HeartbeatRequest) ProtoMessage()    {}
 func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{17}
+	return fileDescriptor_36c97486aaafd691, []int{21}
 }
 func (m *HeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,18 +1196,29 @@ func (m *HeartbeatRequest) GetAuthEnabled() bool {
 	return false
 }
 
+func (m *HeartbeatRequest) GetUsage() *ClusterUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type HeartbeatResponse struct {
-	License              *enterprise.LicenseRecord `protobuf:"bytes,1,opt,name=license,proto3" json:"license,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	License *enterprise.LicenseRecord `protobuf:"bytes,1,opt,name=license,proto3" json:"license,omitempty"`
+	// limits_exceeded describes each of the license's usage limits that
+	// the registered clusters are collectively over. The repo and pipeline
+	// limits are included once they're reached.
+	LimitsExceeded       []string `protobuf:"bytes,2,rep,name=limits_exceeded,json=limitsExceeded,proto3" json:"limits_exceeded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HeartbeatResponse) Reset()         { *m = HeartbeatResponse{} }
 func (m *HeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*HeartbeatResponse) ProtoMessage()    {}
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36c97486aaafd691, []int{18}
+	return fileDescriptor_36c97486aaafd691, []int{22}
 }
 func (m *HeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -984,6 +1254,13 @@ func (m *HeartbeatResponse) GetLicense() *enterprise.LicenseRecord {
 	return nil
 }
 
+func (m *HeartbeatResponse) GetLimitsExceeded() []string {
+	if m != nil {
+		return m.LimitsExceeded
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ActivateRequest)(nil), "license.ActivateRequest")
 	proto.RegisterType((*ActivateResponse)(nil), "license.ActivateResponse")
@@ -995,11 +1272,15 @@ func init() {
 	proto.RegisterType((*AddClusterResponse)(nil), "license.AddClusterResponse")
 	proto.RegisterType((*DeleteClusterRequest)(nil), "license.DeleteClusterRequest")
 	proto.RegisterType((*DeleteClusterResponse)(nil), "license.DeleteClusterResponse")
+	proto.RegisterType((*ClusterUsage)(nil), "license.ClusterUsage")
+	proto.RegisterType((*UsageLimits)(nil), "license.UsageLimits")
 	proto.RegisterType((*ClusterStatus)(nil), "license.ClusterStatus")
 	proto.RegisterType((*UpdateClusterRequest)(nil), "license.UpdateClusterRequest")
 	proto.RegisterType((*UpdateClusterResponse)(nil), "license.UpdateClusterResponse")
 	proto.RegisterType((*ListClustersRequest)(nil), "license.ListClustersRequest")
 	proto.RegisterType((*ListClustersResponse)(nil), "license.ListClustersResponse")
+	proto.RegisterType((*SetUsageLimitsRequest)(nil), "license.SetUsageLimitsRequest")
+	proto.RegisterType((*SetUsageLimitsResponse)(nil), "license.SetUsageLimitsResponse")
 	proto.RegisterType((*DeleteAllRequest)(nil), "license.DeleteAllRequest")
 	proto.RegisterType((*DeleteAllResponse)(nil), "license.DeleteAllResponse")
 	proto.RegisterType((*HeartbeatRequest)(nil), "license.HeartbeatRequest")
@@ -1009,58 +1290,74 @@ func init() {
 func init() { proto.RegisterFile("license/license.proto", fileDescriptor_36c97486aaafd691) }
 
 var fileDescriptor_36c97486aaafd691 = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x4e, 0xf3, 0x46,
-	0x14, 0xc5, 0xe1, 0x27, 0xe4, 0x06, 0x02, 0x19, 0x12, 0x30, 0xa6, 0x24, 0x61, 0x16, 0x25, 0x95,
-	0xda, 0x44, 0x0a, 0x5d, 0x54, 0xad, 0x2a, 0x35, 0x40, 0x05, 0x54, 0x54, 0x42, 0x2e, 0x6c, 0xaa,
-	0xaa, 0x91, 0x63, 0x5f, 0x12, 0xab, 0xc6, 0xe3, 0x7a, 0x26, 0xb4, 0x7d, 0x8b, 0x2e, 0xfb, 0x3a,
-	0xdd, 0x75, 0xd9, 0x27, 0xa0, 0x15, 0x0f, 0xf0, 0x2d, 0x78, 0x82, 0x4f, 0x8e, 0xc7, 0x7f, 0xc1,
-	0x21, 0xfa, 0x56, 0xf1, 0x9c, 0x7b, 0xe7, 0xce, 0x99, 0x7b, 0xcf, 0x1c, 0x05, 0xea, 0x8e, 0x6d,
-	0xa2, 0xcb, 0xb1, 0x2b, 0x7f, 0x3b, 0x9e, 0xcf, 0x04, 0x23, 0x45, 0xb9, 0xd4, 0x9a, 0x23, 0xc6,
-	0x46, 0x0e, 0x76, 0xa7, 0xf0, 0x70, 0x72, 0xdf, 0x15, 0xf6, 0x03, 0x72, 0x61, 0x3c, 0x78, 0x61,
-	0xa6, 0x56, 0x1b, 0xb1, 0x11, 0x9b, 0x7e, 0x76, 0x83, 0x2f, 0x89, 0x1e, 0xa0, 0x2b, 0xd0, 0xf7,
-	0x7c, 0x9b, 0x63, 0x37, 0xf9, 0x0c, 0x83, 0xd4, 0x83, 0xad, 0xbe, 0x29, 0xec, 0x47, 0x43, 0xa0,
-	0x8e, 0xbf, 0x4e, 0x90, 0x0b, 0x72, 0x0c, 0x5b, 0x46, 0x08, 0xd9, 0xcc, 0x1d, 0x98, 0xcc, 0x42,
-	0x55, 0x69, 0x29, 0xed, 0x92, 0x5e, 0x49, 0xe0, 0x33, 0x66, 0x21, 0xf9, 0x1c, 0x8a, 0xf8, 0xbb,
-	0x67, 0xfb, 0xc8, 0xd5, 0x42, 0x4b, 0x69, 0x97, 0x7b, 0x5a, 0x27, 0x64, 0xd8, 0x89, 0x18, 0x76,
-	0x6e, 0x23, 0x86, 0x7a, 0x94, 0x4a, 0xbf, 0x86, 0xed, 0xe4, 0x44, 0xee, 0x31, 0x97, 0x23, 0xf9,
-	0x04, 0x56, 0x6c, 0xf7, 0x9e, 0x4d, 0xcf, 0x29, 0xf7, 0xea, 0x9d, 0x14, 0xcd, 0x5b, 0xf6, 0x0b,
-	0xba, 0x57, 0xee, 0x3d, 0xd3, 0xa7, 0x29, 0x54, 0x03, 0xf5, 0x02, 0x45, 0x3f, 0xc3, 0x44, 0x32,
-	0xa7, 0x7f, 0x29, 0xb0, 0x9f, 0x13, 0x94, 0x87, 0x1c, 0xc3, 0x2a, 0x17, 0x86, 0x08, 0x6f, 0x53,
-	0xe9, 0x55, 0xd3, 0xa7, 0xfc, 0x10, 0x04, 0xf4, 0x30, 0x1e, 0xb3, 0x29, 0x2c, 0x64, 0x93, 0xd7,
-	0xab, 0xe5, 0xbc, 0x5e, 0xd1, 0x1d, 0xa8, 0x9e, 0xa3, 0x91, 0xed, 0x34, 0xad, 0x01, 0x49, 0x83,
-	0x21, 0x4f, 0x7a, 0x07, 0xd5, 0xbe, 0x65, 0x9d, 0x39, 0x13, 0x2e, 0xd0, 0x8f, 0x86, 0x52, 0x81,
-	0x82, 0x6d, 0xc9, 0x39, 0x14, 0x6c, 0x8b, 0xa8, 0x50, 0x34, 0x2c, 0xcb, 0x47, 0x1e, 0xf6, 0xbe,
-	0xa4, 0x47, 0x4b, 0xb2, 0x0b, 0x6b, 0x1c, 0x4d, 0x1f, 0x85, 0x64, 0x22, 0x57, 0xf4, 0x53, 0x20,
-	0xe9, 0xb2, 0xb2, 0x29, 0x49, 0xb6, 0x92, 0xc9, 0xfe, 0x18, 0x6a, 0xe7, 0xe8, 0xa0, 0xc0, 0xb7,
-	0x79, 0xd0, 0x3d, 0xa8, 0xcf, 0xe4, 0xc9, 0x5b, 0xfc, 0x5d, 0x80, 0x4d, 0x89, 0x05, 0xcd, 0x9d,
-	0xf0, 0x0f, 0xb8, 0x82, 0x0a, 0xc5, 0x47, 0xf4, 0xb9, 0xcd, 0x5c, 0x79, 0x87, 0x68, 0x49, 0xbe,
-	0x80, 0x0d, 0x63, 0x22, 0xc6, 0x03, 0x74, 0x8d, 0xa1, 0x83, 0x96, 0xba, 0xd2, 0x52, 0xda, 0xeb,
-	0xa7, 0xf5, 0x97, 0xa7, 0x66, 0xd5, 0x1a, 0x7e, 0x49, 0xd3, 0x31, 0xaa, 0x97, 0x83, 0xe5, 0xb7,
-	0xe1, 0x8a, 0xfc, 0x0c, 0x15, 0xc7, 0xe0, 0x62, 0x30, 0x46, 0xc3, 0x17, 0x43, 0x34, 0x84, 0xba,
-	0xba, 0x48, 0xb3, 0xa7, 0x07, 0x2f, 0x4f, 0xcd, 0x9d, 0xa0, 0x6e, 0x76, 0x27, 0xfd, 0xf3, 0xbf,
-	0xa6, 0xa2, 0x6f, 0x06, 0xe0, 0x65, 0x84, 0x11, 0x1d, 0xc0, 0xf4, 0xd1, 0x10, 0x68, 0x0d, 0x0c,
-	0xa1, 0xae, 0x2d, 0xac, 0xbd, 0xf7, 0xf2, 0xd4, 0xdc, 0x0a, 0x6a, 0x27, 0xbb, 0xc2, 0xba, 0x25,
-	0x09, 0xf4, 0x05, 0xfd, 0x06, 0x6a, 0x77, 0x9e, 0x65, 0x2c, 0x1a, 0xc2, 0xfc, 0x4e, 0x06, 0xe3,
-	0x99, 0xa9, 0x20, 0xc7, 0x53, 0x87, 0x9d, 0x6b, 0x9b, 0x0b, 0x09, 0xf3, 0x48, 0x91, 0xdf, 0x41,
-	0x2d, 0x0b, 0x4b, 0x99, 0xf4, 0x60, 0xdd, 0x94, 0x98, 0xaa, 0xb4, 0x96, 0xdb, 0xe5, 0xde, 0x6e,
-	0x27, 0x72, 0xa9, 0xcc, 0x94, 0xf5, 0x38, 0x8f, 0x12, 0xd8, 0x0e, 0xa5, 0xd1, 0x77, 0x9c, 0xa8,
-	0xfe, 0xf4, 0x19, 0xc4, 0x98, 0xe4, 0xf2, 0x1b, 0x6c, 0xc7, 0x7d, 0x9c, 0x77, 0xc5, 0x44, 0xa7,
-	0x85, 0xb4, 0x4e, 0xdf, 0x90, 0xca, 0x51, 0x9e, 0x54, 0x32, 0x9a, 0xa0, 0x97, 0x50, 0x4d, 0x1d,
-	0x2c, 0xaf, 0x7a, 0x02, 0x91, 0xe1, 0x4a, 0x3b, 0xda, 0x4f, 0x1b, 0xc0, 0x75, 0x18, 0xd2, 0xd1,
-	0x64, 0xbe, 0xa5, 0x47, 0x99, 0xbd, 0x77, 0x2b, 0xb0, 0xdc, 0xbf, 0xb9, 0x22, 0x7d, 0x58, 0x8f,
-	0xcc, 0x8d, 0xa8, 0x71, 0x87, 0x66, 0x1c, 0x56, 0xdb, 0xcf, 0x89, 0xc8, 0x5e, 0x2c, 0x91, 0x9f,
-	0xa0, 0xfa, 0xca, 0xc3, 0xc8, 0x51, 0xbc, 0x63, 0x9e, 0xf9, 0x69, 0xf4, 0xad, 0x94, 0xb8, 0xfa,
-	0x39, 0x94, 0xe2, 0x01, 0x90, 0x84, 0xc7, 0xec, 0xa0, 0x34, 0x2d, 0x2f, 0x14, 0x57, 0xb9, 0x00,
-	0x48, 0xbc, 0x84, 0x24, 0xb9, 0xaf, 0x7c, 0x4b, 0x3b, 0xc8, 0x8d, 0xc5, 0x85, 0x6e, 0x60, 0x33,
-	0x63, 0x1f, 0xe4, 0x70, 0xe6, 0xdc, 0x99, 0x72, 0x8d, 0x79, 0xe1, 0xb8, 0xe2, 0xf7, 0xb0, 0x91,
-	0x56, 0x30, 0xf9, 0x28, 0xde, 0x91, 0xa3, 0x77, 0xed, 0x70, 0x4e, 0x34, 0x4d, 0x30, 0xf3, 0x80,
-	0x52, 0x04, 0xf3, 0x9e, 0x66, 0x8a, 0x60, 0xfe, 0xbb, 0x9b, 0x4e, 0x20, 0x71, 0x8d, 0x64, 0x02,
-	0xb3, 0x2f, 0x20, 0x35, 0x81, 0x57, 0x1a, 0xa5, 0x4b, 0xa7, 0x5f, 0xfd, 0xf3, 0xdc, 0x50, 0xfe,
-	0x7d, 0x6e, 0x28, 0xff, 0x3f, 0x37, 0x94, 0x1f, 0x3f, 0x1b, 0xd9, 0x62, 0x3c, 0x19, 0x76, 0x4c,
-	0xf6, 0xd0, 0xf5, 0x0c, 0x73, 0xfc, 0x87, 0x85, 0x7e, 0xfa, 0xeb, 0xb1, 0xd7, 0xe5, 0xbe, 0x19,
-	0xfd, 0xaf, 0x18, 0xae, 0x4d, 0xfd, 0xe8, 0xe4, 0x7d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x53,
-	0x04, 0x87, 0x71, 0x08, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xff, 0xaf, 0x5d, 0x27, 0xf1, 0x71, 0xe2, 0xc4, 0x13, 0x3b, 0xdd, 0x6c, 0xda, 0x38, 0xdd,
+	0xbf, 0x44, 0x83, 0x08, 0xb6, 0xe4, 0x22, 0x84, 0x40, 0x20, 0x9c, 0x26, 0x2a, 0x95, 0x82, 0x14,
+	0x4d, 0x1a, 0x21, 0x21, 0x84, 0x35, 0xde, 0x3d, 0x71, 0x56, 0x5d, 0x7b, 0xb7, 0x3b, 0xe3, 0xe0,
+	0xbe, 0x05, 0x97, 0x3c, 0x00, 0x12, 0xb7, 0x3c, 0x00, 0x77, 0xdc, 0x70, 0xc9, 0x13, 0x14, 0x94,
+	0x07, 0xe0, 0xa2, 0x4f, 0x80, 0x76, 0x67, 0xf6, 0xcb, 0x59, 0x27, 0xe5, 0xca, 0x7b, 0x3e, 0xe7,
+	0x77, 0xbe, 0x0d, 0x2d, 0xd7, 0xb1, 0x70, 0xc2, 0xb1, 0xab, 0x7e, 0x3b, 0x7e, 0xe0, 0x09, 0x8f,
+	0x2c, 0x2b, 0xd2, 0x68, 0x8f, 0x3c, 0x6f, 0xe4, 0x62, 0x37, 0x62, 0x0f, 0xa7, 0x17, 0x5d, 0xe1,
+	0x8c, 0x91, 0x0b, 0x36, 0xf6, 0xa5, 0xa6, 0xd1, 0x1c, 0x79, 0x23, 0x2f, 0xfa, 0xec, 0x86, 0x5f,
+	0x8a, 0xbb, 0x83, 0x13, 0x81, 0x81, 0x1f, 0x38, 0x1c, 0xbb, 0xe9, 0xa7, 0x14, 0x9a, 0x3e, 0xac,
+	0xf7, 0x2d, 0xe1, 0x5c, 0x31, 0x81, 0x14, 0x5f, 0x4d, 0x91, 0x0b, 0xf2, 0x18, 0xd6, 0x99, 0x64,
+	0x39, 0xde, 0x64, 0x60, 0x79, 0x36, 0xea, 0xda, 0x9e, 0xb6, 0x5f, 0xa5, 0xf5, 0x94, 0xfd, 0xd4,
+	0xb3, 0x91, 0x7c, 0x04, 0xcb, 0x38, 0xf3, 0x9d, 0x00, 0xb9, 0x5e, 0xda, 0xd3, 0xf6, 0x6b, 0x3d,
+	0xa3, 0x23, 0x11, 0x76, 0x62, 0x84, 0x9d, 0x17, 0x31, 0x42, 0x1a, 0xab, 0x9a, 0x9f, 0xc3, 0x46,
+	0xfa, 0x22, 0xf7, 0xbd, 0x09, 0x47, 0xf2, 0x3e, 0xdc, 0x73, 0x26, 0x17, 0x5e, 0xf4, 0x4e, 0xad,
+	0xd7, 0xea, 0x64, 0x60, 0xbe, 0xf0, 0x5e, 0xe2, 0xe4, 0xf9, 0xe4, 0xc2, 0xa3, 0x91, 0x8a, 0x69,
+	0x80, 0xfe, 0x0c, 0x45, 0x3f, 0x87, 0x44, 0x21, 0x37, 0x7f, 0xd2, 0x60, 0xbb, 0x40, 0xa8, 0x1e,
+	0x79, 0x0c, 0x15, 0x2e, 0x98, 0x90, 0xd1, 0xd4, 0x7b, 0x8d, 0xec, 0x2b, 0x67, 0xa1, 0x80, 0x4a,
+	0x79, 0x82, 0xa6, 0x74, 0x27, 0x9a, 0xa2, 0x5c, 0x95, 0x8b, 0x72, 0x65, 0x6e, 0x42, 0xe3, 0x08,
+	0x59, 0x3e, 0xd3, 0x66, 0x13, 0x48, 0x96, 0x29, 0x71, 0x9a, 0xe7, 0xd0, 0xe8, 0xdb, 0xf6, 0x53,
+	0x77, 0xca, 0x05, 0x06, 0x71, 0x51, 0xea, 0x50, 0x72, 0x6c, 0x55, 0x87, 0x92, 0x63, 0x13, 0x1d,
+	0x96, 0x99, 0x6d, 0x07, 0xc8, 0x65, 0xee, 0xab, 0x34, 0x26, 0xc9, 0x16, 0x2c, 0x71, 0xb4, 0x02,
+	0x14, 0x0a, 0x89, 0xa2, 0xcc, 0x03, 0x20, 0x59, 0xb7, 0x2a, 0x29, 0xa9, 0xb6, 0x96, 0xd3, 0x7e,
+	0x0f, 0x9a, 0x47, 0xe8, 0xa2, 0xc0, 0xdb, 0x71, 0x98, 0xf7, 0xa1, 0x35, 0xa7, 0xa7, 0xa2, 0xf8,
+	0x4d, 0x83, 0x55, 0xc5, 0x3b, 0xe7, 0x6c, 0x84, 0xa4, 0x09, 0x95, 0x00, 0x7d, 0x8f, 0x47, 0xc6,
+	0x65, 0x2a, 0x09, 0xf2, 0x00, 0xaa, 0xbe, 0xe3, 0xa3, 0xeb, 0x4c, 0x54, 0x17, 0x95, 0x69, 0xca,
+	0x20, 0x5f, 0x80, 0xcc, 0x23, 0x0e, 0x7e, 0xf0, 0x82, 0x97, 0x18, 0xf0, 0x28, 0xa6, 0xf2, 0xe1,
+	0xfd, 0xb7, 0x6f, 0xda, 0x9b, 0xf6, 0xf0, 0x53, 0x33, 0x2f, 0x35, 0xe9, 0x9a, 0x64, 0x7c, 0x23,
+	0x69, 0xf2, 0x09, 0xac, 0x72, 0xe1, 0x05, 0x68, 0x0f, 0x86, 0xaf, 0x05, 0x72, 0xfd, 0x5e, 0x64,
+	0xdd, 0x7a, 0xfb, 0xa6, 0xdd, 0x08, 0xad, 0xb3, 0x32, 0x93, 0xd6, 0x24, 0x79, 0x18, 0x51, 0xbf,
+	0x68, 0x50, 0x8b, 0x70, 0x9f, 0x38, 0x63, 0x47, 0x70, 0xb2, 0x03, 0xd5, 0x31, 0x9b, 0x0d, 0xb2,
+	0x11, 0xac, 0x8c, 0xd9, 0x8c, 0x46, 0x41, 0xfc, 0x1f, 0xd6, 0x42, 0xe1, 0x7c, 0x20, 0xab, 0x63,
+	0x36, 0x3b, 0x4d, 0x62, 0x39, 0x00, 0x12, 0x2a, 0x15, 0xc5, 0x43, 0x37, 0xc6, 0x6c, 0xd6, 0xcf,
+	0x21, 0xdf, 0x87, 0x90, 0x37, 0xb8, 0x89, 0x9e, 0xd6, 0xc7, 0x6c, 0x76, 0x96, 0x41, 0xfa, 0x4f,
+	0x09, 0xd6, 0x54, 0xa2, 0xc3, 0x2e, 0x9e, 0xf2, 0xff, 0xd0, 0x2b, 0x3a, 0x2c, 0x5f, 0x61, 0xc0,
+	0x1d, 0x6f, 0xa2, 0x9a, 0x25, 0x26, 0xc3, 0xcc, 0xb1, 0xa9, 0xb8, 0x1c, 0xe0, 0x84, 0x0d, 0x5d,
+	0xb4, 0xa3, 0xb7, 0x57, 0xd2, 0xcc, 0x65, 0x65, 0x26, 0xad, 0x85, 0xe4, 0xb1, 0xa4, 0xc8, 0xf7,
+	0x50, 0x77, 0x19, 0x17, 0x83, 0x4b, 0x64, 0x81, 0x18, 0x22, 0x13, 0x7a, 0xe5, 0xae, 0xe5, 0x70,
+	0xb8, 0x13, 0xd7, 0x33, 0x6f, 0x69, 0xfe, 0xf8, 0x57, 0x5b, 0xa3, 0x6b, 0x21, 0xf3, 0xab, 0x98,
+	0x47, 0x28, 0x80, 0x15, 0x20, 0x13, 0x68, 0x0f, 0x98, 0xd0, 0x97, 0xee, 0xf4, 0x1d, 0xf6, 0xca,
+	0x7a, 0xe8, 0x3b, 0xb5, 0x92, 0x7e, 0xab, 0x8a, 0xd1, 0x17, 0xe4, 0x03, 0xa8, 0x4c, 0xc3, 0x62,
+	0xeb, 0xcb, 0x6a, 0xe4, 0xe3, 0x0d, 0x9c, 0xed, 0x60, 0x2a, 0x75, 0xcc, 0x2f, 0xa1, 0x79, 0xee,
+	0xdb, 0xec, 0xae, 0xd1, 0x58, 0x9c, 0xf6, 0x70, 0x68, 0xe6, 0x3c, 0xa8, 0xa1, 0x69, 0xc1, 0xe6,
+	0x89, 0xc3, 0x85, 0x62, 0xf3, 0x78, 0x4f, 0xfc, 0xaa, 0x41, 0x33, 0xcf, 0x57, 0xd3, 0xdb, 0x83,
+	0x15, 0x4b, 0xf1, 0x74, 0x6d, 0xaf, 0xbc, 0x5f, 0xeb, 0x6d, 0xcd, 0x43, 0x97, 0x3d, 0x41, 0x13,
+	0x3d, 0xf2, 0x31, 0xd4, 0x84, 0x27, 0x98, 0x3b, 0x90, 0x11, 0x97, 0x6e, 0x8b, 0x18, 0x22, 0xcd,
+	0xe8, 0x9b, 0x1c, 0xc0, 0x92, 0x1b, 0xcd, 0x42, 0xd4, 0x2a, 0xb5, 0x5e, 0x33, 0x31, 0xc9, 0xcc,
+	0x09, 0x55, 0x3a, 0xe6, 0x31, 0xb4, 0xce, 0x50, 0x64, 0x25, 0x2a, 0x4b, 0xa9, 0x1b, 0xed, 0x1d,
+	0xdc, 0xe8, 0xb0, 0x35, 0xef, 0x46, 0xa5, 0x8a, 0xc0, 0x86, 0x5c, 0x3c, 0x7d, 0xd7, 0x8d, 0xf3,
+	0x14, 0x2d, 0xd9, 0x84, 0xa7, 0x14, 0x7f, 0xd6, 0x60, 0x23, 0xe9, 0x9e, 0x45, 0xb5, 0x4a, 0xd7,
+	0x60, 0x29, 0xbb, 0x06, 0x6f, 0x19, 0x90, 0x47, 0x45, 0x03, 0x92, 0x9f, 0x84, 0xa4, 0xab, 0x2a,
+	0xef, 0xd0, 0x55, 0xaf, 0xa0, 0x91, 0x41, 0xa9, 0xea, 0xfb, 0x04, 0xe2, 0xe3, 0xaf, 0xb2, 0xb5,
+	0x9d, 0x3d, 0x46, 0x27, 0x52, 0x44, 0xd1, 0xf2, 0x02, 0x9b, 0xc6, 0x9a, 0xe1, 0x4d, 0x92, 0xd9,
+	0x1b, 0xe0, 0xcc, 0x42, 0xb4, 0xd1, 0xd6, 0x4b, 0x7b, 0xe5, 0xf0, 0x26, 0x49, 0xf6, 0xb1, 0xe2,
+	0xf6, 0x7e, 0xaf, 0x40, 0xb9, 0x7f, 0xfa, 0x9c, 0xf4, 0x61, 0x25, 0xbe, 0xc8, 0x44, 0x4f, 0x40,
+	0xce, 0xfd, 0x2d, 0x30, 0xb6, 0x0b, 0x24, 0x2a, 0xc5, 0xff, 0x23, 0xdf, 0x41, 0xe3, 0xc6, 0xe1,
+	0x25, 0x8f, 0x12, 0x8b, 0x45, 0x17, 0xdb, 0x30, 0x6f, 0x53, 0x49, 0xbc, 0x1f, 0x41, 0x35, 0xa9,
+	0x2b, 0x49, 0x71, 0xcc, 0xd7, 0xdf, 0x30, 0x8a, 0x44, 0x89, 0x97, 0x67, 0x00, 0xe9, 0x01, 0x24,
+	0xa9, 0xee, 0x8d, 0x63, 0x6b, 0xec, 0x14, 0xca, 0x12, 0x47, 0xa7, 0xb0, 0x96, 0xbb, 0x79, 0xe4,
+	0xe1, 0xdc, 0xbb, 0x73, 0xee, 0x76, 0x17, 0x89, 0x13, 0x8f, 0x5f, 0xc3, 0x6a, 0x76, 0xbe, 0xc9,
+	0x83, 0xc4, 0xa2, 0x60, 0x1d, 0x18, 0x0f, 0x17, 0x48, 0xb3, 0x00, 0x73, 0xfb, 0x25, 0x03, 0xb0,
+	0x68, 0x73, 0x19, 0xbb, 0x8b, 0xc4, 0x89, 0xc7, 0x33, 0xa8, 0xe7, 0xe7, 0x90, 0xa4, 0x36, 0x85,
+	0x73, 0x6e, 0xb4, 0x17, 0xca, 0xb3, 0x65, 0x4d, 0xd7, 0x7a, 0x5a, 0xd6, 0xf9, 0x61, 0x35, 0x8c,
+	0x22, 0x51, 0xec, 0xe5, 0xf0, 0xb3, 0x3f, 0xae, 0x77, 0xb5, 0x3f, 0xaf, 0x77, 0xb5, 0xbf, 0xaf,
+	0x77, 0xb5, 0x6f, 0x3f, 0x1c, 0x39, 0xe2, 0x72, 0x3a, 0xec, 0x58, 0xde, 0xb8, 0xeb, 0x33, 0xeb,
+	0xf2, 0xb5, 0x8d, 0x41, 0xf6, 0xeb, 0xaa, 0xd7, 0xe5, 0x81, 0x15, 0xff, 0xc3, 0x1e, 0x2e, 0x45,
+	0x07, 0xe3, 0xc9, 0xbf, 0x03, 0x00, 0x65, 0x00, 0x74, 0x4b, 0x7b, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1086,6 +1383,9 @@ type APIClient interface {
 	DeleteCluster(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*DeleteClusterResponse, error)
 	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
 	UpdateCluster(ctx context.Context, in *UpdateClusterRequest, opts ...grpc.CallOption) (*UpdateClusterResponse, error)
+	// SetUsageLimits sets the limits on the combined usage of all
+	// registered clusters.
+	SetUsageLimits(ctx context.Context, in *SetUsageLimitsRequest, opts ...grpc.CallOption) (*SetUsageLimitsResponse, error)
 	// Heartbeat is the RPC registered pachds make to the license server
 	// to communicate their status and fetch updates.
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
@@ -1162,6 +1462,15 @@ func (c *aPIClient) UpdateCluster(ctx context.Context, in *UpdateClusterRequest,
 	return out, nil
 }
 
+func (c *aPIClient) SetUsageLimits(ctx context.Context, in *SetUsageLimitsRequest, opts ...grpc.CallOption) (*SetUsageLimitsResponse, error) {
+	out := new(SetUsageLimitsResponse)
+	err := c.cc.Invoke(ctx, "/license.API/SetUsageLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
 	out := new(HeartbeatResponse)
 	err := c.cc.Invoke(ctx, "/license.API/Heartbeat", in, out, opts...)
@@ -1184,6 +1493,9 @@ type APIServer interface {
 	DeleteCluster(context.Context, *DeleteClusterRequest) (*DeleteClusterResponse, error)
 	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
 	UpdateCluster(context.Context, *UpdateClusterRequest) (*UpdateClusterResponse, error)
+	// SetUsageLimits sets the limits on the combined usage of all
+	// registered clusters.
+	SetUsageLimits(context.Context, *SetUsageLimitsRequest) (*SetUsageLimitsResponse, error)
 	// Heartbeat is the RPC registered pachds make to the license server
 	// to communicate their status and fetch updates.
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
@@ -1214,6 +1526,9 @@ func (*UnimplementedAPIServer) ListClusters(ctx context.Context, req *ListCluste
 func (*UnimplementedAPIServer) UpdateCluster(ctx context.Context, req *UpdateClusterRequest) (*UpdateClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCluster not implemented")
 }
+func (*UnimplementedAPIServer) SetUsageLimits(ctx context.Context, req *SetUsageLimitsRequest) (*SetUsageLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUsageLimits not implemented")
+}
 func (*UnimplementedAPIServer) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*HeartbeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
@@ -1348,6 +1663,24 @@ func _API_UpdateCluster_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_SetUsageLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUsageLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetUsageLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/license.API/SetUsageLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetUsageLimits(ctx, req.(*SetUsageLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HeartbeatRequest)
 	if err := dec(in); err != nil {
@@ -1398,6 +1731,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCluster",
 			Handler:    _API_UpdateCluster_Handler,
 		},
+		{
+			MethodName: "SetUsageLimits",
+			Handler:    _API_SetUsageLimits_Handler,
+		},
 		{
 			MethodName: "Heartbeat",
 			Handler:    _API_Heartbeat_Handler,
@@ -1767,7 +2104,7 @@ func (m *DeleteClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ClusterStatus) Marshal() (dAtA []byte, err error) {
+func (m *ClusterUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1777,12 +2114,12 @@ func (m *ClusterStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1791,61 +2128,30 @@ func (m *ClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreatedAt != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintLicense(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.LastHeartbeat != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeat, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeat):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintLicense(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.AuthEnabled {
-		i--
-		if m.AuthEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.StoredBytes != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.StoredBytes))
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.Version)))
+	if m.ActiveWorkers != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.ActiveWorkers))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.Address)))
+	if m.Pipelines != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Pipelines))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.Id)))
+	if m.Repos != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.Repos))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateClusterRequest) Marshal() (dAtA []byte, err error) {
+func (m *UsageLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1855,12 +2161,12 @@ func (m *UpdateClusterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UsageLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UsageLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1869,15 +2175,152 @@ func (m *UpdateClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLicense(dAtA, i, uint64(len(m.Address)))
+	if m.MaxStoredBytes != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.MaxStoredBytes))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
+	if m.MaxActiveWorkers != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.MaxActiveWorkers))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPipelines != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.MaxPipelines))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRepos != 0 {
+		i = encodeVarintLicense(dAtA, i, uint64(m.MaxRepos))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedAt != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintLicense(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastHeartbeat != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeat, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeat):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintLicense(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthEnabled {
+		i--
+		if m.AuthEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLicense(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
 		i = encodeVarintLicense(dAtA, i, uint64(len(m.Id)))
 		i--
@@ -1964,6 +2407,30 @@ func (m *ListClustersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TotalUsage != nil {
+		{
+			size, err := m.TotalUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Clusters) > 0 {
 		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1981,6 +2448,72 @@ func (m *ListClustersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetUsageLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetUsageLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetUsageLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetUsageLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetUsageLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetUsageLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2059,6 +2592,18 @@ func (m *HeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLicense(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.AuthEnabled {
 		i--
 		if m.AuthEnabled {
@@ -2117,6 +2662,15 @@ func (m *HeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LimitsExceeded) > 0 {
+		for iNdEx := len(m.LimitsExceeded) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LimitsExceeded[iNdEx])
+			copy(dAtA[i:], m.LimitsExceeded[iNdEx])
+			i = encodeVarintLicense(dAtA, i, uint64(len(m.LimitsExceeded[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.License != nil {
 		{
 			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
@@ -2306,6 +2860,54 @@ func (m *DeleteClusterResponse) Size() (n int) {
 	return n
 }
 
+func (m *ClusterUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repos != 0 {
+		n += 1 + sovLicense(uint64(m.Repos))
+	}
+	if m.Pipelines != 0 {
+		n += 1 + sovLicense(uint64(m.Pipelines))
+	}
+	if m.ActiveWorkers != 0 {
+		n += 1 + sovLicense(uint64(m.ActiveWorkers))
+	}
+	if m.StoredBytes != 0 {
+		n += 1 + sovLicense(uint64(m.StoredBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UsageLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRepos != 0 {
+		n += 1 + sovLicense(uint64(m.MaxRepos))
+	}
+	if m.MaxPipelines != 0 {
+		n += 1 + sovLicense(uint64(m.MaxPipelines))
+	}
+	if m.MaxActiveWorkers != 0 {
+		n += 1 + sovLicense(uint64(m.MaxActiveWorkers))
+	}
+	if m.MaxStoredBytes != 0 {
+		n += 1 + sovLicense(uint64(m.MaxStoredBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ClusterStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2335,6 +2937,10 @@ func (m *ClusterStatus) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedAt)
 		n += 1 + l + sovLicense(uint64(l))
 	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2397,6 +3003,42 @@ func (m *ListClustersResponse) Size() (n int) {
 			n += 1 + l + sovLicense(uint64(l))
 		}
 	}
+	if m.TotalUsage != nil {
+		l = m.TotalUsage.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetUsageLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetUsageLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2448,6 +3090,10 @@ func (m *HeartbeatRequest) Size() (n int) {
 	if m.AuthEnabled {
 		n += 2
 	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovLicense(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2464,6 +3110,12 @@ func (m *HeartbeatResponse) Size() (n int) {
 		l = m.License.Size()
 		n += 1 + l + sovLicense(uint64(l))
 	}
+	if len(m.LimitsExceeded) > 0 {
+		for _, s := range m.LimitsExceeded {
+			l = len(s)
+			n += 1 + l + sovLicense(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3337,6 +3989,260 @@ func (m *DeleteClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			m.Repos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repos |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			m.Pipelines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pipelines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWorkers", wireType)
+			}
+			m.ActiveWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveWorkers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredBytes", wireType)
+			}
+			m.StoredBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRepos", wireType)
+			}
+			m.MaxRepos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRepos |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPipelines", wireType)
+			}
+			m.MaxPipelines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPipelines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveWorkers", wireType)
+			}
+			m.MaxActiveWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveWorkers |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStoredBytes", wireType)
+			}
+			m.MaxStoredBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStoredBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ClusterStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3550,7 +4456,43 @@ func (m *ClusterStatus) Unmarshal(dAtA []byte) error {
 			if m.CreatedAt == nil {
 				m.CreatedAt = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &ClusterUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3856,6 +4798,216 @@ func (m *ListClustersResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalUsage == nil {
+				m.TotalUsage = &ClusterUsage{}
+			}
+			if err := m.TotalUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &UsageLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetUsageLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetUsageLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetUsageLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &UsageLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLicense(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetUsageLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLicense
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetUsageLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetUsageLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLicense(dAtA[iNdEx:])
@@ -4125,6 +5277,42 @@ func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AuthEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &ClusterUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLicense(dAtA[iNdEx:])
@@ -4212,6 +5400,38 @@ func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitsExceeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLicense
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLicense
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLicense
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitsExceeded = append(m.LimitsExceeded, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLicense(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"path"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/pachhash"
 )
@@ -15,6 +16,20 @@ var (
 	EmptyStr = "(empty)"
 )
 
+// ContentTypeMetadataKey is the file metadata key which holds a file's MIME
+// type. It's served as the Content-Type of the file over HTTP and S3.
+const ContentTypeMetadataKey = "Content-Type"
+
+// ResolveSymlink returns the path in a commit that a symlink at link with the
+// given target points to. Relative targets are resolved from the directory
+// containing the link, and no target can point outside of the commit.
+func ResolveSymlink(link, target string) string {
+	if !path.IsAbs(target) {
+		target = path.Join(path.Dir(link), target)
+	}
+	return path.Clean("/" + target)
+}
+
 // FullID prints repoName/CommitID
 func (c *Commit) FullID() string {
 	return fmt.Sprintf("%s/%s", c.Repo.Name, c.ID)
@@ -259,23 +259,26 @@ func (m *SecretMount) GetEnvVar() string {
 }
 
 type Transform struct {
-	Image                string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Cmd                  []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
-	ErrCmd               []string          `protobuf:"bytes,13,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
-	Env                  map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Secrets              []*SecretMount    `protobuf:"bytes,4,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	ImagePullSecrets     []string          `protobuf:"bytes,9,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
-	Stdin                []string          `protobuf:"bytes,5,rep,name=stdin,proto3" json:"stdin,omitempty"`
-	ErrStdin             []string          `protobuf:"bytes,14,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
-	AcceptReturnCode     []int64           `protobuf:"varint,6,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
-	Debug                bool              `protobuf:"varint,7,opt,name=debug,proto3" json:"debug,omitempty"`
-	User                 string            `protobuf:"bytes,10,opt,name=user,proto3" json:"user,omitempty"`
-	WorkingDir           string            `protobuf:"bytes,11,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
-	Dockerfile           string            `protobuf:"bytes,12,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
-	Build                *BuildSpec        `protobuf:"bytes,15,opt,name=build,proto3" json:"build,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Image            string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Cmd              []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
+	ErrCmd           []string          `protobuf:"bytes,13,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
+	Env              map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Secrets          []*SecretMount    `protobuf:"bytes,4,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	ImagePullSecrets []string          `protobuf:"bytes,9,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
+	Stdin            []string          `protobuf:"bytes,5,rep,name=stdin,proto3" json:"stdin,omitempty"`
+	ErrStdin         []string          `protobuf:"bytes,14,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
+	AcceptReturnCode []int64           `protobuf:"varint,6,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
+	Debug            bool              `protobuf:"varint,7,opt,name=debug,proto3" json:"debug,omitempty"`
+	User             string            `protobuf:"bytes,10,opt,name=user,proto3" json:"user,omitempty"`
+	WorkingDir       string            `protobuf:"bytes,11,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	Dockerfile       string            `protobuf:"bytes,12,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
+	Build            *BuildSpec        `protobuf:"bytes,15,opt,name=build,proto3" json:"build,omitempty"`
+	// persistent starts cmd once per worker and sends it each datum over stdin,
+	// instead of starting cmd once per datum.
+	Persistent           bool     `protobuf:"varint,16,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Transform) Reset()         { *m = Transform{} }
@@ -409,6 +412,13 @@ func (m *Transform) GetBuild() *BuildSpec {
 	return nil
 }
 
+func (m *Transform) GetPersistent() bool {
+	if m != nil {
+		return m.Persistent
+	}
+	return false
+}
+
 type BuildSpec struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Language             string   `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
@@ -1094,24 +1104,264 @@ func (m *GitInput) GetCommit() string {
 	return ""
 }
 
+// SQLInput runs a query against a SQL database and commits its results to
+// the input's repo, either on a schedule or when triggered by RunCron.
+type SQLInput struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// url is the connection URL of the database, such as
+	// postgres://user@host:5432/db or mysql://user@tcp(host:3306)/db.
+	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// password_secret, if set, is the name of a secret whose "password" key
+	// holds the password for url.
+	PasswordSecret string `protobuf:"bytes,5,opt,name=password_secret,json=passwordSecret,proto3" json:"password_secret,omitempty"`
+	Query          string `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	// format is the format that the results are written in, either "jsonl"
+	// (the default) or "csv".
+	Format string `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	// spec is the cron schedule that the query is run on. If it's empty, the
+	// query is only run by RunCron.
+	Spec string `protobuf:"bytes,8,opt,name=spec,proto3" json:"spec,omitempty"`
+	// rows_per_file, if nonzero, splits the results into files of at most
+	// that many rows, so that they can be processed as separate datums.
+	RowsPerFile          int64    `protobuf:"varint,9,opt,name=rows_per_file,json=rowsPerFile,proto3" json:"rows_per_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQLInput) Reset()         { *m = SQLInput{} }
+func (m *SQLInput) String() string { return proto.CompactTextString(m) }
+func (*SQLInput) ProtoMessage()    {}
+func (*SQLInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{12}
+}
+func (m *SQLInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SQLInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLInput.Merge(m, src)
+}
+func (m *SQLInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLInput proto.InternalMessageInfo
+
+func (m *SQLInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SQLInput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *SQLInput) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *SQLInput) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *SQLInput) GetPasswordSecret() string {
+	if m != nil {
+		return m.PasswordSecret
+	}
+	return ""
+}
+
+func (m *SQLInput) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SQLInput) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *SQLInput) GetSpec() string {
+	if m != nil {
+		return m.Spec
+	}
+	return ""
+}
+
+func (m *SQLInput) GetRowsPerFile() int64 {
+	if m != nil {
+		return m.RowsPerFile
+	}
+	return 0
+}
+
+// BucketInput keeps the input's repo in sync with a prefix of an object
+// store bucket, committing objects that are added or changed.
+type BucketInput struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// url is the location of the bucket and prefix, such as
+	// s3://bucket/prefix, gs://bucket/prefix or local://path/prefix. It can't
+	// be in pachd's own storage bucket.
+	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// interval is how often the bucket is polled for changes, by default 1m.
+	Interval *types.Duration `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// delete, if true, deletes files from the repo when their objects are
+	// removed from the bucket.
+	Delete bool   `protobuf:"varint,6,opt,name=delete,proto3" json:"delete,omitempty"`
+	Glob   string `protobuf:"bytes,7,opt,name=glob,proto3" json:"glob,omitempty"`
+	// credentials_secret is the name of a secret holding the credentials used
+	// to access the bucket, under the same keys as pachd's storage secret
+	// (e.g. amazon-region, amazon-id and amazon-secret). It's required unless
+	// url is local.
+	CredentialsSecret    string   `protobuf:"bytes,8,opt,name=credentials_secret,json=credentialsSecret,proto3" json:"credentials_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketInput) Reset()         { *m = BucketInput{} }
+func (m *BucketInput) String() string { return proto.CompactTextString(m) }
+func (*BucketInput) ProtoMessage()    {}
+func (*BucketInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{13}
+}
+func (m *BucketInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BucketInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketInput.Merge(m, src)
+}
+func (m *BucketInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketInput proto.InternalMessageInfo
+
+func (m *BucketInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BucketInput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *BucketInput) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *BucketInput) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *BucketInput) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *BucketInput) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *BucketInput) GetGlob() string {
+	if m != nil {
+		return m.Glob
+	}
+	return ""
+}
+
+func (m *BucketInput) GetCredentialsSecret() string {
+	if m != nil {
+		return m.CredentialsSecret
+	}
+	return ""
+}
+
 type Input struct {
-	Pfs                  *PFSInput  `protobuf:"bytes,6,opt,name=pfs,proto3" json:"pfs,omitempty"`
-	Join                 []*Input   `protobuf:"bytes,7,rep,name=join,proto3" json:"join,omitempty"`
-	Group                []*Input   `protobuf:"bytes,8,rep,name=group,proto3" json:"group,omitempty"`
-	Cross                []*Input   `protobuf:"bytes,2,rep,name=cross,proto3" json:"cross,omitempty"`
-	Union                []*Input   `protobuf:"bytes,3,rep,name=union,proto3" json:"union,omitempty"`
-	Cron                 *CronInput `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
-	Git                  *GitInput  `protobuf:"bytes,5,opt,name=git,proto3" json:"git,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Pfs                  *PFSInput    `protobuf:"bytes,6,opt,name=pfs,proto3" json:"pfs,omitempty"`
+	Join                 []*Input     `protobuf:"bytes,7,rep,name=join,proto3" json:"join,omitempty"`
+	Group                []*Input     `protobuf:"bytes,8,rep,name=group,proto3" json:"group,omitempty"`
+	Cross                []*Input     `protobuf:"bytes,2,rep,name=cross,proto3" json:"cross,omitempty"`
+	Union                []*Input     `protobuf:"bytes,3,rep,name=union,proto3" json:"union,omitempty"`
+	Cron                 *CronInput   `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	Git                  *GitInput    `protobuf:"bytes,5,opt,name=git,proto3" json:"git,omitempty"`
+	SQL                  *SQLInput    `protobuf:"bytes,9,opt,name=sql,proto3" json:"sql,omitempty"`
+	Bucket               *BucketInput `protobuf:"bytes,10,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{12}
+	return fileDescriptor_beade573c128ccc7, []int{14}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,6 +1439,20 @@ func (m *Input) GetGit() *GitInput {
 	return nil
 }
 
+func (m *Input) GetSQL() *SQLInput {
+	if m != nil {
+		return m.SQL
+	}
+	return nil
+}
+
+func (m *Input) GetBucket() *BucketInput {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
 type JobInput struct {
 	Name                 string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Commit               *pfs.Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -1203,7 +1467,7 @@ func (m *JobInput) Reset()         { *m = JobInput{} }
 func (m *JobInput) String() string { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()    {}
 func (*JobInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{13}
+	return fileDescriptor_beade573c128ccc7, []int{15}
 }
 func (m *JobInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1284,7 +1548,7 @@ func (m *ParallelismSpec) Reset()         { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()    {}
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{14}
+	return fileDescriptor_beade573c128ccc7, []int{16}
 }
 func (m *ParallelismSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1605,7 @@ func (m *InputFile) Reset()         { *m = InputFile{} }
 func (m *InputFile) String() string { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()    {}
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{15}
+	return fileDescriptor_beade573c128ccc7, []int{17}
 }
 func (m *InputFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1661,7 @@ func (m *Datum) Reset()         { *m = Datum{} }
 func (m *Datum) String() string { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()    {}
 func (*Datum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{16}
+	return fileDescriptor_beade573c128ccc7, []int{18}
 }
 func (m *Datum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1455,7 +1719,7 @@ func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
 func (m *DatumInfo) String() string { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()    {}
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{17}
+	return fileDescriptor_beade573c128ccc7, []int{19}
 }
 func (m *DatumInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1534,7 +1798,7 @@ func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{18}
+	return fileDescriptor_beade573c128ccc7, []int{20}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1613,7 +1877,7 @@ func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
 func (m *ProcessStats) String() string { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()    {}
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{19}
+	return fileDescriptor_beade573c128ccc7, []int{21}
 }
 func (m *ProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1692,7 +1956,7 @@ func (m *AggregateProcessStats) Reset()         { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()    {}
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{20}
+	return fileDescriptor_beade573c128ccc7, []int{22}
 }
 func (m *AggregateProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1761,21 +2025,24 @@ type WorkerStatus struct {
 	JobID    string       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	Data     []*InputFile `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
 	// Started is the time processing on the current datum began.
-	Started              *types.Timestamp `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
-	Stats                *ProcessStats    `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	QueueSize            int64            `protobuf:"varint,6,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
-	DataProcessed        int64            `protobuf:"varint,7,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataRecovered        int64            `protobuf:"varint,8,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Started       *types.Timestamp `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
+	Stats         *ProcessStats    `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	QueueSize     int64            `protobuf:"varint,6,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	DataProcessed int64            `protobuf:"varint,7,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataRecovered int64            `protobuf:"varint,8,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	// Datums holds each of the datums the worker is currently processing, data
+	// and started describe the oldest of them.
+	Datums               []*DatumStatus `protobuf:"bytes,9,rep,name=datums,proto3" json:"datums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()    {}
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{21}
+	return fileDescriptor_beade573c128ccc7, []int{23}
 }
 func (m *WorkerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1860,6 +2127,70 @@ func (m *WorkerStatus) GetDataRecovered() int64 {
 	return 0
 }
 
+func (m *WorkerStatus) GetDatums() []*DatumStatus {
+	if m != nil {
+		return m.Datums
+	}
+	return nil
+}
+
+// DatumStatus describes a datum that a worker is currently processing.
+type DatumStatus struct {
+	Data []*InputFile `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Started is the time processing on the datum began.
+	Started              *types.Timestamp `protobuf:"bytes,2,opt,name=started,proto3" json:"started,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DatumStatus) Reset()         { *m = DatumStatus{} }
+func (m *DatumStatus) String() string { return proto.CompactTextString(m) }
+func (*DatumStatus) ProtoMessage()    {}
+func (*DatumStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{24}
+}
+func (m *DatumStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumStatus.Merge(m, src)
+}
+func (m *DatumStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumStatus proto.InternalMessageInfo
+
+func (m *DatumStatus) GetData() []*InputFile {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DatumStatus) GetStarted() *types.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
 // ResourceSpec describes the amount of resources that pipeline pods should
 // request from kubernetes, for scheduling.
 type ResourceSpec struct {
@@ -1883,7 +2214,7 @@ func (m *ResourceSpec) Reset()         { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()    {}
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{22}
+	return fileDescriptor_beade573c128ccc7, []int{25}
 }
 func (m *ResourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1954,7 +2285,7 @@ func (m *GPUSpec) Reset()         { *m = GPUSpec{} }
 func (m *GPUSpec) String() string { return proto.CompactTextString(m) }
 func (*GPUSpec) ProtoMessage()    {}
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{23}
+	return fileDescriptor_beade573c128ccc7, []int{26}
 }
 func (m *GPUSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2028,7 +2359,7 @@ func (m *EtcdJobInfo) Reset()         { *m = EtcdJobInfo{} }
 func (m *EtcdJobInfo) String() string { return proto.CompactTextString(m) }
 func (*EtcdJobInfo) ProtoMessage()    {}
 func (*EtcdJobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{24}
+	return fileDescriptor_beade573c128ccc7, []int{27}
 }
 func (m *EtcdJobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2212,7 +2543,7 @@ func (m *JobInfo) Reset()         { *m = JobInfo{} }
 func (m *JobInfo) String() string { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()    {}
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{25}
+	return fileDescriptor_beade573c128ccc7, []int{28}
 }
 func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2533,7 +2864,7 @@ func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{26}
+	return fileDescriptor_beade573c128ccc7, []int{29}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2918,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{27}
+	return fileDescriptor_beade573c128ccc7, []int{30}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2650,7 +2981,7 @@ func (m *EtcdPipelineInfo) Reset()         { *m = EtcdPipelineInfo{} }
 func (m *EtcdPipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*EtcdPipelineInfo) ProtoMessage()    {}
 func (*EtcdPipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{28}
+	return fileDescriptor_beade573c128ccc7, []int{31}
 }
 func (m *EtcdPipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2764,33 +3095,38 @@ type PipelineInfo struct {
 	EnableStats           bool            `protobuf:"varint,24,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	Salt                  string          `protobuf:"bytes,25,opt,name=salt,proto3" json:"salt,omitempty"`
 	// reason includes any error messages associated with a failed pipeline
-	Reason               string          `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,30,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	GithookURL           string          `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Standby              bool            `protobuf:"varint,37,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,39,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,40,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,41,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	S3Out                bool            `protobuf:"varint,47,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,48,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,53,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reason               string            `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaxQueueSize         int64             `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service              *Service          `protobuf:"bytes,30,opt,name=service,proto3" json:"service,omitempty"`
+	Spout                *Spout            `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec            *ChunkSpec        `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout         *types.Duration   `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout           *types.Duration   `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	GithookURL           string            `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
+	SpecCommit           *pfs.Commit       `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Standby              bool              `protobuf:"varint,37,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries           int64             `protobuf:"varint,39,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec       *SchedulingSpec   `protobuf:"bytes,40,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec              string            `protobuf:"bytes,41,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch             string            `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	S3Out                bool              `protobuf:"varint,47,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
+	Metadata             *Metadata         `protobuf:"bytes,48,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec        string            `protobuf:"bytes,53,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	PropagateLabels      []string          `protobuf:"bytes,54,rep,name=propagate_labels,json=propagateLabels,proto3" json:"propagate_labels,omitempty"`
+	DatumConcurrency     int64             `protobuf:"varint,55,opt,name=datum_concurrency,json=datumConcurrency,proto3" json:"datum_concurrency,omitempty"`
+	PrioritizeNewestJobs bool              `protobuf:"varint,56,opt,name=prioritize_newest_jobs,json=prioritizeNewestJobs,proto3" json:"prioritize_newest_jobs,omitempty"`
+	Template             *PipelineTemplate `protobuf:"bytes,57,opt,name=template,proto3" json:"template,omitempty"`
+	JobRetries           *JobRetries       `protobuf:"bytes,58,opt,name=job_retries,json=jobRetries,proto3" json:"job_retries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()    {}
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{29}
+	return fileDescriptor_beade573c128ccc7, []int{32}
 }
 func (m *PipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3099,6 +3435,41 @@ func (m *PipelineInfo) GetReprocessSpec() string {
 	return ""
 }
 
+func (m *PipelineInfo) GetPropagateLabels() []string {
+	if m != nil {
+		return m.PropagateLabels
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetDatumConcurrency() int64 {
+	if m != nil {
+		return m.DatumConcurrency
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetPrioritizeNewestJobs() bool {
+	if m != nil {
+		return m.PrioritizeNewestJobs
+	}
+	return false
+}
+
+func (m *PipelineInfo) GetTemplate() *PipelineTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetJobRetries() *JobRetries {
+	if m != nil {
+		return m.JobRetries
+	}
+	return nil
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -3110,7 +3481,7 @@ func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()    {}
 func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{30}
+	return fileDescriptor_beade573c128ccc7, []int{33}
 }
 func (m *PipelineInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3173,7 +3544,7 @@ func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()    {}
 func (*CreateJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{31}
+	return fileDescriptor_beade573c128ccc7, []int{34}
 }
 func (m *CreateJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3686,7 @@ func (m *InspectJobRequest) Reset()         { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()    {}
 func (*InspectJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{32}
+	return fileDescriptor_beade573c128ccc7, []int{35}
 }
 func (m *InspectJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3401,7 +3772,7 @@ func (m *ListJobRequest) Reset()         { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()    {}
 func (*ListJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{33}
+	return fileDescriptor_beade573c128ccc7, []int{36}
 }
 func (m *ListJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3484,7 +3855,7 @@ func (m *FlushJobRequest) Reset()         { *m = FlushJobRequest{} }
 func (m *FlushJobRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushJobRequest) ProtoMessage()    {}
 func (*FlushJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{34}
+	return fileDescriptor_beade573c128ccc7, []int{37}
 }
 func (m *FlushJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3538,7 +3909,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{35}
+	return fileDescriptor_beade573c128ccc7, []int{38}
 }
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3586,7 +3957,7 @@ func (m *StopJobRequest) Reset()         { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()    {}
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{36}
+	return fileDescriptor_beade573c128ccc7, []int{39}
 }
 func (m *StopJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3649,7 +4020,7 @@ func (m *UpdateJobStateRequest) Reset()         { *m = UpdateJobStateRequest{} }
 func (m *UpdateJobStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobStateRequest) ProtoMessage()    {}
 func (*UpdateJobStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{37}
+	return fileDescriptor_beade573c128ccc7, []int{40}
 }
 func (m *UpdateJobStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3784,7 +4155,7 @@ func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()    {}
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{38}
+	return fileDescriptor_beade573c128ccc7, []int{41}
 }
 func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3902,7 +4273,7 @@ func (m *LogMessage) Reset()         { *m = LogMessage{} }
 func (m *LogMessage) String() string { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()    {}
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{39}
+	return fileDescriptor_beade573c128ccc7, []int{42}
 }
 func (m *LogMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4006,7 +4377,7 @@ func (m *RestartDatumRequest) Reset()         { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()    {}
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{40}
+	return fileDescriptor_beade573c128ccc7, []int{43}
 }
 func (m *RestartDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4060,7 +4431,7 @@ func (m *InspectDatumRequest) Reset()         { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()    {}
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{41}
+	return fileDescriptor_beade573c128ccc7, []int{44}
 }
 func (m *InspectDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4110,7 +4481,7 @@ func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()    {}
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{42}
+	return fileDescriptor_beade573c128ccc7, []int{45}
 }
 func (m *ListDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4146,6 +4517,148 @@ func (m *ListDatumRequest) GetJob() *Job {
 	return nil
 }
 
+type FileLineageRequest struct {
+	// File is the file whose lineage should be traced.
+	File *pfs.File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Downstream, if true, traces the files that were derived from file rather
+	// than the files that file was derived from.
+	Downstream bool `protobuf:"varint,2,opt,name=downstream,proto3" json:"downstream,omitempty"`
+	// Depth limits how many pipelines are traversed, 0 means no limit.
+	Depth                int64    `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileLineageRequest) Reset()         { *m = FileLineageRequest{} }
+func (m *FileLineageRequest) String() string { return proto.CompactTextString(m) }
+func (*FileLineageRequest) ProtoMessage()    {}
+func (*FileLineageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{46}
+}
+func (m *FileLineageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileLineageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileLineageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileLineageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileLineageRequest.Merge(m, src)
+}
+func (m *FileLineageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileLineageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileLineageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileLineageRequest proto.InternalMessageInfo
+
+func (m *FileLineageRequest) GetFile() *pfs.File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *FileLineageRequest) GetDownstream() bool {
+	if m != nil {
+		return m.Downstream
+	}
+	return false
+}
+
+func (m *FileLineageRequest) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+// FileLineageInfo is a single edge in a file's lineage graph, it records that
+// the datum consumed input while writing output.
+type FileLineageInfo struct {
+	Input  *pfs.File `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Output *pfs.File `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	Datum  *Datum    `protobuf:"bytes,3,opt,name=datum,proto3" json:"datum,omitempty"`
+	// Depth is the number of pipelines between the requested file and this edge,
+	// starting at 1.
+	Depth                int64    `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileLineageInfo) Reset()         { *m = FileLineageInfo{} }
+func (m *FileLineageInfo) String() string { return proto.CompactTextString(m) }
+func (*FileLineageInfo) ProtoMessage()    {}
+func (*FileLineageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{47}
+}
+func (m *FileLineageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileLineageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileLineageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileLineageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileLineageInfo.Merge(m, src)
+}
+func (m *FileLineageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileLineageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileLineageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileLineageInfo proto.InternalMessageInfo
+
+func (m *FileLineageInfo) GetInput() *pfs.File {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *FileLineageInfo) GetOutput() *pfs.File {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *FileLineageInfo) GetDatum() *Datum {
+	if m != nil {
+		return m.Datum
+	}
+	return nil
+}
+
+func (m *FileLineageInfo) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
 // ChunkSpec specifies how a pipeline should chunk its datums.
 type ChunkSpec struct {
 	// number, if nonzero, specifies that each chunk should contain `number`
@@ -4165,7 +4678,7 @@ func (m *ChunkSpec) Reset()         { *m = ChunkSpec{} }
 func (m *ChunkSpec) String() string { return proto.CompactTextString(m) }
 func (*ChunkSpec) ProtoMessage()    {}
 func (*ChunkSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{43}
+	return fileDescriptor_beade573c128ccc7, []int{48}
 }
 func (m *ChunkSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4220,7 +4733,7 @@ func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
 func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
 func (*SchedulingSpec) ProtoMessage()    {}
 func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{44}
+	return fileDescriptor_beade573c128ccc7, []int{49}
 }
 func (m *SchedulingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4263,6 +4776,135 @@ func (m *SchedulingSpec) GetPriorityClassName() string {
 	return ""
 }
 
+// PipelineTemplate describes the template and arguments that a pipeline spec
+// was rendered from.
+type PipelineTemplate struct {
+	// source is the path or URL of the template.
+	Source               string            `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Args                 map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PipelineTemplate) Reset()         { *m = PipelineTemplate{} }
+func (m *PipelineTemplate) String() string { return proto.CompactTextString(m) }
+func (*PipelineTemplate) ProtoMessage()    {}
+func (*PipelineTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{50}
+}
+func (m *PipelineTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PipelineTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineTemplate.Merge(m, src)
+}
+func (m *PipelineTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineTemplate proto.InternalMessageInfo
+
+func (m *PipelineTemplate) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PipelineTemplate) GetArgs() map[string]string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+// JobRetries specifies how a job whose datums fail is retried before it's
+// failed.
+type JobRetries struct {
+	// count is the number of times a job's failed datums are retried.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// backoff is the time to wait before the first retry, it's doubled for each
+	// retry after that. It defaults to one minute.
+	Backoff *types.Duration `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// retryable_reasons are regular expressions matched against the failure
+	// reasons of the failed datums. A job is only retried if every failed
+	// datum matches one of them. If empty, every failure is retryable.
+	RetryableReasons     []string `protobuf:"bytes,3,rep,name=retryable_reasons,json=retryableReasons,proto3" json:"retryable_reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobRetries) Reset()         { *m = JobRetries{} }
+func (m *JobRetries) String() string { return proto.CompactTextString(m) }
+func (*JobRetries) ProtoMessage()    {}
+func (*JobRetries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{51}
+}
+func (m *JobRetries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRetries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRetries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRetries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRetries.Merge(m, src)
+}
+func (m *JobRetries) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRetries) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRetries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRetries proto.InternalMessageInfo
+
+func (m *JobRetries) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *JobRetries) GetBackoff() *types.Duration {
+	if m != nil {
+		return m.Backoff
+	}
+	return nil
+}
+
+func (m *JobRetries) GetRetryableReasons() []string {
+	if m != nil {
+		return m.RetryableReasons
+	}
+	return nil
+}
+
 type CreatePipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	// tf_job encodes a Kubeflow TFJob spec. Pachyderm uses this to create TFJobs
@@ -4289,32 +4931,48 @@ type CreatePipelineRequest struct {
 	EnableStats           bool          `protobuf:"varint,17,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
-	Standby              bool            `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,34,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,46,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ReprocessSpec        string          `protobuf:"bytes,49,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool            `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	MaxQueueSize   int64           `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service        *Service        `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout          `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec      *ChunkSpec      `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout   *types.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
+	Standby        bool            `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries     int64           `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,34,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,46,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ReprocessSpec  string          `protobuf:"bytes,49,opt,name=reprocess_spec,json=reprocessSpec,proto3" json:"reprocess_spec,omitempty"`
+	// propagate_labels lists the keys of the labels which are copied from the
+	// input commits of each job onto its output commit.
+	PropagateLabels []string `protobuf:"bytes,50,rep,name=propagate_labels,json=propagateLabels,proto3" json:"propagate_labels,omitempty"`
+	// datum_concurrency is the number of datums each worker processes in
+	// parallel. Each datum's inputs and output are in their own directories.
+	DatumConcurrency int64 `protobuf:"varint,51,opt,name=datum_concurrency,json=datumConcurrency,proto3" json:"datum_concurrency,omitempty"`
+	// prioritize_newest_jobs makes workers process the datums of newer jobs
+	// before those of older jobs that are still running, rather than in job
+	// creation order.
+	PrioritizeNewestJobs bool `protobuf:"varint,52,opt,name=prioritize_newest_jobs,json=prioritizeNewestJobs,proto3" json:"prioritize_newest_jobs,omitempty"`
+	// template records the template that the request was rendered from, if
+	// any. It's only informational.
+	Template *PipelineTemplate `protobuf:"bytes,53,opt,name=template,proto3" json:"template,omitempty"`
+	// job_retries, if set, retries the failed datums of a job in new attempts
+	// before the job is failed.
+	JobRetries           *JobRetries `protobuf:"bytes,54,opt,name=job_retries,json=jobRetries,proto3" json:"job_retries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{45}
+	return fileDescriptor_beade573c128ccc7, []int{52}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4560,23 +5218,58 @@ func (m *CreatePipelineRequest) GetReprocessSpec() string {
 	return ""
 }
 
-type InspectPipelineRequest struct {
-	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *CreatePipelineRequest) GetPropagateLabels() []string {
+	if m != nil {
+		return m.PropagateLabels
+	}
+	return nil
 }
 
-func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{} }
-func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
-func (*InspectPipelineRequest) ProtoMessage()    {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{46}
-}
-func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *CreatePipelineRequest) GetDatumConcurrency() int64 {
+	if m != nil {
+		return m.DatumConcurrency
+	}
+	return 0
 }
-func (m *InspectPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *CreatePipelineRequest) GetPrioritizeNewestJobs() bool {
+	if m != nil {
+		return m.PrioritizeNewestJobs
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetTemplate() *PipelineTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetJobRetries() *JobRetries {
+	if m != nil {
+		return m.JobRetries
+	}
+	return nil
+}
+
+type InspectPipelineRequest struct {
+	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{} }
+func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectPipelineRequest) ProtoMessage()    {}
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{53}
+}
+func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
 		return xxx_messageInfo_InspectPipelineRequest.Marshal(b, m, deterministic)
 	} else {
@@ -4633,7 +5326,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{47}
+	return fileDescriptor_beade573c128ccc7, []int{54}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4704,7 +5397,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{48}
+	return fileDescriptor_beade573c128ccc7, []int{55}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4772,7 +5465,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{49}
+	return fileDescriptor_beade573c128ccc7, []int{56}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4819,7 +5512,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{50}
+	return fileDescriptor_beade573c128ccc7, []int{57}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4855,6 +5548,74 @@ func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+type RollbackPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// version is the version of the pipeline to roll back to.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// reprocess, if true, gives the new version a new salt so that all datums
+	// are reprocessed. Otherwise, the new version uses the salt of the version
+	// that is rolled back to, so datums that the pipeline's last job
+	// successfully processed with that salt are skipped.
+	Reprocess            bool     `protobuf:"varint,3,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackPipelineRequest) Reset()         { *m = RollbackPipelineRequest{} }
+func (m *RollbackPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackPipelineRequest) ProtoMessage()    {}
+func (*RollbackPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_beade573c128ccc7, []int{58}
+}
+func (m *RollbackPipelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackPipelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackPipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPipelineRequest.Merge(m, src)
+}
+func (m *RollbackPipelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackPipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPipelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPipelineRequest proto.InternalMessageInfo
+
+func (m *RollbackPipelineRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *RollbackPipelineRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RollbackPipelineRequest) GetReprocess() bool {
+	if m != nil {
+		return m.Reprocess
+	}
+	return false
+}
+
 type RunPipelineRequest struct {
 	Pipeline             *Pipeline               `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	Provenance           []*pfs.CommitProvenance `protobuf:"bytes,2,rep,name=provenance,proto3" json:"provenance,omitempty"`
@@ -4868,7 +5629,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{51}
+	return fileDescriptor_beade573c128ccc7, []int{59}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4929,7 +5690,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{52}
+	return fileDescriptor_beade573c128ccc7, []int{60}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +5737,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{53}
+	return fileDescriptor_beade573c128ccc7, []int{61}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5023,7 +5784,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{54}
+	return fileDescriptor_beade573c128ccc7, []int{62}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5070,7 +5831,7 @@ func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{55}
+	return fileDescriptor_beade573c128ccc7, []int{63}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5117,7 +5878,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{56}
+	return fileDescriptor_beade573c128ccc7, []int{64}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5166,7 +5927,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{57}
+	return fileDescriptor_beade573c128ccc7, []int{65}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5227,7 +5988,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{58}
+	return fileDescriptor_beade573c128ccc7, []int{66}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5277,7 +6038,7 @@ func (m *GarbageCollectRequest) Reset()         { *m = GarbageCollectRequest{} }
 func (m *GarbageCollectRequest) String() string { return proto.CompactTextString(m) }
 func (*GarbageCollectRequest) ProtoMessage()    {}
 func (*GarbageCollectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{59}
+	return fileDescriptor_beade573c128ccc7, []int{67}
 }
 func (m *GarbageCollectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5323,7 +6084,7 @@ func (m *GarbageCollectResponse) Reset()         { *m = GarbageCollectResponse{}
 func (m *GarbageCollectResponse) String() string { return proto.CompactTextString(m) }
 func (*GarbageCollectResponse) ProtoMessage()    {}
 func (*GarbageCollectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{60}
+	return fileDescriptor_beade573c128ccc7, []int{68}
 }
 func (m *GarbageCollectResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5362,7 +6123,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{61}
+	return fileDescriptor_beade573c128ccc7, []int{69}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5401,7 +6162,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_beade573c128ccc7, []int{62}
+	return fileDescriptor_beade573c128ccc7, []int{70}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5450,6 +6211,8 @@ func init() {
 	proto.RegisterType((*PFSInput)(nil), "pps.PFSInput")
 	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
 	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
+	proto.RegisterType((*SQLInput)(nil), "pps.SQLInput")
+	proto.RegisterType((*BucketInput)(nil), "pps.BucketInput")
 	proto.RegisterType((*Input)(nil), "pps.Input")
 	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
 	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
@@ -5460,6 +6223,7 @@ func init() {
 	proto.RegisterType((*ProcessStats)(nil), "pps.ProcessStats")
 	proto.RegisterType((*AggregateProcessStats)(nil), "pps.AggregateProcessStats")
 	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
+	proto.RegisterType((*DatumStatus)(nil), "pps.DatumStatus")
 	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
 	proto.RegisterType((*GPUSpec)(nil), "pps.GPUSpec")
 	proto.RegisterType((*EtcdJobInfo)(nil), "pps.EtcdJobInfo")
@@ -5483,15 +6247,21 @@ func init() {
 	proto.RegisterType((*RestartDatumRequest)(nil), "pps.RestartDatumRequest")
 	proto.RegisterType((*InspectDatumRequest)(nil), "pps.InspectDatumRequest")
 	proto.RegisterType((*ListDatumRequest)(nil), "pps.ListDatumRequest")
+	proto.RegisterType((*FileLineageRequest)(nil), "pps.FileLineageRequest")
+	proto.RegisterType((*FileLineageInfo)(nil), "pps.FileLineageInfo")
 	proto.RegisterType((*ChunkSpec)(nil), "pps.ChunkSpec")
 	proto.RegisterType((*SchedulingSpec)(nil), "pps.SchedulingSpec")
 	proto.RegisterMapType((map[string]string)(nil), "pps.SchedulingSpec.NodeSelectorEntry")
+	proto.RegisterType((*PipelineTemplate)(nil), "pps.PipelineTemplate")
+	proto.RegisterMapType((map[string]string)(nil), "pps.PipelineTemplate.ArgsEntry")
+	proto.RegisterType((*JobRetries)(nil), "pps.JobRetries")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
 	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
 	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
 	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
 	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
 	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
+	proto.RegisterType((*RollbackPipelineRequest)(nil), "pps.RollbackPipelineRequest")
 	proto.RegisterType((*RunPipelineRequest)(nil), "pps.RunPipelineRequest")
 	proto.RegisterType((*RunCronRequest)(nil), "pps.RunCronRequest")
 	proto.RegisterType((*CreateSecretRequest)(nil), "pps.CreateSecretRequest")
@@ -5509,320 +6279,355 @@ func init() {
 func init() { proto.RegisterFile("pps/pps.proto", fileDescriptor_beade573c128ccc7) }
 
 var fileDescriptor_beade573c128ccc7 = []byte{
-	// 4996 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0x4b, 0x73, 0x1b, 0x49,
-	0x72, 0x16, 0x80, 0x06, 0xd0, 0x48, 0x3c, 0xd8, 0x2c, 0x3e, 0x04, 0x42, 0x12, 0x49, 0xb5, 0x1e,
-	0x23, 0x69, 0xb5, 0xa4, 0x44, 0xed, 0xcc, 0xee, 0x6a, 0xc6, 0x33, 0xc3, 0x97, 0x64, 0x62, 0x39,
-	0x12, 0xb7, 0x29, 0x8d, 0xc3, 0x3e, 0x18, 0xd1, 0x68, 0x14, 0xc0, 0x16, 0x1b, 0xdd, 0x3d, 0xfd,
-	0xa0, 0x86, 0x73, 0xf1, 0x5f, 0x70, 0xd8, 0x11, 0x3e, 0xf8, 0xe0, 0xb0, 0x4f, 0x3e, 0x39, 0xec,
-	0x93, 0x4f, 0x7b, 0xf1, 0xc9, 0x1b, 0xe1, 0x70, 0x84, 0x2f, 0xbe, 0x2a, 0x1c, 0x8a, 0x8d, 0xf0,
-	0x0f, 0xf0, 0xcd, 0x7b, 0x71, 0x54, 0x56, 0x75, 0xa3, 0x1b, 0x00, 0x01, 0x3e, 0x26, 0x7c, 0xab,
-	0xca, 0xcc, 0xaa, 0xae, 0xca, 0xca, 0xca, 0xfc, 0x32, 0x0b, 0x80, 0xaa, 0xeb, 0xfa, 0xeb, 0xae,
-	0xeb, 0xaf, 0xb9, 0x9e, 0x13, 0x38, 0x24, 0xe7, 0xba, 0x7e, 0xe3, 0x46, 0xcf, 0x71, 0x7a, 0x16,
-	0x5d, 0x47, 0x52, 0x3b, 0xec, 0xae, 0xd3, 0xbe, 0x1b, 0x9c, 0x72, 0x89, 0xc6, 0xca, 0x30, 0x33,
-	0x30, 0xfb, 0xd4, 0x0f, 0xf4, 0xbe, 0x2b, 0x04, 0x96, 0x87, 0x05, 0x3a, 0xa1, 0xa7, 0x07, 0xa6,
-	0x63, 0x0b, 0xfe, 0x7c, 0xcf, 0xe9, 0x39, 0xd8, 0x5c, 0x67, 0x2d, 0x41, 0xad, 0xba, 0x5d, 0x7f,
-	0xdd, 0xed, 0x8a, 0x75, 0xa8, 0xc7, 0x50, 0x3e, 0xa4, 0x86, 0x47, 0x83, 0x6f, 0x9c, 0xd0, 0x0e,
-	0x08, 0x01, 0xc9, 0xd6, 0xfb, 0xb4, 0x9e, 0x59, 0xcd, 0x3c, 0x28, 0x69, 0xd8, 0x26, 0x0a, 0xe4,
-	0x8e, 0xe9, 0x69, 0x5d, 0x42, 0x12, 0x6b, 0x92, 0x5b, 0x00, 0x7d, 0x26, 0xde, 0x72, 0xf5, 0xe0,
-	0xa8, 0x9e, 0x45, 0x46, 0x09, 0x29, 0x07, 0x7a, 0x70, 0x44, 0xae, 0x43, 0x91, 0xda, 0x27, 0xad,
-	0x13, 0xdd, 0xab, 0xe7, 0x90, 0x57, 0xa0, 0xf6, 0xc9, 0xb7, 0xba, 0xa7, 0xfe, 0x3e, 0x07, 0xa5,
-	0x37, 0x9e, 0x6e, 0xfb, 0x5d, 0xc7, 0xeb, 0x93, 0x79, 0xc8, 0x9b, 0x7d, 0xbd, 0x17, 0x7d, 0x8c,
-	0x77, 0xd8, 0xd7, 0x8c, 0x7e, 0xa7, 0x9e, 0x5d, 0xcd, 0xb1, 0xaf, 0x19, 0xfd, 0x0e, 0x4e, 0xe7,
-	0x79, 0x2d, 0x46, 0xad, 0x22, 0xb5, 0x40, 0x3d, 0x6f, 0xbb, 0xdf, 0x21, 0x0f, 0x21, 0x47, 0xed,
-	0x93, 0x7a, 0x6e, 0x35, 0xf7, 0xa0, 0xbc, 0x71, 0x7d, 0x8d, 0x29, 0x37, 0x9e, 0x7d, 0x6d, 0xd7,
-	0x3e, 0xd9, 0xb5, 0x03, 0xef, 0x54, 0x63, 0x32, 0xe4, 0x11, 0x14, 0x7d, 0xdc, 0xa6, 0x5f, 0x97,
-	0x50, 0x5c, 0x41, 0xf1, 0xc4, 0xd6, 0xb5, 0x48, 0x80, 0x3c, 0x06, 0x82, 0x4b, 0x69, 0xb9, 0xa1,
-	0x65, 0xb5, 0xa2, 0x61, 0x25, 0xfc, 0xb4, 0x82, 0x9c, 0x83, 0xd0, 0xb2, 0x0e, 0x85, 0xf4, 0x3c,
-	0xe4, 0xfd, 0xa0, 0x63, 0xda, 0xf5, 0x3c, 0x0a, 0xf0, 0x0e, 0xb9, 0x01, 0x25, 0xb6, 0x66, 0xce,
-	0xa9, 0x21, 0x47, 0xa6, 0x9e, 0x77, 0x88, 0xcc, 0xc7, 0x40, 0x74, 0xc3, 0xa0, 0x6e, 0xd0, 0xf2,
-	0x68, 0x10, 0x7a, 0x76, 0xcb, 0x70, 0x3a, 0xb4, 0x5e, 0x58, 0xcd, 0x3d, 0xc8, 0x69, 0x0a, 0xe7,
-	0x68, 0xc8, 0xd8, 0x76, 0x3a, 0x94, 0x7d, 0xa0, 0x43, 0xdb, 0x61, 0xaf, 0x5e, 0x5c, 0xcd, 0x3c,
-	0x90, 0x35, 0xde, 0x61, 0x07, 0x15, 0xfa, 0xd4, 0xab, 0x03, 0x3f, 0x28, 0xd6, 0x26, 0x2b, 0x50,
-	0x7e, 0xef, 0x78, 0xc7, 0xa6, 0xdd, 0x6b, 0x75, 0x4c, 0xaf, 0x5e, 0x46, 0x16, 0x08, 0xd2, 0x8e,
-	0xe9, 0x91, 0x65, 0x80, 0x8e, 0x63, 0x1c, 0x53, 0xaf, 0x6b, 0x5a, 0xb4, 0x5e, 0xe1, 0xfc, 0x01,
-	0x85, 0xdc, 0x85, 0x7c, 0x3b, 0x34, 0xad, 0x4e, 0x7d, 0x66, 0x35, 0xf3, 0xa0, 0xbc, 0x51, 0x43,
-	0x1d, 0x6d, 0x31, 0xca, 0xa1, 0x4b, 0x0d, 0x8d, 0x33, 0x1b, 0x9f, 0x81, 0x1c, 0x29, 0x37, 0xb2,
-	0x8d, 0xcc, 0xc0, 0x36, 0xe6, 0x21, 0x7f, 0xa2, 0x5b, 0x21, 0x15, 0x66, 0xc1, 0x3b, 0xcf, 0xb3,
-	0xbf, 0xc8, 0xa8, 0xbf, 0x86, 0x52, 0x3c, 0x17, 0x5b, 0x3f, 0x1a, 0x8f, 0x30, 0x34, 0xd6, 0x26,
-	0x0d, 0x90, 0x2d, 0xdd, 0xee, 0x85, 0xcc, 0x26, 0xf8, 0xe8, 0xb8, 0x3f, 0x30, 0x96, 0x5c, 0xc2,
-	0x58, 0xd4, 0x87, 0x90, 0x7f, 0xf3, 0xa2, 0xe9, 0xb4, 0xc9, 0x2a, 0x14, 0x82, 0x6e, 0xeb, 0x9d,
-	0xd3, 0xe6, 0x13, 0x6e, 0x95, 0x3e, 0x7e, 0x58, 0xe1, 0x2c, 0x2d, 0x1f, 0x74, 0x9b, 0x4e, 0x5b,
-	0x6d, 0x40, 0x61, 0xb7, 0xe7, 0x51, 0xdf, 0x67, 0x6b, 0x7e, 0xab, 0xed, 0x47, 0x6b, 0x7e, 0xab,
-	0xed, 0xab, 0xb7, 0x20, 0xc7, 0x26, 0x59, 0x84, 0xac, 0xd9, 0x11, 0x13, 0x14, 0x3e, 0x7e, 0x58,
-	0xc9, 0xee, 0xed, 0x68, 0x59, 0xb3, 0xa3, 0xfe, 0x6f, 0x06, 0xe4, 0x6f, 0x68, 0xa0, 0x77, 0xf4,
-	0x40, 0x27, 0x5f, 0x43, 0x59, 0xb7, 0x6d, 0x27, 0xc0, 0x9b, 0xe6, 0xd7, 0x33, 0x68, 0x4d, 0xcb,
-	0xa8, 0xa9, 0x48, 0x66, 0x6d, 0x73, 0x20, 0xc0, 0x6d, 0x30, 0x39, 0x84, 0x3c, 0x85, 0x82, 0xa5,
-	0xb7, 0xa9, 0xe5, 0xa3, 0x91, 0x97, 0x37, 0x96, 0xd2, 0x83, 0xf7, 0x91, 0xc7, 0xc7, 0x09, 0xc1,
-	0xc6, 0x97, 0xa0, 0x0c, 0xcf, 0x79, 0x11, 0xd5, 0x37, 0x7e, 0x09, 0xe5, 0xc4, 0xb4, 0x17, 0x3a,
-	0xb5, 0x3f, 0x83, 0xe2, 0x21, 0xf5, 0x4e, 0x4c, 0x83, 0x92, 0x3b, 0x50, 0x35, 0xed, 0x80, 0x7a,
-	0xb6, 0x6e, 0xb5, 0x5c, 0xc7, 0x0b, 0x70, 0x82, 0xbc, 0x56, 0x89, 0x88, 0x07, 0x8e, 0x17, 0x30,
-	0x21, 0xfa, 0x7d, 0x52, 0x28, 0xcb, 0x85, 0x22, 0x22, 0x0a, 0x31, 0x4d, 0xbb, 0xfc, 0x28, 0x85,
-	0xa6, 0x0f, 0xb4, 0xac, 0xe9, 0x32, 0xab, 0x08, 0x4e, 0x5d, 0x2a, 0x7c, 0x0d, 0xb6, 0xd5, 0x75,
-	0xc8, 0x1f, 0xba, 0x4e, 0x18, 0x90, 0xfb, 0xec, 0x0e, 0xe3, 0x4a, 0xf0, 0xc3, 0xe5, 0x8d, 0x8a,
-	0xb8, 0xc3, 0x48, 0xd3, 0x22, 0xa6, 0xfa, 0xcf, 0x59, 0x90, 0x0f, 0x5e, 0x1c, 0xee, 0xd9, 0x6e,
-	0x38, 0xde, 0xa1, 0x11, 0x90, 0x3c, 0xea, 0x3a, 0x62, 0xaf, 0xd8, 0x26, 0x8b, 0x50, 0x68, 0x7b,
-	0xba, 0x6d, 0x1c, 0x45, 0x2e, 0x8b, 0xf7, 0x18, 0xdd, 0x70, 0xfa, 0x7d, 0x33, 0x10, 0x6b, 0x12,
-	0x3d, 0x36, 0x47, 0xcf, 0x72, 0xda, 0xf5, 0x3c, 0x9f, 0x83, 0xb5, 0x99, 0xa3, 0x7a, 0xe7, 0x98,
-	0x76, 0xcb, 0xb1, 0xeb, 0x32, 0x17, 0x66, 0xdd, 0xd7, 0x36, 0xf3, 0x97, 0x4e, 0x18, 0x50, 0xaf,
-	0xc5, 0xfa, 0x78, 0xef, 0x64, 0xad, 0x84, 0x94, 0xa6, 0x63, 0xda, 0x64, 0x09, 0xe4, 0x9e, 0xe7,
-	0x84, 0x6e, 0xab, 0x7d, 0x2a, 0x2e, 0x6d, 0x11, 0xfb, 0x5b, 0xa7, 0xec, 0x33, 0x96, 0xfe, 0xc3,
-	0x69, 0xbd, 0x80, 0x63, 0xb0, 0xcd, 0xae, 0x39, 0xc6, 0x89, 0x16, 0xbb, 0xb3, 0xbe, 0x70, 0x0b,
-	0x80, 0xa4, 0x17, 0x8c, 0x42, 0x6a, 0x90, 0xf5, 0x9f, 0xd5, 0x4b, 0x48, 0xcf, 0xfa, 0xcf, 0x98,
-	0xe2, 0x02, 0xcf, 0xec, 0xf5, 0x84, 0xbb, 0x40, 0xc5, 0x75, 0x99, 0xaf, 0x44, 0x9a, 0x16, 0x31,
-	0xd5, 0x7f, 0xcc, 0x40, 0x69, 0xdb, 0x73, 0xec, 0x0b, 0x6b, 0x4e, 0x68, 0x28, 0x37, 0xac, 0x21,
-	0xdf, 0xa5, 0x46, 0x74, 0x96, 0xac, 0x4d, 0x6e, 0x42, 0xc9, 0x39, 0xa1, 0xde, 0x7b, 0xcf, 0x0c,
-	0xa8, 0xd8, 0xd3, 0x80, 0x40, 0x9e, 0x30, 0x57, 0xaa, 0x7b, 0x01, 0x2a, 0xb5, 0xbc, 0xd1, 0x58,
-	0xe3, 0x01, 0x6e, 0x2d, 0x0a, 0x70, 0x6b, 0x6f, 0xa2, 0x08, 0xa8, 0x71, 0x41, 0xd5, 0x04, 0xf9,
-	0xa5, 0x19, 0x9c, 0xbd, 0xde, 0x25, 0xc8, 0x85, 0x9e, 0xc5, 0x97, 0xbb, 0x55, 0xfc, 0xf8, 0x61,
-	0x85, 0x5d, 0x77, 0x8d, 0xd1, 0x2e, 0x7a, 0xe0, 0xea, 0xff, 0x64, 0x20, 0xcf, 0x3f, 0xb4, 0x02,
-	0x39, 0xb7, 0xeb, 0xe3, 0xf2, 0xcb, 0x1b, 0x55, 0xb4, 0xc1, 0xc8, 0xdc, 0x34, 0xc6, 0x21, 0xcb,
-	0x20, 0xe1, 0x41, 0x17, 0xf1, 0x7a, 0x03, 0x4a, 0x70, 0x36, 0xd2, 0xc9, 0x2a, 0xe4, 0xf1, 0x7c,
-	0xeb, 0xf2, 0x88, 0x00, 0x67, 0x30, 0x09, 0xc3, 0x73, 0xfc, 0xc8, 0x43, 0xa4, 0x24, 0x90, 0xc1,
-	0x24, 0x42, 0xdb, 0x74, 0x6c, 0x11, 0xfd, 0x52, 0x12, 0xc8, 0x20, 0x2a, 0x48, 0x86, 0xe7, 0xd8,
-	0xb8, 0x8d, 0xc8, 0x97, 0xc7, 0xa7, 0xab, 0x21, 0x8f, 0x6d, 0xa5, 0x67, 0x46, 0xfa, 0xe6, 0x5b,
-	0x89, 0xf4, 0xa9, 0x31, 0x8e, 0x7a, 0x0c, 0x72, 0xd3, 0x69, 0xa7, 0x15, 0x2c, 0x25, 0x14, 0x7c,
-	0x27, 0xd6, 0x16, 0xbf, 0x92, 0x65, 0xb4, 0xac, 0x6d, 0x24, 0x8d, 0xdc, 0x95, 0x6c, 0xe2, 0xae,
-	0x44, 0x86, 0x9d, 0x1b, 0x18, 0xb6, 0xfa, 0x16, 0x66, 0x0e, 0x74, 0x4f, 0xb7, 0x2c, 0x6a, 0x99,
-	0x7e, 0x1f, 0xc3, 0x44, 0x03, 0x64, 0xc3, 0xb1, 0xfd, 0x40, 0xb7, 0xb9, 0x23, 0x91, 0xb4, 0xb8,
-	0x4f, 0x56, 0xa1, 0x6c, 0x38, 0xb4, 0xdb, 0x35, 0x0d, 0x93, 0xda, 0xdc, 0xfa, 0x32, 0x5a, 0x92,
-	0xd4, 0x94, 0xe4, 0x8c, 0x92, 0x55, 0x9f, 0x41, 0x09, 0x37, 0xc0, 0x2e, 0x47, 0x1c, 0x77, 0xa4,
-	0x44, 0xdc, 0x21, 0x20, 0x1d, 0xe9, 0xfe, 0x11, 0xaa, 0xa1, 0xa2, 0x61, 0x5b, 0xfd, 0x1c, 0xf2,
-	0x3b, 0x7a, 0x10, 0xf6, 0xcf, 0x0a, 0x0a, 0xa4, 0x01, 0xb9, 0x77, 0x62, 0x4f, 0xe5, 0x0d, 0x19,
-	0x55, 0xc7, 0xa2, 0x0d, 0x23, 0xaa, 0xbf, 0xcd, 0x40, 0x09, 0x47, 0xef, 0xd9, 0x5d, 0x87, 0x1d,
-	0x55, 0x87, 0x75, 0x84, 0x8a, 0xf8, 0x51, 0x21, 0x5b, 0xe3, 0x0c, 0x72, 0x0f, 0x0d, 0x3f, 0xe0,
-	0xde, 0xb7, 0xb6, 0x31, 0x33, 0x90, 0x38, 0x64, 0x64, 0x8d, 0x73, 0xc9, 0x27, 0x5c, 0xcc, 0xc7,
-	0xad, 0x96, 0x37, 0x66, 0xb9, 0xe9, 0x79, 0x8e, 0x41, 0x7d, 0x9f, 0x09, 0xfa, 0x5c, 0xd0, 0x27,
-	0xf7, 0xa1, 0xe4, 0x76, 0xfd, 0x16, 0x9f, 0x93, 0x9f, 0x7f, 0x09, 0x0f, 0x86, 0xa9, 0x40, 0x93,
-	0xdd, 0x2e, 0x8a, 0x53, 0x72, 0x1b, 0x24, 0x16, 0x72, 0x10, 0xba, 0xe0, 0xf9, 0x0b, 0x11, 0xb6,
-	0x6c, 0x0d, 0x59, 0xea, 0x3f, 0x65, 0xa0, 0xb4, 0xd9, 0xeb, 0x79, 0xb4, 0xc7, 0x06, 0xcc, 0x43,
-	0xde, 0x60, 0x60, 0x09, 0xb7, 0x92, 0xd3, 0x78, 0x87, 0xe9, 0xaf, 0x4f, 0x75, 0x1b, 0x57, 0x9f,
-	0xd1, 0xb0, 0xcd, 0xae, 0x91, 0x1f, 0x74, 0x3a, 0xf4, 0x44, 0x9c, 0x8b, 0xe8, 0x91, 0x87, 0xa0,
-	0x74, 0xcd, 0x6e, 0x70, 0xd4, 0x72, 0xa9, 0x67, 0x50, 0x3b, 0x60, 0x40, 0x44, 0x42, 0x89, 0x19,
-	0xa4, 0x1f, 0xc4, 0x64, 0xf2, 0x19, 0x5c, 0xb7, 0x4d, 0x9b, 0xa2, 0xa3, 0x1b, 0x1a, 0x91, 0xc7,
-	0x11, 0x0b, 0x9c, 0xfd, 0x22, 0x3d, 0x4e, 0xfd, 0x8b, 0x2c, 0x54, 0x92, 0x5a, 0x21, 0x5f, 0x42,
-	0xb5, 0xe3, 0xbc, 0xb7, 0x2d, 0x47, 0xef, 0xb4, 0x18, 0x88, 0x16, 0x07, 0xb1, 0x34, 0xe2, 0x5f,
-	0x76, 0x04, 0x80, 0xd6, 0x2a, 0x91, 0x3c, 0xf3, 0x38, 0xe4, 0x0b, 0xa8, 0xb8, 0x7c, 0x3e, 0x3e,
-	0x3c, 0x3b, 0x6d, 0x78, 0x59, 0x88, 0xe3, 0xe8, 0xe7, 0x50, 0x0e, 0xdd, 0xc1, 0xb7, 0x73, 0xd3,
-	0x06, 0x03, 0x97, 0xc6, 0xb1, 0xf7, 0xa0, 0x16, 0xaf, 0xbc, 0x7d, 0x1a, 0x50, 0x1f, 0x75, 0x25,
-	0x69, 0xf1, 0x7e, 0xb6, 0x18, 0x91, 0xdc, 0x86, 0x8a, 0xf8, 0x04, 0x17, 0xca, 0xa3, 0x90, 0xf8,
-	0x2c, 0x8a, 0xa8, 0x7f, 0x9d, 0x85, 0x85, 0xf8, 0x1c, 0x53, 0xda, 0x79, 0x36, 0x5e, 0x3b, 0xdc,
-	0x61, 0xc4, 0x43, 0x86, 0x54, 0xf2, 0x74, 0xac, 0x4a, 0x86, 0xc7, 0xa4, 0xf4, 0xb0, 0x3e, 0x4e,
-	0x0f, 0xc3, 0x23, 0x92, 0x9b, 0xff, 0x74, 0xec, 0xe6, 0x47, 0xc7, 0x0c, 0x29, 0xe3, 0xe9, 0x18,
-	0x65, 0x8c, 0x59, 0x5a, 0x52, 0x39, 0xff, 0x96, 0x85, 0xca, 0x1f, 0x39, 0xde, 0x31, 0xf5, 0x98,
-	0x4a, 0x42, 0x9f, 0x3c, 0x84, 0xd2, 0x7b, 0xec, 0xb7, 0xe2, 0xbb, 0x5f, 0xf9, 0xf8, 0x61, 0x45,
-	0xe6, 0x42, 0x7b, 0x3b, 0x9a, 0xcc, 0xd9, 0x7b, 0x1d, 0x86, 0x3c, 0xdf, 0x39, 0x6d, 0x26, 0x97,
-	0x1d, 0x20, 0x4f, 0xe6, 0x33, 0x77, 0xb4, 0xfc, 0x3b, 0xa7, 0xbd, 0xd7, 0x61, 0x8e, 0x18, 0x6f,
-	0x19, 0xf7, 0xd4, 0xb5, 0x81, 0xa7, 0xc6, 0xdb, 0x88, 0x3c, 0xf2, 0x33, 0x28, 0x62, 0x44, 0xa3,
-	0x1d, 0xb1, 0xc9, 0x49, 0xc1, 0x2f, 0x12, 0x1d, 0x38, 0x84, 0xfc, 0x14, 0x87, 0x70, 0x0b, 0xe0,
-	0xbb, 0x90, 0x86, 0xb4, 0xe5, 0x9b, 0x3f, 0xf0, 0xc0, 0x9b, 0xd3, 0x4a, 0x48, 0x39, 0x34, 0x7f,
-	0xe0, 0x66, 0xa6, 0x07, 0x7a, 0x4b, 0x1c, 0x17, 0xed, 0x20, 0xa8, 0xc8, 0x69, 0x55, 0x46, 0x3d,
-	0x88, 0x88, 0xb1, 0x98, 0x47, 0x0d, 0x16, 0xb4, 0x69, 0x07, 0x61, 0x8e, 0x10, 0xd3, 0x22, 0xa2,
-	0xea, 0x41, 0x45, 0xa3, 0xbe, 0x13, 0x7a, 0x06, 0x45, 0x1f, 0xce, 0x32, 0x3a, 0x37, 0x44, 0x35,
-	0x66, 0x35, 0xd6, 0x64, 0xce, 0xa1, 0x4f, 0xfb, 0x8e, 0x77, 0x2a, 0x42, 0x82, 0xe8, 0x91, 0x65,
-	0xc8, 0xf5, 0xdc, 0x50, 0xec, 0x86, 0xa3, 0xbb, 0x97, 0x07, 0x6f, 0x31, 0xf7, 0x60, 0x0c, 0xe6,
-	0x68, 0x3a, 0xa6, 0x7f, 0x1c, 0x39, 0x6f, 0xd6, 0x6e, 0x4a, 0x72, 0x4e, 0x91, 0xd4, 0x4f, 0xa1,
-	0x28, 0x24, 0x63, 0x0c, 0x99, 0x19, 0x60, 0x48, 0xf6, 0x41, 0x3b, 0xec, 0xb7, 0xa9, 0x87, 0x1f,
-	0xcc, 0x69, 0xa2, 0xa7, 0xfe, 0xa7, 0x04, 0xe5, 0xdd, 0xc0, 0xe8, 0x60, 0x8c, 0xeb, 0x3a, 0x91,
-	0x53, 0xcf, 0x8c, 0x71, 0xea, 0xe4, 0x21, 0xc8, 0xae, 0xe9, 0x52, 0xcb, 0xb4, 0x23, 0x73, 0x17,
-	0xb1, 0x5f, 0x10, 0xb5, 0x98, 0x4d, 0x9e, 0x40, 0xd5, 0x09, 0x03, 0x37, 0x0c, 0x5a, 0x09, 0x64,
-	0x34, 0x14, 0x1c, 0x2b, 0x5c, 0x82, 0xf7, 0x48, 0x1d, 0x8a, 0x1e, 0xe5, 0xe0, 0x87, 0xdf, 0xf0,
-	0xa8, 0x3b, 0xe6, 0x6c, 0xf2, 0xe3, 0xce, 0xe6, 0x36, 0x54, 0x50, 0xcc, 0x3f, 0x36, 0x5d, 0x97,
-	0x76, 0xc4, 0x19, 0x97, 0x19, 0xed, 0x90, 0x93, 0x98, 0x11, 0xa0, 0x48, 0xe0, 0x04, 0xba, 0x25,
-	0x4e, 0xb8, 0xc4, 0x28, 0x6f, 0x18, 0x81, 0xc1, 0x4a, 0x64, 0x77, 0x75, 0xd3, 0x8a, 0x8f, 0x16,
-	0x47, 0xbc, 0x40, 0xca, 0x98, 0xe3, 0x9f, 0x19, 0x73, 0xfc, 0x03, 0xa3, 0x2c, 0x4d, 0x31, 0xca,
-	0x35, 0xa8, 0x60, 0x23, 0x52, 0x12, 0x8c, 0x2a, 0xa9, 0x8c, 0x02, 0x42, 0x47, 0x77, 0xa2, 0x28,
-	0x59, 0xc6, 0x28, 0x59, 0x8d, 0x8e, 0x27, 0x15, 0x23, 0x17, 0xa1, 0xe0, 0x51, 0xdd, 0x77, 0x6c,
-	0x91, 0xde, 0x8a, 0x5e, 0xf2, 0x82, 0x55, 0xcf, 0x7f, 0xc1, 0x3e, 0x03, 0xb9, 0x6b, 0xda, 0xa6,
-	0x7f, 0x44, 0x3b, 0xf5, 0xda, 0xd4, 0x61, 0xb1, 0xac, 0xfa, 0xbb, 0x2a, 0x14, 0xcf, 0x63, 0x53,
-	0x8f, 0xa1, 0x14, 0x44, 0x15, 0x8b, 0x94, 0x0f, 0x8d, 0xeb, 0x18, 0xda, 0x40, 0x20, 0x65, 0x81,
-	0xb9, 0xc9, 0x16, 0xf8, 0x10, 0x94, 0xa8, 0xdd, 0x3a, 0xa1, 0x9e, 0xcf, 0x90, 0x62, 0x15, 0x0d,
-	0x6b, 0x26, 0xa2, 0x7f, 0xcb, 0xc9, 0xe4, 0x31, 0x94, 0x19, 0x36, 0x8f, 0x4e, 0x61, 0x7d, 0xf4,
-	0x14, 0x80, 0xf1, 0xc5, 0x21, 0x7c, 0x05, 0x8a, 0x3b, 0xc0, 0x68, 0x2d, 0x44, 0xf8, 0x15, 0x1c,
-	0x32, 0xcf, 0xd7, 0x92, 0x06, 0x70, 0xda, 0x8c, 0x3b, 0x84, 0xe8, 0xee, 0x40, 0x81, 0x62, 0x1e,
-	0x2e, 0x8a, 0x0c, 0x65, 0x1c, 0xc6, 0x53, 0x73, 0x4d, 0xb0, 0xc8, 0x27, 0x00, 0xae, 0xee, 0x51,
-	0x3b, 0xc0, 0x94, 0xbe, 0x30, 0xa4, 0xba, 0x12, 0xe7, 0xb1, 0x94, 0x3d, 0x71, 0xac, 0xc5, 0xcb,
-	0x1d, 0xab, 0x7c, 0xfe, 0x63, 0x1d, 0xbd, 0xd7, 0xa5, 0x69, 0xf7, 0x3a, 0xb6, 0x59, 0x38, 0x97,
-	0xcd, 0xde, 0x49, 0xd9, 0x6c, 0x22, 0xe1, 0xad, 0x4d, 0x48, 0x78, 0x19, 0xc0, 0xf4, 0x59, 0x86,
-	0x5c, 0xff, 0x69, 0x02, 0x60, 0x62, 0xce, 0xac, 0x71, 0x06, 0x79, 0x04, 0x65, 0xb1, 0x70, 0x4c,
-	0xdf, 0x48, 0x02, 0x12, 0x6a, 0xd4, 0x75, 0x34, 0xe0, 0x5c, 0xd6, 0x66, 0x09, 0xbc, 0x90, 0x15,
-	0xf9, 0xd1, 0x2c, 0x2e, 0x4a, 0xec, 0x6b, 0x8b, 0x67, 0x49, 0x09, 0x7f, 0x35, 0x3f, 0xcd, 0x5f,
-	0x2d, 0x9e, 0xc7, 0x5f, 0x2d, 0x8f, 0xfa, 0xab, 0x21, 0x87, 0xf4, 0xe0, 0x1c, 0x0e, 0x69, 0x6d,
-	0x9c, 0x43, 0x4a, 0xfb, 0xbd, 0xeb, 0xc3, 0x7e, 0x2f, 0xf6, 0x57, 0x2b, 0x53, 0xfc, 0xd5, 0x67,
-	0x50, 0x15, 0xa0, 0xc0, 0x47, 0x94, 0x50, 0xaf, 0x63, 0x40, 0xe7, 0x03, 0x92, 0xf0, 0x41, 0xab,
-	0xbc, 0x4f, 0x82, 0x89, 0x2f, 0x61, 0xd6, 0x13, 0xf1, 0xb0, 0xe5, 0xd1, 0xef, 0x42, 0xea, 0x07,
-	0x7e, 0x7d, 0x29, 0xf1, 0xb1, 0x64, 0xb4, 0xd4, 0x94, 0x48, 0x56, 0x13, 0xa2, 0xe4, 0x39, 0xcc,
-	0xc4, 0xe3, 0x2d, 0xb3, 0x6f, 0x06, 0x7e, 0xfd, 0xee, 0x59, 0xa3, 0x6b, 0x91, 0xe4, 0x3e, 0x0a,
-	0x92, 0x3d, 0xb8, 0xee, 0x9b, 0x1d, 0x6a, 0xe8, 0x5e, 0x6b, 0x78, 0x8e, 0x27, 0x67, 0xcd, 0xb1,
-	0x20, 0x46, 0x68, 0xe9, 0xa9, 0x56, 0x21, 0x6f, 0x32, 0xd4, 0x52, 0x6f, 0x24, 0xac, 0x4c, 0x64,
-	0x9c, 0xc8, 0x20, 0x6b, 0x00, 0x36, 0x7d, 0x1f, 0x99, 0xcd, 0x0d, 0x14, 0x9b, 0x41, 0x23, 0xe3,
-	0x56, 0x83, 0x69, 0x45, 0xc9, 0xa6, 0xef, 0x85, 0x11, 0x0d, 0x07, 0x80, 0x5b, 0x53, 0x02, 0xc0,
-	0x6d, 0xa8, 0x50, 0x5b, 0x6f, 0x5b, 0xb4, 0xc5, 0x0f, 0x6c, 0x15, 0x73, 0xc7, 0x32, 0xa7, 0x71,
-	0x30, 0x4b, 0x40, 0xf2, 0x75, 0x2b, 0xa8, 0xdf, 0x16, 0x45, 0x07, 0xdd, 0x0a, 0xc8, 0x4f, 0x01,
-	0x8c, 0xa3, 0xd0, 0x3e, 0xe6, 0xce, 0xea, 0x5e, 0x32, 0x1d, 0x66, 0x64, 0xdc, 0x73, 0xc9, 0x88,
-	0x9a, 0x98, 0x2d, 0xb0, 0xd4, 0x0b, 0x61, 0x2a, 0xbb, 0x55, 0xf7, 0xa7, 0x67, 0x0b, 0x4c, 0xfe,
-	0x0d, 0x17, 0x67, 0x78, 0x9f, 0x01, 0xc2, 0x68, 0xf4, 0x27, 0x53, 0xf1, 0xfe, 0x3b, 0xa7, 0x1d,
-	0x8d, 0xe5, 0x26, 0xcf, 0xbe, 0xed, 0x99, 0xd4, 0xaf, 0x3f, 0x8c, 0x4d, 0x3e, 0xec, 0xbf, 0x61,
-	0x14, 0xf2, 0x05, 0xcc, 0xf8, 0xc6, 0x11, 0xed, 0x84, 0x96, 0x69, 0xf7, 0xf8, 0x86, 0x1e, 0xe1,
-	0x07, 0xe6, 0xf8, 0xa5, 0x8f, 0x79, 0xdc, 0x1a, 0xfc, 0x54, 0x9f, 0x2c, 0x81, 0xec, 0x3a, 0x1d,
-	0x3e, 0xec, 0x27, 0xbc, 0xd0, 0xe4, 0x3a, 0xbc, 0x1e, 0x7b, 0x03, 0x4a, 0x8c, 0xe5, 0xea, 0x81,
-	0x71, 0x54, 0x7f, 0xcc, 0x8b, 0xaf, 0xae, 0xd3, 0x39, 0x60, 0xfd, 0xa6, 0x24, 0x4b, 0x4a, 0xbe,
-	0x29, 0xc9, 0x79, 0xa5, 0xd0, 0x94, 0xe4, 0x9b, 0xca, 0xad, 0xa6, 0x24, 0xab, 0xca, 0x1d, 0x75,
-	0x07, 0x0a, 0xdc, 0xee, 0xc7, 0x16, 0x5f, 0xee, 0xa7, 0xb3, 0x5a, 0x65, 0xe8, 0x9e, 0x44, 0xee,
-	0x4f, 0x5d, 0x06, 0x39, 0x8a, 0x60, 0xe3, 0xe6, 0x51, 0x7f, 0x9f, 0x05, 0x85, 0x81, 0xb4, 0x48,
-	0x08, 0xa3, 0xea, 0x83, 0x68, 0xf2, 0x0c, 0x4e, 0x4e, 0x52, 0x81, 0xf0, 0x0c, 0xef, 0x2a, 0xa5,
-	0xbc, 0xeb, 0x50, 0xdc, 0xcb, 0x4e, 0x8e, 0x7b, 0xdb, 0xc0, 0xce, 0xa9, 0x85, 0x09, 0xaf, 0x2f,
-	0xa0, 0xfc, 0x5d, 0x1e, 0xba, 0x86, 0x96, 0xc6, 0xdc, 0xfb, 0x36, 0x8a, 0xf1, 0x1a, 0x6e, 0xe9,
-	0x5d, 0xd4, 0x67, 0x9e, 0x48, 0x0f, 0x83, 0xa3, 0x56, 0xe0, 0x1c, 0x53, 0x5b, 0x94, 0x0e, 0x4b,
-	0x8c, 0xf2, 0x86, 0x11, 0xc8, 0x33, 0xa8, 0x59, 0xba, 0x8f, 0x31, 0x4f, 0xe4, 0xee, 0x85, 0x71,
-	0x51, 0xa3, 0xc2, 0x84, 0xa2, 0x1e, 0x59, 0x85, 0x72, 0x22, 0xc4, 0x62, 0x14, 0x94, 0xb4, 0x24,
-	0xa9, 0xf1, 0x05, 0xd4, 0xd2, 0x4b, 0x4a, 0xd6, 0x7f, 0xf3, 0x63, 0xea, 0xbf, 0xf9, 0x64, 0xfd,
-	0xf7, 0xef, 0x6b, 0x50, 0x49, 0x69, 0x3e, 0x89, 0x42, 0x32, 0x93, 0x51, 0x48, 0x1d, 0x8a, 0x11,
-	0xf8, 0x28, 0xf3, 0x28, 0x71, 0x12, 0x83, 0x8e, 0x8b, 0x00, 0x9f, 0xc7, 0x71, 0x75, 0x7f, 0x2d,
-	0xe1, 0x7b, 0xb0, 0xbc, 0x3f, 0x5a, 0xe9, 0x1f, 0x0b, 0x51, 0xe0, 0x47, 0x87, 0x28, 0xbf, 0x04,
-	0x30, 0x3c, 0xaa, 0x07, 0xb4, 0xd3, 0xd2, 0x03, 0x01, 0x51, 0x26, 0xa1, 0x88, 0x92, 0x90, 0xde,
-	0x0c, 0x06, 0xb6, 0x5b, 0x9c, 0x66, 0xbb, 0x75, 0x06, 0x6f, 0x1c, 0x0c, 0x90, 0xf7, 0xd1, 0xd9,
-	0x45, 0x5d, 0xe6, 0x0b, 0x3d, 0x6a, 0x30, 0x84, 0x44, 0x3d, 0xcf, 0xf1, 0x44, 0xc1, 0xb9, 0xcc,
-	0x69, 0xbb, 0x8c, 0x44, 0x7e, 0x02, 0xb3, 0x3c, 0x0e, 0xf9, 0x51, 0xd8, 0xa1, 0x9d, 0xfa, 0x53,
-	0x74, 0x29, 0x8a, 0x60, 0x68, 0x11, 0x3d, 0x29, 0xac, 0x9f, 0xe8, 0xa6, 0xc5, 0x5c, 0x6a, 0x7d,
-	0x23, 0x25, 0xbc, 0x19, 0xd1, 0xc9, 0x57, 0xa9, 0xcb, 0x50, 0xc2, 0xcb, 0xb0, 0x9a, 0xda, 0xc5,
-	0x94, 0x8b, 0x30, 0x6a, 0xe9, 0x3f, 0x99, 0x6e, 0xe9, 0x23, 0xc0, 0x44, 0x19, 0x03, 0x4c, 0xc6,
-	0x06, 0xdb, 0xb9, 0x2b, 0x05, 0xdb, 0x95, 0x1f, 0x21, 0xd8, 0x3e, 0xbb, 0x6c, 0xb0, 0x9d, 0x3f,
-	0x2b, 0xd8, 0xae, 0x42, 0xb9, 0x43, 0x7d, 0xc3, 0x33, 0x5d, 0x16, 0x45, 0xea, 0x0b, 0xfc, 0xfc,
-	0x13, 0x24, 0xe6, 0x6d, 0x0c, 0xdd, 0x38, 0x12, 0x49, 0xff, 0x75, 0xee, 0x6d, 0x90, 0x82, 0x49,
-	0xff, 0x70, 0x34, 0xad, 0x9f, 0x1d, 0x4d, 0x97, 0x12, 0xd1, 0x74, 0xe0, 0x4e, 0x6f, 0xa6, 0xdc,
-	0xe9, 0x5d, 0xa8, 0xf5, 0xf5, 0xef, 0x5b, 0x89, 0x32, 0xc3, 0x2d, 0xb4, 0x9e, 0x4a, 0x5f, 0xff,
-	0xfe, 0xd7, 0x71, 0xa5, 0x21, 0x01, 0x69, 0x97, 0xaf, 0x06, 0x69, 0xd3, 0x51, 0x7d, 0xf5, 0xc2,
-	0x51, 0xfd, 0xf6, 0x95, 0xa2, 0xba, 0x7a, 0x91, 0xa8, 0xbe, 0x0e, 0xe5, 0x9e, 0x19, 0x1c, 0x39,
-	0xce, 0x71, 0x2b, 0xf4, 0x2c, 0x0e, 0xf2, 0xb7, 0x6a, 0x1f, 0x3f, 0xac, 0xc0, 0x4b, 0x4e, 0x7e,
-	0xab, 0xed, 0x6b, 0x20, 0x44, 0xde, 0x7a, 0xd6, 0x70, 0x68, 0xba, 0x3b, 0x39, 0x34, 0xa1, 0x93,
-	0xd0, 0xed, 0x4e, 0xfb, 0x14, 0xc1, 0x0d, 0x3a, 0x09, 0xec, 0x0e, 0xc3, 0x89, 0x4f, 0xce, 0x03,
-	0x27, 0x1e, 0x5c, 0x0e, 0x4e, 0x3c, 0x3c, 0x3f, 0x9c, 0x20, 0x0b, 0x50, 0xf0, 0x9f, 0xb5, 0x98,
-	0x1a, 0xd7, 0xf9, 0x93, 0xb6, 0xff, 0xec, 0x75, 0x18, 0xb0, 0xc0, 0xd2, 0x17, 0x8f, 0xa0, 0x02,
-	0x9c, 0x56, 0x53, 0x2f, 0xa3, 0x5a, 0xcc, 0x66, 0xc8, 0xdf, 0xa3, 0x51, 0x01, 0x12, 0xbf, 0xff,
-	0x29, 0x7e, 0xa3, 0x1a, 0x53, 0xd9, 0x2a, 0xae, 0x16, 0xf9, 0x78, 0x65, 0x29, 0xc6, 0x3e, 0x8b,
-	0xca, 0xf5, 0xa6, 0x24, 0x37, 0x94, 0x1b, 0x4d, 0x49, 0xbe, 0xa1, 0xdc, 0x6c, 0x4a, 0x32, 0x51,
-	0xe6, 0x9a, 0x92, 0xfc, 0x33, 0xe5, 0xd3, 0xa6, 0x24, 0xcf, 0x2a, 0x44, 0x7d, 0x09, 0xd5, 0xa4,
-	0xfb, 0xc3, 0x84, 0x21, 0x4e, 0xc2, 0x4d, 0xbb, 0xeb, 0x88, 0xc7, 0xe2, 0xd9, 0x11, 0x4f, 0xa9,
-	0x55, 0xdc, 0x44, 0x4f, 0xfd, 0x4d, 0x1e, 0x94, 0x6d, 0x8c, 0x16, 0x2c, 0xaa, 0x71, 0xcf, 0x74,
-	0xa5, 0xf2, 0xd3, 0xd2, 0x05, 0xca, 0x4f, 0x8d, 0x69, 0xe9, 0xdc, 0x8d, 0xf3, 0xa4, 0x73, 0x37,
-	0xa7, 0x95, 0x9f, 0x6e, 0x4d, 0x29, 0x3f, 0x2d, 0x9f, 0x23, 0xdb, 0x5b, 0x99, 0x58, 0x7e, 0x5a,
-	0xbd, 0x60, 0xf9, 0xe9, 0xf6, 0x79, 0xcb, 0x4f, 0xea, 0x25, 0x52, 0xf9, 0x44, 0x9d, 0xe2, 0xee,
-	0xe5, 0xea, 0x14, 0xf7, 0xce, 0x5f, 0xa7, 0x18, 0xb2, 0xdc, 0x8c, 0x92, 0x6d, 0x4a, 0x32, 0x28,
-	0xe5, 0xa6, 0x24, 0x17, 0x15, 0xb9, 0x29, 0xc9, 0x25, 0x05, 0x9a, 0x92, 0x2c, 0x2b, 0xa5, 0xa6,
-	0x24, 0x57, 0x94, 0x6a, 0x53, 0x92, 0xcb, 0x4a, 0xa5, 0x29, 0xc9, 0x55, 0xa5, 0xd6, 0x94, 0xe4,
-	0x9a, 0x32, 0xd3, 0x94, 0xe4, 0x05, 0x65, 0xb1, 0x29, 0xc9, 0x33, 0x8a, 0xd2, 0x94, 0x64, 0x45,
-	0x99, 0xe5, 0x36, 0x1e, 0x5b, 0xfd, 0x9c, 0x32, 0xdf, 0x94, 0xe4, 0x79, 0x65, 0x21, 0xbe, 0x19,
-	0xd7, 0x95, 0x7a, 0x53, 0x92, 0xeb, 0xca, 0x92, 0xfa, 0x57, 0x19, 0x98, 0xdd, 0xb3, 0xd9, 0xad,
-	0x0c, 0x12, 0xf6, 0x3b, 0xa9, 0x0c, 0x76, 0xf1, 0x7a, 0xe9, 0x0a, 0x94, 0xdb, 0x96, 0x63, 0x1c,
-	0xb7, 0x06, 0x19, 0x86, 0xac, 0x01, 0x92, 0x38, 0x58, 0x20, 0x20, 0x75, 0x43, 0xcb, 0x42, 0xcc,
-	0x2f, 0x6b, 0xd8, 0x56, 0xff, 0x3b, 0x03, 0xb5, 0x7d, 0xd3, 0x0f, 0xce, 0xb8, 0x55, 0x53, 0xc0,
-	0xec, 0x1a, 0x54, 0x30, 0xf2, 0x0e, 0x12, 0x86, 0xdc, 0x88, 0xbd, 0xa0, 0x80, 0x58, 0xe2, 0xa5,
-	0x8a, 0xc0, 0x47, 0xa6, 0x1f, 0x38, 0x1e, 0xff, 0xb1, 0x55, 0x4e, 0x8b, 0xba, 0xf1, 0x6e, 0xf2,
-	0x83, 0xdd, 0x90, 0x06, 0xc8, 0xef, 0xbe, 0x7b, 0x61, 0x5a, 0x01, 0xf5, 0x10, 0x7e, 0x96, 0xb4,
-	0xb8, 0xaf, 0xbe, 0x83, 0x99, 0x17, 0x56, 0xe8, 0x1f, 0x25, 0x76, 0x7a, 0x0f, 0x8a, 0x7c, 0x1d,
-	0xd1, 0x6f, 0x56, 0x52, 0x0b, 0x89, 0x78, 0xe4, 0x09, 0x54, 0x02, 0xa7, 0x15, 0x6d, 0x3a, 0x7a,
-	0x80, 0x1e, 0x52, 0x4a, 0x39, 0x70, 0xa2, 0xb6, 0xaf, 0xae, 0x81, 0xb2, 0x43, 0x2d, 0x9a, 0x72,
-	0x56, 0x13, 0x0e, 0x5b, 0xfd, 0x53, 0xa8, 0x1d, 0x06, 0x8e, 0x7b, 0x59, 0xd3, 0xc8, 0x4e, 0xd1,
-	0xa2, 0xfa, 0xbb, 0x2c, 0x2c, 0xbc, 0x75, 0x3b, 0xdc, 0x7b, 0xf2, 0xcb, 0x79, 0x8e, 0xef, 0xdc,
-	0x49, 0x27, 0xab, 0xd3, 0x6e, 0x77, 0x2e, 0x75, 0xbb, 0xff, 0x3f, 0xaa, 0xf7, 0x43, 0xfe, 0xb1,
-	0x78, 0x0e, 0xff, 0x28, 0x4f, 0xaf, 0x86, 0x95, 0xce, 0xac, 0x86, 0xc1, 0x64, 0xf7, 0xa9, 0xfe,
-	0x4b, 0x16, 0x6a, 0x2f, 0x69, 0xb0, 0xef, 0xf4, 0xfc, 0x4b, 0x84, 0xa8, 0x49, 0x47, 0x11, 0x29,
-	0xa3, 0x8b, 0xb6, 0xcc, 0x93, 0xed, 0x12, 0x57, 0x06, 0x37, 0x6f, 0x7f, 0xf0, 0xa4, 0x5e, 0x38,
-	0xeb, 0x49, 0x7d, 0x11, 0x0a, 0x7d, 0xdd, 0x67, 0x77, 0x83, 0xdf, 0x19, 0xd1, 0x63, 0xf4, 0xae,
-	0x63, 0x59, 0xce, 0x7b, 0xf1, 0xbb, 0x19, 0xd1, 0xc3, 0x57, 0x23, 0xdd, 0xb4, 0x84, 0xce, 0xb0,
-	0x4d, 0x1e, 0x80, 0x12, 0xfa, 0xb4, 0x65, 0x39, 0xc7, 0x66, 0xab, 0xad, 0x1b, 0xc7, 0xd4, 0xee,
-	0x88, 0x5f, 0xd5, 0xd4, 0x42, 0x9f, 0xee, 0x3b, 0xc7, 0xe6, 0x16, 0xa7, 0x92, 0x75, 0xc8, 0xfb,
-	0xa6, 0x6d, 0x50, 0xa1, 0xb5, 0x09, 0xb8, 0x90, 0xcb, 0x71, 0xdf, 0xac, 0xfe, 0x26, 0x0b, 0xb0,
-	0xef, 0xf4, 0xbe, 0xa1, 0xbe, 0xaf, 0xf7, 0x30, 0xc1, 0x89, 0xf1, 0x42, 0xa2, 0x0a, 0x12, 0x83,
-	0x83, 0x57, 0x7a, 0x9f, 0x26, 0xde, 0x1b, 0x73, 0x67, 0xbc, 0x37, 0xa6, 0x1e, 0x2f, 0x8b, 0x13,
-	0x1f, 0x2f, 0xef, 0x83, 0xcc, 0x01, 0xa2, 0xc9, 0x77, 0x56, 0xda, 0x2a, 0x7f, 0xfc, 0xb0, 0x52,
-	0xe4, 0xbf, 0x5d, 0xd8, 0xd1, 0x8a, 0xc8, 0xdc, 0xeb, 0x24, 0xb4, 0x09, 0x29, 0x6d, 0x46, 0x4f,
-	0x9b, 0xd2, 0x84, 0xa7, 0xcd, 0xe8, 0x97, 0x8a, 0x32, 0xf7, 0x5d, 0xf8, 0x4b, 0xc5, 0x47, 0x90,
-	0x8d, 0x5f, 0x2d, 0x27, 0x85, 0xb4, 0x6c, 0xe0, 0xb3, 0xcb, 0xd5, 0xe7, 0x0a, 0x12, 0x6e, 0x2e,
-	0xea, 0xaa, 0x6f, 0x60, 0x4e, 0xe3, 0xf7, 0x8c, 0x1f, 0xfd, 0x39, 0xae, 0xf9, 0xb0, 0x6d, 0x65,
-	0x47, 0x6c, 0x4b, 0xfd, 0x39, 0xcc, 0x89, 0xe8, 0x95, 0x9a, 0x75, 0xea, 0xaf, 0x38, 0x98, 0x23,
-	0x64, 0xd1, 0xe5, 0xbc, 0x6b, 0x51, 0xb7, 0xa0, 0x14, 0xa7, 0x2a, 0x89, 0x17, 0xca, 0x4c, 0xf2,
-	0x85, 0x92, 0x5d, 0x57, 0x96, 0x4c, 0x89, 0xb7, 0x6c, 0xfe, 0x7a, 0x59, 0x62, 0x14, 0xfe, 0x72,
-	0xfd, 0xef, 0x19, 0xa8, 0xa5, 0x51, 0x3a, 0x69, 0x42, 0xd5, 0x76, 0x3a, 0xb4, 0xe5, 0x53, 0x8b,
-	0x1a, 0x81, 0xe3, 0x09, 0x77, 0x7f, 0x6f, 0x0c, 0xa2, 0x5f, 0x7b, 0xe5, 0x74, 0xe8, 0xa1, 0x90,
-	0xe3, 0x49, 0x7a, 0xc5, 0x4e, 0x90, 0xc8, 0x1a, 0xcc, 0xb9, 0x9e, 0xe9, 0x78, 0x66, 0x70, 0xda,
-	0x32, 0x2c, 0xdd, 0xf7, 0xb9, 0x5d, 0xf2, 0x57, 0xdb, 0xd9, 0x88, 0xb5, 0xcd, 0x38, 0xcc, 0x38,
-	0x1b, 0x5f, 0xc1, 0xec, 0xc8, 0x94, 0x17, 0xfa, 0xb5, 0xe1, 0xbf, 0x02, 0x2c, 0x70, 0xe8, 0x1b,
-	0x3b, 0x8d, 0x8b, 0x47, 0xea, 0x41, 0xb9, 0xe8, 0xce, 0x39, 0xca, 0x45, 0x17, 0x2b, 0x45, 0x8d,
-	0x2b, 0x2e, 0x15, 0x2f, 0x57, 0x5c, 0x2a, 0x9d, 0x5d, 0x5c, 0x5a, 0x84, 0x42, 0x88, 0x21, 0x2c,
-	0xf2, 0x5e, 0xbc, 0x37, 0x5a, 0x02, 0x81, 0x31, 0x25, 0x90, 0x41, 0x7a, 0x75, 0x37, 0x99, 0x5e,
-	0x8d, 0xad, 0x8c, 0x54, 0xae, 0x54, 0x19, 0x59, 0xfc, 0x11, 0x2a, 0x23, 0xeb, 0x97, 0xad, 0x8c,
-	0x54, 0xcf, 0x59, 0x19, 0xa9, 0x4d, 0xab, 0x8c, 0x28, 0xd3, 0x2a, 0x23, 0xb3, 0xa3, 0x95, 0x91,
-	0x9b, 0x50, 0x8a, 0x53, 0x4d, 0x7c, 0x4e, 0x93, 0xb5, 0x01, 0x61, 0x4c, 0x2d, 0x64, 0x7e, 0x72,
-	0x2d, 0x64, 0xe1, 0x5c, 0xb5, 0x90, 0xdb, 0xe7, 0xab, 0x85, 0x5c, 0xbf, 0x70, 0x2d, 0xa4, 0x7e,
-	0xa5, 0x5a, 0xc8, 0xd2, 0x45, 0x6a, 0x21, 0x51, 0x49, 0xa9, 0x91, 0x28, 0x29, 0x25, 0x0a, 0x18,
-	0x37, 0x26, 0x16, 0x30, 0x6e, 0x9e, 0xa7, 0x80, 0x71, 0xeb, 0x72, 0x05, 0x8c, 0xe5, 0x09, 0x05,
-	0x8c, 0xd5, 0xa1, 0x02, 0xc6, 0x50, 0x7d, 0x46, 0x9d, 0x5c, 0x9f, 0x49, 0xd6, 0x35, 0xd6, 0x2e,
-	0x5a, 0xd7, 0x78, 0x3a, 0xa6, 0xae, 0x31, 0x94, 0xdf, 0xf1, 0xdc, 0x8d, 0x67, 0x6a, 0x3c, 0x2f,
-	0x7b, 0xa2, 0x3c, 0x55, 0xb7, 0x61, 0x51, 0x84, 0xb1, 0xcb, 0x7b, 0x52, 0xf5, 0xef, 0x32, 0x30,
-	0xc7, 0x62, 0xda, 0x15, 0x9c, 0x71, 0x22, 0xa9, 0xc9, 0xa6, 0x93, 0x9a, 0x87, 0xa0, 0xe8, 0x0c,
-	0x7b, 0xb5, 0x4c, 0xdb, 0x70, 0xfa, 0x2e, 0x4b, 0x21, 0xc4, 0xcf, 0x41, 0x67, 0x90, 0xbe, 0x17,
-	0x93, 0x53, 0xb9, 0x8e, 0x34, 0x94, 0xeb, 0xfc, 0x65, 0x06, 0x16, 0x78, 0x02, 0x72, 0x85, 0x55,
-	0x2a, 0x90, 0xd3, 0xe3, 0x6c, 0x91, 0x35, 0x59, 0x8c, 0xea, 0x3a, 0x9e, 0x11, 0x79, 0x60, 0xde,
-	0x61, 0x66, 0x71, 0x4c, 0xa9, 0xcb, 0x9f, 0xd1, 0xf9, 0x0f, 0x98, 0x65, 0x46, 0xd0, 0xa8, 0xeb,
-	0x34, 0x25, 0x39, 0xab, 0xe4, 0xc4, 0x0f, 0x92, 0x36, 0x61, 0xfe, 0x90, 0x21, 0x93, 0x2b, 0x28,
-	0xff, 0x6b, 0x98, 0x63, 0x89, 0xd2, 0x15, 0x66, 0xf8, 0x9b, 0x0c, 0x10, 0x2d, 0xb4, 0xaf, 0xa0,
-	0x97, 0x4f, 0x01, 0x5c, 0xcf, 0x39, 0xa1, 0xb6, 0xce, 0xd0, 0x2d, 0x4f, 0x06, 0x17, 0x12, 0x86,
-	0x7e, 0x10, 0x33, 0xb5, 0x84, 0x60, 0x02, 0xa4, 0x4a, 0xe3, 0x41, 0xaa, 0xd0, 0xd2, 0xe7, 0x50,
-	0xd3, 0x42, 0x7b, 0xdb, 0x73, 0xec, 0x4b, 0xec, 0xee, 0x21, 0xcc, 0x71, 0xa8, 0xc0, 0xff, 0x8a,
-	0x13, 0xcd, 0xc0, 0x72, 0x65, 0xd3, 0xe2, 0xa3, 0x2b, 0x1a, 0xb6, 0xd5, 0xe7, 0x30, 0xc7, 0x4d,
-	0x24, 0x2d, 0x7a, 0x07, 0x0a, 0xfc, 0xef, 0x3d, 0x83, 0x5f, 0x2f, 0xc7, 0x7f, 0x0a, 0xd2, 0x04,
-	0x4b, 0xfd, 0x1c, 0xe6, 0xc5, 0x45, 0xba, 0xc4, 0xe0, 0x9b, 0x50, 0xe0, 0x94, 0xb1, 0x2f, 0x9b,
-	0x7f, 0x9e, 0x01, 0xe0, 0x6c, 0x7c, 0x59, 0x3b, 0xcf, 0x8c, 0xf1, 0xcf, 0xdb, 0xb2, 0x89, 0x9f,
-	0xb7, 0xed, 0x01, 0xc1, 0xd7, 0x25, 0xd3, 0xb1, 0x5b, 0xf1, 0xbf, 0xc4, 0x44, 0xbd, 0x61, 0x12,
-	0xbc, 0x9e, 0x8d, 0x46, 0xc5, 0x24, 0xf5, 0xab, 0xe8, 0xff, 0x60, 0xbc, 0x84, 0xf9, 0x04, 0xca,
-	0xfc, 0xbb, 0xc9, 0x02, 0xe6, 0x4c, 0x62, 0x5d, 0x58, 0xbe, 0x04, 0x3f, 0x6e, 0xab, 0xcf, 0x61,
-	0xe1, 0xa5, 0xee, 0xb5, 0xf5, 0x1e, 0xdd, 0x76, 0x2c, 0x06, 0x03, 0x23, 0x7d, 0xdd, 0x86, 0x0a,
-	0xff, 0x99, 0x9f, 0xc0, 0xb2, 0x1c, 0xe7, 0x96, 0x39, 0x8d, 0xa3, 0xd9, 0x3a, 0x2c, 0x0e, 0x8f,
-	0xf5, 0x5d, 0xc7, 0xf6, 0xa9, 0xba, 0x00, 0x73, 0x9b, 0x46, 0x60, 0x9e, 0xe8, 0x01, 0xdd, 0x0c,
-	0x83, 0x23, 0x31, 0xa7, 0xba, 0x08, 0xf3, 0x69, 0x32, 0x17, 0x7f, 0xe4, 0xe1, 0xcf, 0xd6, 0x79,
-	0x25, 0x48, 0x81, 0x4a, 0xf3, 0xf5, 0x56, 0xeb, 0xf0, 0xcd, 0xa6, 0xf6, 0x66, 0xef, 0xd5, 0x4b,
-	0xe5, 0x1a, 0x99, 0x81, 0x32, 0xa3, 0x68, 0x6f, 0x5f, 0xbd, 0x62, 0x84, 0x4c, 0x44, 0x78, 0xb1,
-	0xb9, 0xb7, 0xff, 0x56, 0xdb, 0x55, 0xb2, 0x11, 0xe1, 0xf0, 0xed, 0xf6, 0xf6, 0xee, 0xe1, 0xa1,
-	0x92, 0x23, 0x35, 0x00, 0x46, 0xf8, 0xd5, 0xde, 0xfe, 0xfe, 0xee, 0x8e, 0x22, 0x91, 0x59, 0xa8,
-	0xb2, 0xfe, 0xee, 0x4b, 0x6d, 0xf7, 0xf0, 0x90, 0x4d, 0x52, 0x78, 0xf4, 0x1a, 0x60, 0xf0, 0x93,
-	0x6d, 0x02, 0x50, 0x60, 0xd3, 0xed, 0xee, 0x28, 0xd7, 0x48, 0x19, 0x8a, 0xd1, 0x4c, 0x19, 0xec,
-	0xfc, 0x6a, 0xef, 0xe0, 0x60, 0x77, 0x47, 0xc9, 0x92, 0x0a, 0xc8, 0xf1, 0xba, 0x72, 0xa4, 0x0a,
-	0x25, 0x6d, 0x77, 0xfb, 0xf5, 0xb7, 0xbb, 0x1a, 0xfb, 0xc6, 0xa3, 0xaf, 0xa0, 0x9c, 0x78, 0x2d,
-	0x67, 0x6b, 0x3a, 0x78, 0xbd, 0x13, 0xaf, 0xfa, 0x5a, 0x44, 0x18, 0x4c, 0x5d, 0x03, 0x60, 0x04,
-	0xf1, 0xdd, 0xec, 0xa3, 0x7f, 0xc8, 0x0c, 0x2a, 0xd2, 0x7c, 0x8e, 0x05, 0x98, 0x3d, 0xd8, 0x3b,
-	0xd8, 0xdd, 0xdf, 0x7b, 0xb5, 0x9b, 0x54, 0xc8, 0x3c, 0x28, 0x31, 0x79, 0xa0, 0x95, 0xeb, 0x30,
-	0x37, 0xa0, 0xee, 0xc6, 0xe2, 0xd9, 0x94, 0x78, 0xa4, 0xb3, 0x1c, 0x99, 0x83, 0x99, 0x98, 0x7a,
-	0xb0, 0xf9, 0xf6, 0x10, 0xf5, 0x94, 0x14, 0x3d, 0x7c, 0xb3, 0xf9, 0x6a, 0x67, 0xeb, 0x8f, 0x95,
-	0x7c, 0x6a, 0x19, 0xdb, 0xda, 0xe6, 0xe1, 0x1f, 0xa2, 0x06, 0x37, 0xfe, 0xb6, 0x02, 0xb9, 0xcd,
-	0x83, 0x3d, 0xb2, 0x06, 0xa5, 0xb8, 0xfc, 0x4d, 0x16, 0xc4, 0x1f, 0x17, 0xd2, 0xe5, 0xf0, 0x46,
-	0x9c, 0x4b, 0xa9, 0xd7, 0xc8, 0xcf, 0x00, 0x06, 0xf5, 0x46, 0xb2, 0x28, 0x10, 0xe1, 0x50, 0x01,
-	0xb2, 0x51, 0x89, 0x46, 0xa0, 0x99, 0x5e, 0x23, 0x4f, 0xa0, 0x28, 0x8a, 0x81, 0x84, 0x83, 0x85,
-	0x74, 0x69, 0x70, 0x58, 0xfe, 0x49, 0x86, 0x6c, 0x80, 0x1c, 0x55, 0xd5, 0x08, 0x47, 0xfb, 0x43,
-	0x45, 0xb6, 0x31, 0x63, 0xbe, 0x80, 0x52, 0x5c, 0x1d, 0x13, 0x7b, 0x19, 0xae, 0x96, 0x35, 0x16,
-	0x47, 0xae, 0xe8, 0x6e, 0xdf, 0x0d, 0x4e, 0xd5, 0x6b, 0xe4, 0x17, 0x50, 0x14, 0xb5, 0x32, 0xb1,
-	0xc6, 0x74, 0xe5, 0x6c, 0xc2, 0xc8, 0xe7, 0x50, 0x49, 0x66, 0xb1, 0xa4, 0x9e, 0xd4, 0x4a, 0x32,
-	0x45, 0x6d, 0xd4, 0x06, 0x99, 0xac, 0xd0, 0xcc, 0x67, 0x50, 0x8a, 0x13, 0x59, 0xb1, 0xe6, 0xe1,
-	0xc4, 0x76, 0x74, 0xd4, 0x93, 0x0c, 0xd9, 0xc2, 0x1f, 0xfe, 0xc6, 0xf9, 0xb8, 0xf8, 0xe6, 0x98,
-	0x14, 0x7d, 0xc2, 0xba, 0x5f, 0x40, 0x2d, 0x9d, 0xff, 0x91, 0x46, 0xc2, 0x00, 0x86, 0x22, 0xd9,
-	0x84, 0x79, 0xb6, 0x61, 0x66, 0x08, 0xfe, 0x90, 0x1b, 0x49, 0x15, 0x0c, 0xcf, 0x34, 0xfa, 0x28,
-	0xa3, 0x5e, 0x23, 0x5f, 0x42, 0x25, 0x89, 0x7e, 0xc4, 0x86, 0xc6, 0x00, 0xa2, 0x06, 0x19, 0x19,
-	0xee, 0xf3, 0xcd, 0xa4, 0x91, 0x89, 0xd8, 0xcc, 0x58, 0xb8, 0x32, 0x61, 0x33, 0x3b, 0x50, 0x4d,
-	0x81, 0x09, 0xb2, 0x24, 0x8c, 0x61, 0x14, 0x60, 0x4c, 0x98, 0x65, 0x0b, 0x2a, 0x49, 0x3c, 0x21,
-	0x76, 0x33, 0x06, 0x62, 0x4c, 0x98, 0xe3, 0x6b, 0x28, 0x27, 0x00, 0x05, 0xe1, 0x7f, 0xba, 0x1d,
-	0x85, 0x18, 0x93, 0x4d, 0x5a, 0x84, 0x7c, 0x61, 0xd2, 0x69, 0x00, 0x30, 0x79, 0xfd, 0xc9, 0x78,
-	0x2f, 0xd6, 0x3f, 0x06, 0x02, 0x4c, 0x9e, 0x23, 0x09, 0x04, 0xc4, 0x1c, 0x63, 0xb0, 0xc1, 0xc4,
-	0x1d, 0x00, 0x33, 0x01, 0x31, 0xc3, 0x19, 0x72, 0x0d, 0x65, 0x28, 0x48, 0x32, 0x7b, 0xf8, 0x03,
-	0xa8, 0xa6, 0xa0, 0x84, 0x38, 0xc7, 0x71, 0xf0, 0xa2, 0x31, 0x1c, 0x64, 0x71, 0xb8, 0xf0, 0x25,
-	0x9b, 0x96, 0x75, 0xe6, 0x77, 0xcf, 0x5e, 0xf7, 0x33, 0x28, 0x8a, 0x82, 0xad, 0xd0, 0x7c, 0xba,
-	0x7c, 0x2b, 0xbe, 0x38, 0xa8, 0x47, 0xe2, 0x9d, 0xde, 0x85, 0x4a, 0x32, 0xc2, 0x0a, 0x85, 0x8d,
-	0x89, 0xc5, 0x8d, 0xa5, 0x31, 0x1c, 0x11, 0xbd, 0xf1, 0x26, 0xa4, 0x6b, 0xf2, 0xe2, 0x26, 0x8c,
-	0x2d, 0xd4, 0x9f, 0xbd, 0x87, 0xad, 0x9f, 0xff, 0xf6, 0xe3, 0x72, 0xe6, 0x3f, 0x3e, 0x2e, 0x67,
-	0xfe, 0xeb, 0xe3, 0x72, 0xe6, 0x4f, 0x1e, 0xf6, 0xcc, 0xe0, 0x28, 0x6c, 0xaf, 0x19, 0x4e, 0x7f,
-	0xdd, 0xd5, 0x8d, 0xa3, 0xd3, 0x0e, 0xf5, 0x92, 0xad, 0x93, 0x8d, 0x75, 0xdf, 0x33, 0xd6, 0x5d,
-	0xd7, 0x6f, 0x17, 0x70, 0xaa, 0x67, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xee, 0x8a, 0x1a, 0x6a,
-	0x87, 0x3f, 0x00, 0x00,
+	// 5559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0xcd, 0x6f, 0x1b, 0x49,
+	0x76, 0x37, 0xc9, 0x26, 0xd9, 0x7c, 0xfc, 0x50, 0xab, 0xf4, 0xe1, 0x36, 0xfd, 0x21, 0xb9, 0x6d,
+	0xcf, 0xd8, 0xde, 0x59, 0xc9, 0x23, 0x8d, 0x3d, 0xbb, 0xde, 0xc9, 0xcc, 0xea, 0xcb, 0x8e, 0xb8,
+	0x5a, 0x8f, 0xa6, 0x65, 0x6f, 0x90, 0x1c, 0x42, 0x34, 0xc9, 0x22, 0xd5, 0x56, 0xb3, 0xbb, 0xa7,
+	0x3f, 0xe4, 0xd1, 0x20, 0x40, 0x2e, 0xb9, 0x06, 0x08, 0x12, 0x20, 0x08, 0x36, 0x40, 0x80, 0xfc,
+	0x01, 0xf9, 0x38, 0xe5, 0x10, 0xec, 0x25, 0xa7, 0xec, 0x25, 0x40, 0x2e, 0xb9, 0x0e, 0x02, 0x63,
+	0x81, 0xfc, 0x03, 0xc9, 0x25, 0xb9, 0x04, 0xaf, 0xaa, 0xba, 0xd9, 0x4d, 0x52, 0x24, 0x25, 0x4d,
+	0x72, 0xeb, 0x7a, 0xef, 0xd5, 0xd7, 0xab, 0x57, 0xef, 0xbd, 0xfa, 0x55, 0x91, 0x50, 0x75, 0x5d,
+	0x7f, 0xdd, 0x75, 0xfd, 0x35, 0xd7, 0x73, 0x02, 0x87, 0xe4, 0x5c, 0xd7, 0xaf, 0xdf, 0xec, 0x39,
+	0x4e, 0xcf, 0xa2, 0xeb, 0x8c, 0xd4, 0x0a, 0xbb, 0xeb, 0xb4, 0xef, 0x06, 0x67, 0x5c, 0xa2, 0xbe,
+	0x32, 0xcc, 0x0c, 0xcc, 0x3e, 0xf5, 0x03, 0xa3, 0xef, 0x0a, 0x81, 0x3b, 0xc3, 0x02, 0x9d, 0xd0,
+	0x33, 0x02, 0xd3, 0xb1, 0x05, 0x7f, 0xb1, 0xe7, 0xf4, 0x1c, 0xf6, 0xb9, 0x8e, 0x5f, 0x82, 0x5a,
+	0x75, 0xbb, 0xfe, 0xba, 0xdb, 0x15, 0xe3, 0xd0, 0x4e, 0xa0, 0x7c, 0x44, 0xdb, 0x1e, 0x0d, 0x7e,
+	0xee, 0x84, 0x76, 0x40, 0x08, 0x48, 0xb6, 0xd1, 0xa7, 0x6a, 0x66, 0x35, 0xf3, 0xb0, 0xa4, 0xb3,
+	0x6f, 0xa2, 0x40, 0xee, 0x84, 0x9e, 0xa9, 0x12, 0x23, 0xe1, 0x27, 0xb9, 0x0d, 0xd0, 0x47, 0xf1,
+	0xa6, 0x6b, 0x04, 0xc7, 0x6a, 0x96, 0x31, 0x4a, 0x8c, 0x72, 0x68, 0x04, 0xc7, 0xe4, 0x3a, 0x14,
+	0xa9, 0x7d, 0xda, 0x3c, 0x35, 0x3c, 0x35, 0xc7, 0x78, 0x05, 0x6a, 0x9f, 0xfe, 0xc2, 0xf0, 0xb4,
+	0xbf, 0x94, 0xa0, 0xf4, 0xda, 0x33, 0x6c, 0xbf, 0xeb, 0x78, 0x7d, 0xb2, 0x08, 0x79, 0xb3, 0x6f,
+	0xf4, 0xa2, 0xce, 0x78, 0x01, 0x7b, 0x6b, 0xf7, 0x3b, 0x6a, 0x76, 0x35, 0x87, 0xbd, 0xb5, 0xfb,
+	0x1d, 0xd6, 0x9c, 0xe7, 0x35, 0x91, 0x5a, 0x65, 0xd4, 0x02, 0xf5, 0xbc, 0x9d, 0x7e, 0x87, 0x3c,
+	0x82, 0x1c, 0xb5, 0x4f, 0xd5, 0xdc, 0x6a, 0xee, 0x61, 0x79, 0xe3, 0xfa, 0x1a, 0x2a, 0x37, 0x6e,
+	0x7d, 0x6d, 0xcf, 0x3e, 0xdd, 0xb3, 0x03, 0xef, 0x4c, 0x47, 0x19, 0xf2, 0x18, 0x8a, 0x3e, 0x9b,
+	0xa6, 0xaf, 0x4a, 0x4c, 0x5c, 0x61, 0xe2, 0x89, 0xa9, 0xeb, 0x91, 0x00, 0xf9, 0x08, 0x08, 0x1b,
+	0x4a, 0xd3, 0x0d, 0x2d, 0xab, 0x19, 0x55, 0x2b, 0xb1, 0xae, 0x15, 0xc6, 0x39, 0x0c, 0x2d, 0xeb,
+	0x48, 0x48, 0x2f, 0x42, 0xde, 0x0f, 0x3a, 0xa6, 0xad, 0xe6, 0x99, 0x00, 0x2f, 0x90, 0x9b, 0x50,
+	0xc2, 0x31, 0x73, 0x4e, 0x8d, 0x71, 0x64, 0xea, 0x79, 0x47, 0x8c, 0xf9, 0x11, 0x10, 0xa3, 0xdd,
+	0xa6, 0x6e, 0xd0, 0xf4, 0x68, 0x10, 0x7a, 0x76, 0xb3, 0xed, 0x74, 0xa8, 0x5a, 0x58, 0xcd, 0x3d,
+	0xcc, 0xe9, 0x0a, 0xe7, 0xe8, 0x8c, 0xb1, 0xe3, 0x74, 0x28, 0x76, 0xd0, 0xa1, 0xad, 0xb0, 0xa7,
+	0x16, 0x57, 0x33, 0x0f, 0x65, 0x9d, 0x17, 0x70, 0xa1, 0x42, 0x9f, 0x7a, 0x2a, 0xf0, 0x85, 0xc2,
+	0x6f, 0xb2, 0x02, 0xe5, 0x77, 0x8e, 0x77, 0x62, 0xda, 0xbd, 0x66, 0xc7, 0xf4, 0xd4, 0x32, 0x63,
+	0x81, 0x20, 0xed, 0x9a, 0x1e, 0xb9, 0x03, 0xd0, 0x71, 0xda, 0x27, 0xd4, 0xeb, 0x9a, 0x16, 0x55,
+	0x2b, 0x9c, 0x3f, 0xa0, 0x90, 0xfb, 0x90, 0x6f, 0x85, 0xa6, 0xd5, 0x51, 0xe7, 0x56, 0x33, 0x0f,
+	0xcb, 0x1b, 0x35, 0xa6, 0xa3, 0x6d, 0xa4, 0x1c, 0xb9, 0xb4, 0xad, 0x73, 0x26, 0xb6, 0xe2, 0x52,
+	0xcf, 0x37, 0xfd, 0x80, 0xda, 0x81, 0xaa, 0xb0, 0x51, 0x25, 0x28, 0xf5, 0x67, 0x20, 0x47, 0xca,
+	0x8f, 0x6c, 0x27, 0x33, 0xb0, 0x9d, 0x45, 0xc8, 0x9f, 0x1a, 0x56, 0x48, 0x85, 0xd9, 0xf0, 0xc2,
+	0xf3, 0xec, 0x8f, 0x32, 0xda, 0x57, 0x50, 0x8a, 0xfb, 0xc2, 0xf9, 0x31, 0xe3, 0x12, 0x86, 0x88,
+	0xdf, 0xa4, 0x0e, 0xb2, 0x65, 0xd8, 0xbd, 0xd0, 0xe8, 0x45, 0xb5, 0xe3, 0xf2, 0xc0, 0x98, 0x72,
+	0x09, 0x63, 0xd2, 0x1e, 0x41, 0xfe, 0xf5, 0x8b, 0x86, 0xd3, 0x22, 0xab, 0x50, 0x08, 0xba, 0xcd,
+	0xb7, 0x4e, 0x8b, 0x37, 0xb8, 0x5d, 0x7a, 0xff, 0xdd, 0x0a, 0x67, 0xe9, 0xf9, 0xa0, 0xdb, 0x70,
+	0x5a, 0x5a, 0x1d, 0x0a, 0x7b, 0x3d, 0x8f, 0xfa, 0x3e, 0x8e, 0xf9, 0x8d, 0x7e, 0x10, 0x8d, 0xf9,
+	0x8d, 0x7e, 0xa0, 0xdd, 0x86, 0x1c, 0x36, 0xb2, 0x0c, 0x59, 0xb3, 0x23, 0x1a, 0x28, 0xbc, 0xff,
+	0x6e, 0x25, 0xbb, 0xbf, 0xab, 0x67, 0xcd, 0x8e, 0xf6, 0xdf, 0x19, 0x90, 0x7f, 0x4e, 0x03, 0xa3,
+	0x63, 0x04, 0x06, 0xf9, 0x29, 0x94, 0x0d, 0xdb, 0x76, 0x02, 0xb6, 0x13, 0x7d, 0x35, 0xc3, 0xac,
+	0xed, 0x0e, 0xd3, 0x64, 0x24, 0xb3, 0xb6, 0x35, 0x10, 0xe0, 0x36, 0x9a, 0xac, 0x42, 0x3e, 0x86,
+	0x82, 0x65, 0xb4, 0xa8, 0xe5, 0xb3, 0x4d, 0x50, 0xde, 0xb8, 0x91, 0xae, 0x7c, 0xc0, 0x78, 0xbc,
+	0x9e, 0x10, 0xac, 0x7f, 0x0e, 0xca, 0x70, 0x9b, 0x17, 0x51, 0x7d, 0xfd, 0xc7, 0x50, 0x4e, 0x34,
+	0x7b, 0xa1, 0x55, 0xfb, 0x43, 0x28, 0x1e, 0x51, 0xef, 0xd4, 0x6c, 0x53, 0x72, 0x0f, 0xaa, 0xa6,
+	0x1d, 0x50, 0xcf, 0x36, 0xac, 0xa6, 0xeb, 0x78, 0x01, 0x6b, 0x20, 0xaf, 0x57, 0x22, 0xe2, 0xa1,
+	0xe3, 0x05, 0x28, 0x44, 0xbf, 0x49, 0x0a, 0x65, 0xb9, 0x10, 0xfd, 0x26, 0x21, 0x84, 0x9a, 0x76,
+	0xd5, 0x5c, 0x42, 0xd3, 0x87, 0x7a, 0xd6, 0x74, 0xd1, 0x2a, 0x82, 0x33, 0x97, 0x0a, 0x5f, 0xc4,
+	0xbe, 0xb5, 0x75, 0xc8, 0x1f, 0xb9, 0x4e, 0x18, 0x90, 0x0f, 0x70, 0x8f, 0xb3, 0x91, 0xb0, 0x8e,
+	0xcb, 0x1b, 0x15, 0xb1, 0xc7, 0x19, 0x4d, 0x8f, 0x98, 0xda, 0x3f, 0x64, 0x41, 0x3e, 0x7c, 0x71,
+	0xb4, 0x6f, 0xbb, 0xe1, 0x78, 0x87, 0x47, 0x40, 0xf2, 0xa8, 0xeb, 0x88, 0xb9, 0xb2, 0x6f, 0xb2,
+	0x0c, 0x85, 0x96, 0x67, 0xd8, 0xed, 0xe3, 0xc8, 0xa5, 0xf1, 0x12, 0xd2, 0xdb, 0x4e, 0xbf, 0x6f,
+	0x06, 0x62, 0x4c, 0xa2, 0x84, 0x6d, 0xf4, 0x2c, 0xa7, 0xa5, 0xe6, 0x79, 0x1b, 0xf8, 0x8d, 0x8e,
+	0xec, 0xad, 0x63, 0xda, 0x4d, 0xc7, 0x56, 0x65, 0x2e, 0x8c, 0xc5, 0x2f, 0x6d, 0xf4, 0xa7, 0x4e,
+	0x18, 0x50, 0xaf, 0x89, 0x65, 0xb6, 0x2f, 0x65, 0xbd, 0xc4, 0x28, 0x0d, 0xc7, 0xb4, 0xc9, 0x0d,
+	0x90, 0x7b, 0x9e, 0x13, 0xba, 0xcd, 0xd6, 0x99, 0xd8, 0xd4, 0x45, 0x56, 0xde, 0x3e, 0xc3, 0x6e,
+	0x2c, 0xe3, 0xdb, 0x33, 0xb5, 0xc0, 0xea, 0xb0, 0x6f, 0x74, 0x03, 0x2c, 0x8e, 0x34, 0x71, 0x4f,
+	0xfb, 0xc2, 0x6d, 0x00, 0x23, 0xbd, 0x40, 0x0a, 0xa9, 0x41, 0xd6, 0xdf, 0x54, 0x4b, 0x8c, 0x9e,
+	0xf5, 0x37, 0x51, 0x71, 0x81, 0x67, 0xf6, 0x7a, 0xc2, 0x9d, 0x30, 0xc5, 0x75, 0xd1, 0x97, 0x32,
+	0x9a, 0x1e, 0x31, 0xb5, 0xbf, 0xcb, 0x40, 0x69, 0xc7, 0x73, 0xec, 0x0b, 0x6b, 0x4e, 0x68, 0x28,
+	0x37, 0xac, 0x21, 0xdf, 0xa5, 0xed, 0x68, 0x2d, 0xf1, 0x9b, 0xdc, 0x82, 0x92, 0x73, 0x4a, 0xbd,
+	0x77, 0x9e, 0x19, 0x50, 0x31, 0xa7, 0x01, 0x81, 0x3c, 0x41, 0x57, 0x6b, 0x78, 0x01, 0x53, 0x6a,
+	0x79, 0xa3, 0xbe, 0xc6, 0x03, 0xe0, 0x5a, 0x14, 0x00, 0xd7, 0x5e, 0x47, 0x11, 0x52, 0xe7, 0x82,
+	0x9a, 0x09, 0xf2, 0x4b, 0x33, 0x38, 0x7f, 0xbc, 0x37, 0x20, 0x17, 0x7a, 0x16, 0x1f, 0xee, 0x76,
+	0xf1, 0xfd, 0x77, 0x2b, 0xb8, 0xdd, 0x75, 0xa4, 0x5d, 0x74, 0xc1, 0xb5, 0xff, 0xcc, 0x80, 0x7c,
+	0xf4, 0xd5, 0xc1, 0xf7, 0xa3, 0x1b, 0x31, 0x2e, 0x69, 0xcc, 0xb8, 0x3e, 0x84, 0x39, 0xd7, 0xf0,
+	0xfd, 0x77, 0x8e, 0xd7, 0x11, 0xb1, 0x49, 0xd8, 0x58, 0x2d, 0x22, 0xf3, 0xc8, 0x84, 0x5b, 0xf6,
+	0xeb, 0x90, 0x7a, 0xdc, 0x36, 0x4a, 0x3a, 0x2f, 0x60, 0x8f, 0x18, 0x1e, 0x8d, 0x80, 0xd9, 0x45,
+	0x49, 0x17, 0xa5, 0x78, 0x35, 0xe4, 0xc4, 0x6a, 0x68, 0x50, 0xf5, 0x9c, 0x77, 0x7e, 0xd3, 0xa5,
+	0x1e, 0xb3, 0x25, 0x66, 0x32, 0x39, 0xbd, 0x8c, 0xc4, 0x43, 0xea, 0xa1, 0x31, 0xa1, 0xef, 0x2b,
+	0x6f, 0x87, 0xed, 0x13, 0x1a, 0xfc, 0x9f, 0xcf, 0xfc, 0x29, 0xc8, 0xcc, 0x93, 0x9c, 0x1a, 0x96,
+	0xb0, 0x80, 0x1b, 0x23, 0x16, 0xb0, 0x2b, 0x52, 0x20, 0x3d, 0x16, 0xc5, 0x9e, 0x3a, 0xd4, 0xa2,
+	0xb1, 0x41, 0x89, 0x52, 0xbc, 0x43, 0x8b, 0x89, 0x1d, 0xfa, 0x43, 0x20, 0x6d, 0x8f, 0x76, 0xa8,
+	0x1d, 0x98, 0x86, 0xe5, 0x47, 0xfa, 0xe5, 0x3a, 0x99, 0x4f, 0x70, 0xb8, 0x8a, 0xb5, 0x7f, 0xce,
+	0x42, 0x9e, 0x4f, 0x7b, 0x05, 0x72, 0x6e, 0xd7, 0x67, 0x3d, 0x94, 0x37, 0xaa, 0xcc, 0xef, 0x44,
+	0x2e, 0x46, 0x47, 0x0e, 0xb9, 0x03, 0x12, 0xdb, 0xdc, 0x45, 0xe6, 0xd2, 0x81, 0x49, 0x70, 0x36,
+	0xa3, 0x93, 0x55, 0xc8, 0xb3, 0x3d, 0xad, 0xca, 0x23, 0x02, 0x9c, 0x81, 0x12, 0x6d, 0xcf, 0xf1,
+	0xa3, 0xa8, 0x90, 0x92, 0x60, 0x0c, 0x94, 0x08, 0x6d, 0xd3, 0xb1, 0xd5, 0xdc, 0xa8, 0x04, 0x63,
+	0x10, 0x0d, 0xa4, 0xb6, 0xe7, 0xd8, 0xaa, 0x94, 0x88, 0xef, 0xf1, 0x8e, 0xd6, 0x19, 0x0f, 0xa7,
+	0xd2, 0x33, 0xa3, 0x3d, 0xc6, 0xa7, 0x12, 0xed, 0x21, 0x1d, 0x39, 0xe4, 0x21, 0xe4, 0xfc, 0xaf,
+	0x2d, 0xb5, 0x94, 0x10, 0x88, 0x0c, 0x9f, 0xaf, 0xd8, 0xd1, 0x57, 0x07, 0x3a, 0x8a, 0x90, 0x87,
+	0x50, 0x68, 0x31, 0xdb, 0x10, 0x7e, 0x45, 0x11, 0x09, 0x45, 0x6c, 0x2e, 0xba, 0xe0, 0x6b, 0x27,
+	0x20, 0x37, 0x9c, 0x56, 0xda, 0x84, 0xa4, 0x84, 0x09, 0xdd, 0x8b, 0xcd, 0x85, 0xbb, 0xf6, 0x32,
+	0xf3, 0x50, 0x3b, 0x8c, 0x34, 0xe2, 0x73, 0xb3, 0x89, 0x15, 0x8d, 0x1c, 0x64, 0x6e, 0xe0, 0x20,
+	0xb5, 0x37, 0x30, 0x77, 0x68, 0x78, 0x86, 0x65, 0x51, 0xcb, 0xf4, 0xfb, 0x2c, 0xdd, 0xa8, 0x83,
+	0xdc, 0x76, 0x6c, 0x3f, 0x30, 0x6c, 0x1e, 0x90, 0x24, 0x3d, 0x2e, 0x93, 0x55, 0x28, 0xb7, 0x1d,
+	0xda, 0xed, 0x9a, 0x6d, 0x13, 0x13, 0x1e, 0x6c, 0x29, 0xa3, 0x27, 0x49, 0x0d, 0x49, 0xce, 0x28,
+	0x59, 0x6d, 0x13, 0x4a, 0x6c, 0x02, 0xb8, 0x2f, 0xe2, 0xfc, 0x45, 0x4a, 0xe4, 0x2f, 0x04, 0xa4,
+	0x63, 0xc3, 0x3f, 0x66, 0xaa, 0xad, 0xe8, 0xec, 0x5b, 0xfb, 0x09, 0xe4, 0x77, 0x8d, 0x20, 0xec,
+	0x9f, 0x97, 0x5c, 0x90, 0x3a, 0xe4, 0xde, 0x8a, 0x39, 0x95, 0x37, 0x64, 0xa6, 0x40, 0xcc, 0x5a,
+	0x90, 0xa8, 0xfd, 0x3a, 0x03, 0x25, 0x56, 0x7b, 0xdf, 0xee, 0x3a, 0xb8, 0xfc, 0x1d, 0x2c, 0x08,
+	0x15, 0xf1, 0xe5, 0x67, 0x6c, 0x9d, 0x33, 0xc8, 0x03, 0xe6, 0x40, 0x03, 0x1e, 0xc5, 0x6b, 0x1b,
+	0x73, 0x03, 0x89, 0x23, 0x24, 0xeb, 0x9c, 0x4b, 0x3e, 0xe4, 0x62, 0x3e, 0x9b, 0x6a, 0x79, 0x63,
+	0x9e, 0x9b, 0xb3, 0xe7, 0xb4, 0xa9, 0xef, 0xa3, 0xa0, 0xcf, 0x05, 0x7d, 0xf2, 0x01, 0x94, 0xdc,
+	0xae, 0xdf, 0xe4, 0x6d, 0x72, 0x9b, 0x2a, 0xb1, 0x85, 0x41, 0x15, 0xe8, 0xb2, 0xdb, 0x65, 0xe2,
+	0x94, 0xdc, 0x05, 0x09, 0x53, 0x17, 0x96, 0x22, 0x33, 0x93, 0x11, 0x22, 0x38, 0x6c, 0x9d, 0xb1,
+	0xb4, 0xbf, 0xcf, 0x40, 0x69, 0xab, 0xd7, 0xf3, 0x68, 0x0f, 0x2b, 0x2c, 0x42, 0xbe, 0x8d, 0x49,
+	0x39, 0x9b, 0x4a, 0x4e, 0xe7, 0x05, 0xd4, 0x5f, 0x9f, 0x1a, 0x36, 0x1b, 0x7d, 0x46, 0x67, 0xdf,
+	0xb8, 0xbb, 0xfd, 0xa0, 0xd3, 0xa1, 0xa7, 0x62, 0x5d, 0x44, 0x89, 0x3c, 0x02, 0xa5, 0x6b, 0x76,
+	0x83, 0x63, 0x74, 0x5e, 0x6d, 0xdc, 0xb5, 0x16, 0x1f, 0x61, 0x46, 0x9f, 0x63, 0xf4, 0xc3, 0x98,
+	0x4c, 0x9e, 0xc1, 0x75, 0xdb, 0xb4, 0x29, 0x0b, 0x98, 0x43, 0x35, 0xf2, 0xac, 0xc6, 0x12, 0x67,
+	0xbf, 0x48, 0xd7, 0xd3, 0xfe, 0x34, 0x0b, 0x95, 0xa4, 0x56, 0xc8, 0xe7, 0x50, 0xed, 0x38, 0xef,
+	0x6c, 0xcb, 0x31, 0x3a, 0x4d, 0x3c, 0xac, 0xa9, 0x99, 0x69, 0x5e, 0xaa, 0x12, 0xc9, 0x63, 0xe4,
+	0x22, 0x9f, 0x41, 0xc5, 0xe5, 0xed, 0xf1, 0xea, 0xd9, 0x69, 0xd5, 0xcb, 0x42, 0x9c, 0xd5, 0x7e,
+	0x0e, 0xe5, 0xd0, 0x1d, 0xf4, 0x9d, 0x9b, 0x56, 0x19, 0xb8, 0x34, 0xab, 0xfb, 0x00, 0x6a, 0xf1,
+	0xc8, 0x5b, 0x67, 0x01, 0xf5, 0x99, 0xae, 0x24, 0x3d, 0x9e, 0xcf, 0x36, 0x12, 0xc9, 0x5d, 0xa8,
+	0x84, 0x6e, 0x42, 0x28, 0xcf, 0x84, 0x44, 0xb7, 0x4c, 0x44, 0xfb, 0x65, 0x16, 0x96, 0xe2, 0x75,
+	0x4c, 0x69, 0x67, 0x73, 0xbc, 0x76, 0xb8, 0x13, 0x8a, 0xab, 0x0c, 0xa9, 0xe4, 0xe3, 0xb1, 0x2a,
+	0x19, 0xae, 0x93, 0xd2, 0xc3, 0xfa, 0x38, 0x3d, 0x0c, 0xd7, 0x48, 0x4e, 0xfe, 0xe9, 0xd8, 0xc9,
+	0x8f, 0xd6, 0x19, 0x52, 0xc6, 0xc7, 0x63, 0x94, 0x31, 0x66, 0x68, 0x49, 0xe5, 0xfc, 0x57, 0x16,
+	0x2a, 0xbf, 0xe3, 0x78, 0x27, 0xd4, 0x43, 0x95, 0x84, 0x3e, 0x79, 0x04, 0xa5, 0x77, 0xac, 0xdc,
+	0x8c, 0xf7, 0x7e, 0xe5, 0xfd, 0x77, 0x2b, 0x32, 0x17, 0xda, 0xdf, 0xd5, 0x65, 0xce, 0xde, 0xef,
+	0xe0, 0x09, 0xe6, 0xad, 0xd3, 0x42, 0xb9, 0xec, 0xe0, 0x04, 0x83, 0x3e, 0x73, 0x57, 0xcf, 0xbf,
+	0x75, 0x5a, 0xfb, 0x1d, 0x74, 0xee, 0x6c, 0x97, 0x71, 0xef, 0x5f, 0x1b, 0x78, 0x7f, 0xb6, 0x1b,
+	0x19, 0x8f, 0x7c, 0x02, 0x45, 0x96, 0x19, 0xd1, 0x8e, 0x2a, 0x4d, 0x4d, 0xa2, 0x22, 0xd1, 0x81,
+	0x43, 0xc8, 0x4f, 0x71, 0x08, 0xb7, 0x01, 0xbe, 0x0e, 0x69, 0x48, 0x9b, 0xbe, 0xf9, 0x2d, 0x8f,
+	0xb7, 0x39, 0xbd, 0xc4, 0x28, 0x47, 0xe6, 0xb7, 0xdc, 0xcc, 0x8c, 0xc0, 0x68, 0x8a, 0xe5, 0xa2,
+	0x1d, 0x16, 0x7c, 0x73, 0x7a, 0x15, 0xa9, 0x87, 0x11, 0x31, 0x16, 0xf3, 0x68, 0x1b, 0x93, 0x3f,
+	0xda, 0x51, 0xe5, 0x81, 0x98, 0x1e, 0x11, 0x31, 0xba, 0x30, 0xb7, 0xc6, 0xcf, 0xe6, 0x51, 0x74,
+	0x89, 0xdd, 0x59, 0xe8, 0xeb, 0x82, 0xaf, 0xf5, 0xa0, 0x9c, 0x20, 0xc7, 0x8a, 0xca, 0xcc, 0xa6,
+	0xa8, 0xec, 0xcc, 0x8a, 0xd2, 0x3c, 0xa8, 0xe8, 0xd4, 0x77, 0x42, 0xaf, 0x4d, 0x59, 0x58, 0x41,
+	0x30, 0xc3, 0x0d, 0xd9, 0xca, 0x66, 0x75, 0xfc, 0x44, 0x7f, 0xd5, 0xa7, 0x7d, 0xc7, 0x3b, 0x13,
+	0x51, 0x4a, 0x94, 0xc8, 0x1d, 0xc8, 0xf5, 0xdc, 0x50, 0xcd, 0x27, 0x0e, 0x2e, 0x2f, 0x0f, 0xdf,
+	0x60, 0x23, 0x3a, 0x32, 0xd0, 0xf7, 0x75, 0x4c, 0xff, 0x24, 0x8a, 0x27, 0xf8, 0xdd, 0x90, 0xe4,
+	0x9c, 0x22, 0x69, 0x4f, 0xa1, 0x28, 0x24, 0xe3, 0xe3, 0x51, 0x66, 0x70, 0x3c, 0xc2, 0x0e, 0xed,
+	0xb0, 0xdf, 0xa2, 0x1e, 0xeb, 0x30, 0xa7, 0x8b, 0x92, 0xf6, 0x6f, 0x12, 0x94, 0xf7, 0x82, 0x76,
+	0x87, 0x85, 0xdd, 0xae, 0x13, 0xc5, 0x99, 0xcc, 0x98, 0x38, 0x43, 0x1e, 0x81, 0xec, 0x9a, 0x2e,
+	0xb5, 0x4c, 0x3b, 0xda, 0x81, 0x22, 0xc5, 0x11, 0x44, 0x3d, 0x66, 0x93, 0x27, 0x50, 0x75, 0xc2,
+	0xc0, 0x0d, 0x83, 0x66, 0x22, 0xbd, 0x1b, 0x8a, 0xd7, 0x15, 0x2e, 0xc1, 0x4b, 0x44, 0x85, 0xa2,
+	0x47, 0x79, 0x5e, 0xcf, 0x9d, 0x4e, 0x54, 0x1c, 0x63, 0x2e, 0xf9, 0x71, 0xe6, 0x72, 0x17, 0x2a,
+	0x4c, 0xcc, 0x3f, 0x31, 0x5d, 0x97, 0x76, 0x84, 0xd9, 0x95, 0x91, 0x76, 0xc4, 0x49, 0x68, 0x97,
+	0x4c, 0x24, 0x70, 0x02, 0xc3, 0x12, 0x46, 0x57, 0x42, 0xca, 0x6b, 0x24, 0xe0, 0x89, 0x89, 0xb1,
+	0xbb, 0x86, 0x69, 0xc5, 0xd6, 0xc6, 0x6a, 0xbc, 0x60, 0x94, 0x31, 0x16, 0x39, 0x37, 0xce, 0x22,
+	0xe3, 0x7d, 0x52, 0x9a, 0xb2, 0x4f, 0xd6, 0xa0, 0xc2, 0x3e, 0x22, 0x25, 0xc1, 0xa8, 0x92, 0xca,
+	0x4c, 0x80, 0x17, 0xc8, 0xbd, 0x28, 0x70, 0x97, 0x59, 0xe0, 0xae, 0x46, 0xcb, 0x93, 0x0a, 0xdb,
+	0xcb, 0x50, 0xf0, 0xa8, 0xe1, 0x3b, 0xb6, 0x40, 0x76, 0x44, 0x29, 0x69, 0xca, 0xd5, 0xd9, 0xf7,
+	0xfc, 0x33, 0x90, 0xbb, 0xa6, 0x6d, 0xfa, 0xc7, 0xb4, 0xa3, 0xd6, 0xa6, 0x56, 0x8b, 0x65, 0xb5,
+	0xdf, 0x54, 0xa1, 0x38, 0x8b, 0x4d, 0x7d, 0x04, 0xa5, 0x20, 0x02, 0xeb, 0x52, 0x6e, 0x3d, 0x86,
+	0xf0, 0xf4, 0x81, 0x40, 0xca, 0x02, 0x73, 0x93, 0x2d, 0xf0, 0x11, 0x28, 0xd1, 0x77, 0xf3, 0x94,
+	0x7a, 0x3e, 0x26, 0xc4, 0x55, 0x66, 0x58, 0x73, 0x11, 0xfd, 0x17, 0x9c, 0x4c, 0x3e, 0x82, 0x32,
+	0x1e, 0x74, 0xa2, 0x55, 0x58, 0x1f, 0x5d, 0x05, 0x40, 0x3e, 0xff, 0x26, 0x5f, 0x80, 0xe2, 0x0e,
+	0xd2, 0xc6, 0x26, 0x72, 0x98, 0xa6, 0xcb, 0x1b, 0x8b, 0x7c, 0x2c, 0xe9, 0x9c, 0x52, 0x9f, 0x73,
+	0xd3, 0x04, 0x4c, 0x62, 0x29, 0x83, 0x98, 0x04, 0xbe, 0x56, 0x66, 0xd5, 0x38, 0xea, 0xa4, 0x0b,
+	0x16, 0xf9, 0x10, 0xc0, 0x35, 0x3c, 0x6a, 0x07, 0x0c, 0xad, 0x2a, 0x0c, 0xa9, 0xae, 0xc4, 0x79,
+	0x88, 0x46, 0x25, 0x96, 0xb5, 0x78, 0xb9, 0x65, 0x95, 0x67, 0x5f, 0xd6, 0xd1, 0x7d, 0x5d, 0x9a,
+	0xb6, 0xaf, 0x63, 0x9b, 0x85, 0x99, 0x6c, 0xf6, 0x5e, 0xca, 0x66, 0x13, 0x58, 0x4e, 0x6d, 0x02,
+	0x96, 0x83, 0x39, 0xaf, 0xef, 0x3a, 0x61, 0xa0, 0xfe, 0x30, 0x91, 0xf3, 0x32, 0x38, 0x48, 0xe7,
+	0x0c, 0xf2, 0x18, 0xca, 0x62, 0xe0, 0xec, 0x0c, 0x4a, 0x12, 0x59, 0xaa, 0x4e, 0x5d, 0x47, 0x07,
+	0xce, 0xc5, 0x6f, 0xc4, 0xa6, 0x84, 0xac, 0x38, 0xfa, 0xcf, 0xb3, 0x41, 0x89, 0x79, 0x6d, 0x33,
+	0x5a, 0xd2, 0x5f, 0x2d, 0x4e, 0xf3, 0x57, 0xcb, 0xb3, 0xf8, 0xab, 0x3b, 0xa3, 0xfe, 0x6a, 0xc8,
+	0x21, 0x3d, 0x9c, 0xc1, 0x21, 0xad, 0x8d, 0x73, 0x48, 0x69, 0xbf, 0x77, 0x7d, 0xd8, 0xef, 0xc5,
+	0xfe, 0x6a, 0x65, 0x8a, 0xbf, 0x7a, 0x06, 0x55, 0x91, 0xa7, 0xf8, 0x2c, 0x84, 0xaa, 0xea, 0x6a,
+	0x2e, 0xae, 0x90, 0xcc, 0x68, 0xf4, 0xca, 0xbb, 0x44, 0x89, 0x7c, 0x0e, 0xf3, 0x9e, 0x88, 0x87,
+	0x4d, 0x8f, 0x7e, 0x1d, 0x52, 0x3f, 0xf0, 0xd5, 0x1b, 0x89, 0xce, 0x92, 0xd1, 0x52, 0x57, 0x22,
+	0x59, 0x5d, 0x88, 0x92, 0xe7, 0x30, 0x17, 0xd7, 0xb7, 0xcc, 0xbe, 0x19, 0xf8, 0xea, 0xfd, 0xf3,
+	0x6a, 0xd7, 0x22, 0xc9, 0x03, 0x26, 0x48, 0xf6, 0xe1, 0xba, 0x6f, 0x76, 0x68, 0xdb, 0xf0, 0x9a,
+	0xc3, 0x6d, 0x3c, 0x39, 0xaf, 0x8d, 0x25, 0x51, 0x43, 0x4f, 0x37, 0xb5, 0x0a, 0x79, 0x13, 0xf3,
+	0x03, 0xb5, 0x9e, 0xb0, 0x32, 0x71, 0xb0, 0x66, 0x0c, 0xb2, 0x06, 0x60, 0xd3, 0x77, 0x91, 0xd9,
+	0xdc, 0x64, 0x62, 0x73, 0xcc, 0xc8, 0xb8, 0xd5, 0xb0, 0x93, 0x4e, 0xc9, 0xa6, 0xef, 0x78, 0x71,
+	0x24, 0x00, 0xdc, 0x9e, 0x12, 0x00, 0xee, 0x42, 0x85, 0xda, 0x46, 0xcb, 0xa2, 0x4d, 0xbe, 0x60,
+	0xab, 0xec, 0x38, 0x5b, 0xe6, 0x34, 0x9e, 0x5f, 0x23, 0x82, 0x63, 0x58, 0x81, 0x7a, 0x57, 0x20,
+	0x38, 0x86, 0x15, 0x90, 0x1f, 0x02, 0xb4, 0x8f, 0x43, 0xfb, 0x84, 0x3b, 0xab, 0x07, 0xc9, 0x53,
+	0x3f, 0x92, 0xd9, 0x9c, 0x4b, 0xed, 0xe8, 0x93, 0x1d, 0x60, 0x30, 0x4f, 0x62, 0x99, 0x33, 0xee,
+	0xaa, 0x0f, 0xa6, 0x1f, 0x60, 0x50, 0xfe, 0x35, 0x17, 0xc7, 0x23, 0x08, 0xe6, 0xa8, 0x51, 0xed,
+	0x0f, 0xa7, 0xd5, 0x86, 0xb7, 0x4e, 0x2b, 0xaa, 0xcb, 0x4d, 0x1e, 0xfb, 0xf6, 0x4c, 0xea, 0xab,
+	0x8f, 0x62, 0x93, 0x0f, 0xfb, 0xaf, 0x91, 0x42, 0x3e, 0x83, 0x39, 0xbf, 0x7d, 0x4c, 0x3b, 0xa1,
+	0x85, 0x17, 0x1c, 0x6c, 0x42, 0x8f, 0x59, 0x07, 0x0b, 0x7c, 0xd3, 0xc7, 0x3c, 0x6e, 0x0d, 0x7e,
+	0xaa, 0x8c, 0x18, 0xaa, 0xeb, 0x74, 0x78, 0xb5, 0x1f, 0x70, 0x0c, 0xd5, 0x75, 0xf8, 0x55, 0xc3,
+	0x4d, 0x28, 0x21, 0xcb, 0x35, 0x82, 0xf6, 0xb1, 0xfa, 0x11, 0xe3, 0xa1, 0xec, 0x21, 0x96, 0x1b,
+	0x92, 0x2c, 0x29, 0xf9, 0x86, 0x24, 0xe7, 0x95, 0x42, 0x43, 0x92, 0x6f, 0x29, 0xb7, 0x1b, 0x92,
+	0xac, 0x29, 0xf7, 0xb4, 0x5d, 0x28, 0x70, 0xbb, 0x1f, 0x8b, 0x78, 0x7d, 0x90, 0x3e, 0x68, 0x2b,
+	0x43, 0xfb, 0x24, 0x72, 0x7f, 0xda, 0x1d, 0x90, 0xa3, 0x08, 0x36, 0xae, 0x1d, 0xed, 0x7f, 0xb2,
+	0xa0, 0x60, 0x92, 0x16, 0x09, 0xb1, 0xa8, 0xfa, 0x30, 0x6a, 0x3c, 0xc3, 0x1a, 0x27, 0xa9, 0x40,
+	0x78, 0x8e, 0x77, 0x95, 0x52, 0xde, 0x75, 0x28, 0xee, 0x65, 0x27, 0xc7, 0xbd, 0x1d, 0xc0, 0x75,
+	0x6a, 0xb2, 0x33, 0xb8, 0x2f, 0x4e, 0x17, 0xf7, 0x79, 0xe8, 0x1a, 0x1a, 0x1a, 0xba, 0xf7, 0x1d,
+	0x26, 0xc6, 0xaf, 0x27, 0x4a, 0x6f, 0xa3, 0x32, 0x7a, 0x22, 0x23, 0x0c, 0x8e, 0x9b, 0x81, 0x73,
+	0x42, 0x6d, 0x81, 0x58, 0x96, 0x90, 0xf2, 0x1a, 0x09, 0x64, 0x13, 0x6a, 0x96, 0xe1, 0xb3, 0x98,
+	0x27, 0xe0, 0x84, 0xc2, 0xb8, 0xa8, 0x51, 0x41, 0xa1, 0xa8, 0x84, 0xc0, 0x4c, 0x22, 0xc4, 0xb2,
+	0x28, 0x28, 0xe9, 0x49, 0x52, 0xfd, 0x33, 0xa8, 0xa5, 0x87, 0x94, 0xbc, 0xda, 0xc8, 0x8f, 0xb9,
+	0xda, 0xc8, 0x27, 0xaf, 0x36, 0x7e, 0xa9, 0x40, 0x25, 0xa5, 0xf9, 0x64, 0x16, 0x92, 0x99, 0x9c,
+	0x85, 0xa8, 0x50, 0x8c, 0x92, 0x8f, 0x32, 0x8f, 0x12, 0xa7, 0x71, 0xd2, 0x71, 0x91, 0xc4, 0xe7,
+	0xa3, 0xf8, 0xe2, 0x6a, 0x2d, 0xe1, 0x7b, 0xd8, 0xcd, 0xd5, 0xe8, 0x25, 0xd6, 0xd8, 0x14, 0x05,
+	0xbe, 0xf7, 0x14, 0xe5, 0xc7, 0x00, 0x6d, 0x8f, 0x1a, 0x01, 0xed, 0x34, 0x8d, 0x40, 0x2d, 0x4c,
+	0xcd, 0x22, 0x4a, 0x42, 0x7a, 0x2b, 0x18, 0xd8, 0x6e, 0x71, 0x9a, 0xed, 0xaa, 0x98, 0xde, 0x38,
+	0x2c, 0x40, 0x7e, 0xc0, 0x9c, 0x5d, 0x54, 0x44, 0x5f, 0xe8, 0x51, 0x04, 0x61, 0x9a, 0xd4, 0xf3,
+	0x1c, 0x4f, 0xc0, 0xb3, 0x65, 0x4e, 0xdb, 0x43, 0x12, 0xf9, 0x01, 0xcc, 0xf3, 0x38, 0xe4, 0x47,
+	0x61, 0x87, 0x76, 0xd4, 0x8f, 0x99, 0x4b, 0x51, 0x04, 0x43, 0x8f, 0xe8, 0x49, 0x61, 0xe3, 0xd4,
+	0x30, 0x2d, 0x74, 0xa9, 0xea, 0x46, 0x4a, 0x78, 0x2b, 0xa2, 0x93, 0x2f, 0x52, 0x9b, 0x81, 0x1f,
+	0x3c, 0x57, 0x53, 0xb3, 0x98, 0xb2, 0x11, 0x46, 0x2d, 0xfd, 0x07, 0xd3, 0x2d, 0x7d, 0x24, 0x31,
+	0x51, 0xc6, 0x24, 0x26, 0x63, 0x83, 0xed, 0xc2, 0x95, 0x82, 0xed, 0xca, 0xf7, 0x10, 0x6c, 0x37,
+	0x2f, 0x1b, 0x6c, 0x17, 0xcf, 0x0b, 0xb6, 0xab, 0x50, 0xee, 0x50, 0xbf, 0xed, 0x99, 0x2e, 0x46,
+	0x11, 0x75, 0x89, 0xaf, 0x7f, 0x82, 0x84, 0xde, 0xa6, 0x6d, 0xb4, 0x8f, 0x05, 0x0e, 0x71, 0x9d,
+	0x7b, 0x1b, 0x46, 0x61, 0x38, 0xc4, 0x70, 0x34, 0x55, 0xcf, 0x8f, 0xa6, 0x37, 0x12, 0xd1, 0x74,
+	0xe0, 0x4e, 0x6f, 0xa5, 0xdc, 0xe9, 0x7d, 0xa8, 0xf5, 0x8d, 0x6f, 0x9a, 0x09, 0xe4, 0xe3, 0x36,
+	0xb3, 0x9e, 0x4a, 0xdf, 0xf8, 0xe6, 0xab, 0x18, 0xfc, 0x48, 0xa4, 0xb4, 0x77, 0xae, 0x96, 0xd2,
+	0xa6, 0xa3, 0xfa, 0xea, 0x85, 0xa3, 0xfa, 0xdd, 0x2b, 0x45, 0x75, 0xed, 0x22, 0x51, 0x7d, 0x1d,
+	0xca, 0x3d, 0x33, 0x38, 0x76, 0x9c, 0x93, 0x26, 0x5e, 0xeb, 0xb0, 0x24, 0x7f, 0xbb, 0xf6, 0xfe,
+	0xbb, 0x15, 0x78, 0xc9, 0xc9, 0x78, 0xbb, 0x03, 0x42, 0xe4, 0x8d, 0x67, 0x0d, 0x87, 0xa6, 0xfb,
+	0x93, 0x43, 0x13, 0x73, 0x12, 0x86, 0xdd, 0x69, 0x9d, 0xa9, 0x0f, 0x22, 0x27, 0xc1, 0x8a, 0xc3,
+	0xe9, 0xc4, 0x87, 0xb3, 0xa4, 0x13, 0x0f, 0x2f, 0x97, 0x4e, 0x3c, 0x9a, 0x3d, 0x9d, 0x20, 0x4b,
+	0x50, 0xf0, 0x37, 0x9b, 0x4e, 0xc8, 0x0f, 0x9b, 0xb2, 0x9e, 0xf7, 0x37, 0xbf, 0x0c, 0x03, 0x0c,
+	0x2c, 0x7d, 0x71, 0xbf, 0x2f, 0x92, 0xd3, 0x6a, 0xea, 0xd2, 0x5f, 0x8f, 0xd9, 0x98, 0xf9, 0x7b,
+	0x34, 0xc2, 0x44, 0x59, 0xff, 0x4f, 0x59, 0x1f, 0xd5, 0x98, 0xca, 0x46, 0x81, 0xa7, 0x60, 0xcf,
+	0x71, 0x0d, 0x04, 0x21, 0x9b, 0xe2, 0x39, 0xc1, 0x33, 0xf6, 0x0e, 0x65, 0x2e, 0xa6, 0xf3, 0x2b,
+	0x7f, 0xf4, 0x7f, 0x5c, 0x55, 0x6d, 0xc7, 0x6e, 0x87, 0x9e, 0x47, 0xed, 0xf6, 0x99, 0xfa, 0x29,
+	0xf7, 0x7f, 0x8c, 0xb1, 0x33, 0xa0, 0x93, 0x4f, 0x60, 0xd9, 0xf5, 0x4c, 0xc7, 0x33, 0x03, 0xf3,
+	0x5b, 0xda, 0xb4, 0xe9, 0x3b, 0xca, 0x7d, 0x99, 0xaf, 0xfe, 0x88, 0x4d, 0x68, 0x71, 0xc0, 0x7d,
+	0xc5, 0x98, 0x0d, 0xa7, 0x85, 0x58, 0xa9, 0x1c, 0xd0, 0xbe, 0x6b, 0xa1, 0xbb, 0xfb, 0x31, 0x9b,
+	0xdf, 0x52, 0xca, 0x67, 0xbe, 0x16, 0x4c, 0x3d, 0x16, 0x23, 0x4f, 0xb8, 0xd5, 0x79, 0x94, 0x2f,
+	0xe0, 0xf3, 0x28, 0xa5, 0x16, 0x07, 0x61, 0x4e, 0x66, 0xb6, 0x26, 0xbe, 0xaf, 0x16, 0xec, 0x39,
+	0x98, 0x16, 0xa7, 0x7b, 0xcb, 0xca, 0xf5, 0x86, 0x24, 0xd7, 0x95, 0x9b, 0x0d, 0x49, 0xbe, 0xa9,
+	0xdc, 0x6a, 0x48, 0x32, 0x51, 0x16, 0x1a, 0x92, 0xfc, 0x89, 0xf2, 0xb4, 0x21, 0xc9, 0xf3, 0x0a,
+	0xd1, 0x5e, 0x42, 0x35, 0xe9, 0xf1, 0xd9, 0x19, 0x29, 0xc6, 0x1d, 0x4c, 0xbb, 0xeb, 0x08, 0x78,
+	0x71, 0x7e, 0x24, 0x38, 0xe8, 0x15, 0x37, 0x51, 0xd2, 0x7e, 0x95, 0x07, 0x65, 0x87, 0x05, 0x48,
+	0x36, 0x2f, 0xe6, 0x8c, 0xaf, 0x84, 0xb8, 0xdd, 0xb8, 0x00, 0xe2, 0x56, 0x9f, 0x76, 0x82, 0xbd,
+	0x39, 0xcb, 0x09, 0xf6, 0xd6, 0x34, 0xc4, 0xed, 0xf6, 0x14, 0xc4, 0xed, 0xce, 0x0c, 0x07, 0xdc,
+	0x95, 0x89, 0x88, 0xdb, 0xea, 0x05, 0x11, 0xb7, 0xbb, 0xb3, 0x22, 0x6e, 0xda, 0x25, 0xd0, 0x8b,
+	0x04, 0x34, 0x73, 0xff, 0x72, 0xd0, 0xcc, 0x83, 0xd9, 0xa1, 0x99, 0x21, 0xcb, 0xcd, 0x28, 0xd9,
+	0x86, 0x24, 0x83, 0x52, 0x6e, 0x48, 0x72, 0x51, 0x91, 0x1b, 0x92, 0x5c, 0x52, 0xa0, 0x21, 0xc9,
+	0xb2, 0x52, 0x6a, 0x48, 0x72, 0x45, 0xa9, 0x36, 0x24, 0xb9, 0xac, 0x54, 0x1a, 0x92, 0x5c, 0x55,
+	0x6a, 0x0d, 0x49, 0xae, 0x29, 0x73, 0x0d, 0x49, 0x5e, 0x52, 0x96, 0x1b, 0x92, 0x3c, 0xa7, 0x28,
+	0x0d, 0x49, 0x56, 0x94, 0x79, 0x6e, 0xe3, 0xb1, 0xd5, 0x2f, 0x28, 0x8b, 0x0d, 0x49, 0x5e, 0x54,
+	0x96, 0xe2, 0x9d, 0x71, 0x5d, 0x51, 0x1b, 0x92, 0xac, 0x2a, 0x37, 0xb4, 0x3f, 0xcf, 0xc0, 0xfc,
+	0xbe, 0x8d, 0x8e, 0x28, 0x48, 0xd8, 0xef, 0x24, 0xe4, 0xef, 0xe2, 0x10, 0xf1, 0x0a, 0x94, 0x5b,
+	0x96, 0xd3, 0x3e, 0x69, 0x0e, 0x0e, 0x55, 0xb2, 0x0e, 0x8c, 0xc4, 0xf3, 0x23, 0x02, 0x52, 0x37,
+	0xb4, 0xf8, 0xb3, 0x01, 0x59, 0x67, 0xdf, 0xda, 0x7f, 0x64, 0xa0, 0x76, 0x60, 0xfa, 0xc1, 0x39,
+	0xbb, 0x6a, 0x4a, 0xfe, 0xbe, 0x06, 0x15, 0xd3, 0x4e, 0x8c, 0x91, 0x5f, 0xba, 0xa7, 0xed, 0x85,
+	0x09, 0x88, 0x21, 0x5e, 0x0a, 0xf7, 0x3e, 0x36, 0xfd, 0x00, 0xaf, 0x02, 0x24, 0x66, 0xda, 0x51,
+	0x31, 0x9e, 0x4d, 0x7e, 0x30, 0x1b, 0xbc, 0xa0, 0x7e, 0xfb, 0xf5, 0x0b, 0xd3, 0x0a, 0xa8, 0x27,
+	0x1e, 0x74, 0xc4, 0x65, 0xed, 0x2d, 0xcc, 0xbd, 0xb0, 0x42, 0xff, 0x38, 0x31, 0xd3, 0x07, 0x50,
+	0xe4, 0xe3, 0x88, 0x5e, 0xa0, 0xa5, 0x06, 0x12, 0xf1, 0xc8, 0x13, 0xa8, 0x04, 0x4e, 0x33, 0x9a,
+	0x74, 0xf4, 0xb4, 0x60, 0x48, 0x29, 0xe5, 0xc0, 0x89, 0xbe, 0x7d, 0x6d, 0x0d, 0x94, 0x5d, 0xf6,
+	0x7e, 0x62, 0xb6, 0xc5, 0xd6, 0x7e, 0x1f, 0x6a, 0x47, 0x81, 0xe3, 0x5e, 0xd6, 0x34, 0xb2, 0x53,
+	0xb4, 0xa8, 0xfd, 0x26, 0x0b, 0x4b, 0x6f, 0xdc, 0x0e, 0xf7, 0x9e, 0x7c, 0x73, 0xce, 0xd0, 0xcf,
+	0xbd, 0xf4, 0xf9, 0x7c, 0xda, 0xee, 0xce, 0xa5, 0x76, 0xf7, 0xff, 0xc7, 0x85, 0xc5, 0x90, 0x7f,
+	0x2c, 0xce, 0xe0, 0x1f, 0xe5, 0xe9, 0x00, 0x60, 0xe9, 0x5c, 0x00, 0x10, 0x26, 0xbb, 0x4f, 0xed,
+	0x9f, 0xb2, 0x50, 0x7b, 0x49, 0x83, 0x03, 0xa7, 0xe7, 0x5f, 0x22, 0x44, 0x4d, 0x5a, 0x8a, 0x48,
+	0x19, 0x5d, 0x66, 0xcb, 0x1c, 0x5f, 0x28, 0x71, 0x65, 0x70, 0xf3, 0xf6, 0x07, 0x0f, 0x1b, 0x0a,
+	0xe7, 0x3d, 0x6c, 0xc0, 0x5b, 0x35, 0xc3, 0xc7, 0xbd, 0xc1, 0xf7, 0x8c, 0x28, 0xf1, 0xd7, 0x4e,
+	0x96, 0xe5, 0xbc, 0x13, 0xaf, 0xe0, 0x44, 0x89, 0x5d, 0x94, 0x19, 0xa6, 0x25, 0x74, 0xc6, 0xbe,
+	0xc9, 0x43, 0x50, 0x42, 0x9f, 0x36, 0x2d, 0xe7, 0xc4, 0x6c, 0xb6, 0x8c, 0xf6, 0x09, 0xb5, 0x3b,
+	0xe2, 0x8d, 0x5c, 0x2d, 0xf4, 0xe9, 0x81, 0x73, 0x62, 0x6e, 0x73, 0x2a, 0x59, 0x87, 0xbc, 0x6f,
+	0xda, 0x6d, 0xaa, 0xc2, 0xb4, 0x54, 0x98, 0xcb, 0x71, 0xdf, 0xac, 0xfd, 0x2a, 0x0b, 0x70, 0xe0,
+	0xf4, 0x7e, 0x4e, 0x7d, 0x1f, 0x5f, 0xac, 0xde, 0x4b, 0xe4, 0x0b, 0x09, 0xe0, 0x27, 0x4e, 0x0e,
+	0x5e, 0x21, 0x90, 0x34, 0xb8, 0xf5, 0xcd, 0x9d, 0x73, 0xeb, 0x9b, 0xba, 0x42, 0x2e, 0x4e, 0xbc,
+	0x42, 0xfe, 0x00, 0x64, 0x9e, 0xe8, 0x99, 0x7c, 0x66, 0xa5, 0xed, 0xf2, 0xfb, 0xef, 0x56, 0x8a,
+	0xfc, 0x05, 0xc9, 0xae, 0x5e, 0x64, 0xcc, 0xfd, 0x4e, 0x42, 0x9b, 0x90, 0xd2, 0x66, 0x74, 0x6f,
+	0x2a, 0x4d, 0xb8, 0x37, 0x8d, 0xde, 0x25, 0xcb, 0xdc, 0x77, 0xe1, 0x37, 0x79, 0x0c, 0xd9, 0xf8,
+	0xee, 0x78, 0x52, 0x48, 0xcb, 0x06, 0x3e, 0x6e, 0xae, 0x3e, 0x57, 0x90, 0x70, 0x73, 0x51, 0x51,
+	0x7b, 0x0d, 0x0b, 0x3a, 0xdf, 0x67, 0x7c, 0xe9, 0x67, 0xd8, 0xe6, 0xc3, 0xb6, 0x95, 0x1d, 0xb1,
+	0x2d, 0xed, 0x53, 0x58, 0x10, 0xd1, 0x2b, 0xd5, 0xea, 0xd4, 0xb7, 0x34, 0xe8, 0x08, 0x31, 0xba,
+	0xcc, 0x3a, 0x16, 0xcd, 0x04, 0x82, 0x6a, 0x3a, 0x30, 0x6d, 0x6a, 0xf4, 0x62, 0x27, 0x75, 0x1b,
+	0x24, 0xf6, 0xb2, 0x2e, 0x33, 0xfc, 0x78, 0x86, 0x91, 0xf9, 0x83, 0xed, 0x77, 0xb6, 0x1f, 0x78,
+	0xd4, 0xe8, 0x47, 0x71, 0x6f, 0x40, 0xe1, 0x6f, 0xc3, 0xdd, 0x80, 0xbf, 0x51, 0xcc, 0xe9, 0xbc,
+	0xa0, 0xfd, 0x71, 0x06, 0xe6, 0x12, 0x7d, 0x31, 0xe8, 0x6a, 0x25, 0x3a, 0x55, 0x8f, 0xf4, 0xc4,
+	0xe9, 0xe4, 0x2e, 0x14, 0xb8, 0x63, 0x55, 0xb3, 0xc3, 0x12, 0x82, 0x31, 0x50, 0x4a, 0xee, 0xbc,
+	0x7d, 0x18, 0x8f, 0x47, 0x4a, 0x8e, 0x67, 0x1b, 0x4a, 0xf1, 0xc1, 0x34, 0x71, 0x1f, 0x9d, 0x49,
+	0xde, 0x47, 0xa3, 0xa7, 0xc2, 0xa3, 0xb3, 0x78, 0x4c, 0xc1, 0xef, 0xaa, 0x4b, 0x48, 0xe1, 0x4f,
+	0x27, 0xfe, 0x25, 0x03, 0xb5, 0xf4, 0x99, 0x8c, 0x34, 0xa0, 0x6a, 0x3b, 0x1d, 0xda, 0xf4, 0xa9,
+	0x45, 0xdb, 0x81, 0xe3, 0x89, 0x48, 0xf7, 0x60, 0xcc, 0xf9, 0x6d, 0xed, 0x95, 0xd3, 0xa1, 0x47,
+	0x42, 0x8e, 0x43, 0x32, 0x15, 0x3b, 0x41, 0x22, 0x6b, 0xb0, 0x20, 0x0e, 0x2e, 0x67, 0xcd, 0xb6,
+	0x65, 0xf8, 0x3e, 0xdf, 0x92, 0xfc, 0x8e, 0x7e, 0x3e, 0x62, 0xed, 0x20, 0x07, 0xf7, 0x65, 0xfd,
+	0x0b, 0x98, 0x1f, 0x69, 0xf2, 0x42, 0xcf, 0xa6, 0xff, 0x22, 0x03, 0xca, 0xf0, 0xe9, 0x07, 0x75,
+	0xc3, 0xa1, 0x10, 0xd1, 0x86, 0x28, 0x91, 0x4d, 0x90, 0x0c, 0xaf, 0x17, 0x85, 0xe7, 0x95, 0xb1,
+	0x47, 0xa7, 0xb5, 0x2d, 0xaf, 0x27, 0xd0, 0x26, 0x26, 0x5c, 0xff, 0x14, 0x4a, 0x31, 0xe9, 0x42,
+	0x43, 0xfb, 0xa3, 0x0c, 0xc0, 0xe0, 0x88, 0x75, 0xce, 0x5b, 0xac, 0x4d, 0x28, 0xa2, 0x93, 0x74,
+	0xba, 0xdd, 0xe9, 0xcf, 0x94, 0x22, 0x49, 0x3c, 0x69, 0xe2, 0x79, 0xee, 0x8c, 0x41, 0x2f, 0x3c,
+	0x9a, 0x46, 0x0e, 0x5f, 0x89, 0x19, 0x3a, 0xa7, 0x6b, 0xff, 0x58, 0x81, 0x25, 0x7e, 0x2e, 0x8a,
+	0x23, 0xca, 0xc5, 0xd3, 0xb8, 0x01, 0x7c, 0x7a, 0x6f, 0x06, 0xf8, 0xf4, 0x62, 0xd0, 0xec, 0x38,
+	0xb0, 0xb5, 0x78, 0x39, 0xb0, 0xb5, 0x74, 0x3e, 0xd8, 0xba, 0x0c, 0x85, 0x90, 0xe5, 0x37, 0x51,
+	0x68, 0xe3, 0xa5, 0x51, 0x48, 0x10, 0xc6, 0x40, 0x82, 0x03, 0xb8, 0xe1, 0x7e, 0x12, 0x6e, 0x18,
+	0x8b, 0x14, 0x56, 0xae, 0x84, 0x14, 0x2e, 0x7f, 0x0f, 0x48, 0xe1, 0xfa, 0x65, 0x91, 0xc2, 0xea,
+	0x8c, 0x48, 0x61, 0x6d, 0x1a, 0x52, 0xa8, 0x4c, 0x43, 0x0a, 0xe7, 0x47, 0x91, 0xc2, 0x5b, 0x50,
+	0x8a, 0xa1, 0x17, 0x76, 0xbd, 0x2c, 0xeb, 0x03, 0xc2, 0x18, 0x6c, 0x70, 0x71, 0x32, 0x36, 0xb8,
+	0x34, 0x13, 0x36, 0x78, 0x77, 0x36, 0x6c, 0xf0, 0xfa, 0x85, 0xb1, 0x41, 0xf5, 0x4a, 0xd8, 0xe0,
+	0x8d, 0x8b, 0x60, 0x83, 0x11, 0xc4, 0x5a, 0x4f, 0x40, 0xac, 0x09, 0x40, 0xef, 0xe6, 0x44, 0x40,
+	0xef, 0xd6, 0x2c, 0x80, 0xde, 0xed, 0xcb, 0x01, 0x7a, 0x77, 0x26, 0x00, 0x7a, 0xab, 0x43, 0x80,
+	0xde, 0x10, 0x5e, 0xa9, 0x4d, 0xc6, 0x2b, 0x93, 0x38, 0xdf, 0xda, 0x45, 0x71, 0xbe, 0x8f, 0x67,
+	0xc5, 0xf9, 0x36, 0x2e, 0x80, 0xf3, 0x6d, 0x5e, 0x18, 0xe7, 0xfb, 0x64, 0x46, 0x9c, 0xef, 0xe9,
+	0xa5, 0x70, 0xbe, 0x67, 0x53, 0x71, 0xbe, 0x21, 0xbc, 0x83, 0x63, 0x19, 0x1c, 0xb9, 0xe0, 0x38,
+	0xc5, 0x13, 0xe5, 0x63, 0x6d, 0x07, 0x96, 0x45, 0x5a, 0x77, 0xf9, 0xe0, 0xa1, 0xfd, 0x75, 0x06,
+	0x16, 0x30, 0xc7, 0xbb, 0x42, 0xfc, 0x49, 0x1c, 0xf2, 0xb3, 0xe9, 0x43, 0xfe, 0x23, 0x50, 0x0c,
+	0x3c, 0x8b, 0x34, 0x4d, 0xbb, 0xed, 0xf4, 0x5d, 0xf6, 0x03, 0x05, 0xfe, 0x48, 0x7d, 0x8e, 0xd1,
+	0xf7, 0x63, 0x72, 0xea, 0xec, 0x2f, 0x0d, 0x9d, 0xfd, 0xff, 0x2c, 0x03, 0x4b, 0xfc, 0x40, 0x7e,
+	0x85, 0x51, 0x2a, 0x90, 0x33, 0x62, 0xf4, 0x04, 0x3f, 0x31, 0xe8, 0x77, 0x1d, 0xaf, 0x1d, 0x05,
+	0x1d, 0x5e, 0xc0, 0x9d, 0x70, 0x42, 0xa9, 0xcb, 0x5f, 0xd2, 0xf0, 0x5f, 0x53, 0xc8, 0x48, 0xd0,
+	0xa9, 0xeb, 0x34, 0x24, 0x39, 0xab, 0xe4, 0xc4, 0x9b, 0xc4, 0x2d, 0x58, 0x3c, 0xc2, 0x4c, 0xfd,
+	0x0a, 0xca, 0xff, 0x29, 0x2c, 0x20, 0x70, 0x70, 0x85, 0x16, 0xfe, 0x00, 0xae, 0xeb, 0x8e, 0x65,
+	0x61, 0xf2, 0x71, 0xb5, 0x15, 0x8c, 0x2e, 0x72, 0xb3, 0xe9, 0x8b, 0xdc, 0x94, 0xe3, 0xcf, 0x0d,
+	0x39, 0x7e, 0xed, 0xaf, 0x32, 0x40, 0xf4, 0xd0, 0xbe, 0x42, 0xcf, 0x4f, 0x01, 0x5c, 0xcf, 0x39,
+	0xa5, 0xb6, 0x81, 0x67, 0x4d, 0x9e, 0xfb, 0x2d, 0x25, 0x3c, 0xcb, 0x61, 0xcc, 0xd4, 0x13, 0x82,
+	0x89, 0x23, 0xa3, 0x34, 0xfe, 0xc8, 0x28, 0xd6, 0xe8, 0x27, 0x50, 0xd3, 0x43, 0x1b, 0x7f, 0xfd,
+	0x71, 0x09, 0xdd, 0x3e, 0x82, 0x05, 0x9e, 0x9b, 0xf1, 0x5f, 0xc2, 0x44, 0x2d, 0x90, 0xc4, 0x71,
+	0xa6, 0xc2, 0xcf, 0x30, 0xda, 0x73, 0x58, 0xe0, 0x06, 0x9a, 0x16, 0xbd, 0x07, 0x05, 0xf1, 0xf3,
+	0x9a, 0x4c, 0x22, 0xf9, 0x11, 0x32, 0x82, 0xa5, 0xfd, 0x04, 0x16, 0xc5, 0x36, 0xbe, 0x44, 0xe5,
+	0x5b, 0x50, 0xe0, 0x94, 0xb1, 0x4f, 0x2b, 0xfe, 0x24, 0x03, 0xc0, 0xd9, 0xec, 0x7c, 0x34, 0x4b,
+	0x8b, 0xf1, 0xfb, 0xda, 0x6c, 0xe2, 0x7d, 0xed, 0x3e, 0xfb, 0xc9, 0x10, 0x8b, 0x6e, 0xcd, 0xf8,
+	0x17, 0xda, 0x6a, 0x6e, 0xea, 0x61, 0x77, 0x3e, 0xaa, 0x15, 0x93, 0xb4, 0x2f, 0xa0, 0x3c, 0x18,
+	0x11, 0x82, 0x73, 0x65, 0xde, 0x6f, 0xf2, 0x3a, 0x61, 0x2e, 0x31, 0x2e, 0x14, 0xd3, 0xc1, 0x8f,
+	0xbf, 0xb5, 0xe7, 0xb0, 0xf4, 0xd2, 0xf0, 0x5a, 0x46, 0x8f, 0xee, 0x38, 0x16, 0x9e, 0x4c, 0x22,
+	0x7d, 0xdd, 0x85, 0x0a, 0x7f, 0x67, 0x2c, 0x8e, 0x57, 0x3c, 0x95, 0x2f, 0x73, 0x1a, 0x3f, 0x60,
+	0xa9, 0xb0, 0x3c, 0x5c, 0xd7, 0x77, 0x1d, 0xdb, 0xa7, 0xda, 0x12, 0x2c, 0x6c, 0xb5, 0x03, 0xf3,
+	0xd4, 0x08, 0xe8, 0x56, 0x18, 0x1c, 0x8b, 0x36, 0xb5, 0x65, 0x58, 0x4c, 0x93, 0xb9, 0xf8, 0x63,
+	0x8f, 0xfd, 0x94, 0x87, 0xe3, 0xb2, 0x0a, 0x54, 0x1a, 0x5f, 0x6e, 0x37, 0x8f, 0x5e, 0x6f, 0xe9,
+	0xaf, 0xf7, 0x5f, 0xbd, 0x54, 0xae, 0x91, 0x39, 0x28, 0x23, 0x45, 0x7f, 0xf3, 0xea, 0x15, 0x12,
+	0x32, 0x11, 0xe1, 0xc5, 0xd6, 0xfe, 0xc1, 0x1b, 0x7d, 0x4f, 0xc9, 0x46, 0x84, 0xa3, 0x37, 0x3b,
+	0x3b, 0x7b, 0x47, 0x47, 0x4a, 0x8e, 0xd4, 0x00, 0x90, 0xf0, 0xb3, 0xfd, 0x83, 0x83, 0xbd, 0x5d,
+	0x45, 0x22, 0xf3, 0x50, 0xc5, 0xf2, 0xde, 0x4b, 0x7d, 0xef, 0xe8, 0x08, 0x1b, 0x29, 0x3c, 0xfe,
+	0x12, 0x60, 0xf0, 0x33, 0x16, 0x02, 0x50, 0xc0, 0xe6, 0xf6, 0x76, 0x95, 0x6b, 0xa4, 0x0c, 0xc5,
+	0xa8, 0xa5, 0x0c, 0x2b, 0xfc, 0x6c, 0xff, 0xf0, 0x70, 0x6f, 0x57, 0xc9, 0x92, 0x0a, 0xc8, 0xf1,
+	0xb8, 0x72, 0xa4, 0x0a, 0x25, 0x7d, 0x6f, 0xe7, 0xcb, 0x5f, 0xec, 0xe9, 0xd8, 0xc7, 0xe3, 0x2f,
+	0xa0, 0x9c, 0x78, 0xae, 0x83, 0x63, 0x3a, 0xfc, 0x72, 0x37, 0x1e, 0xf5, 0xb5, 0x88, 0x30, 0x68,
+	0xba, 0x06, 0x80, 0x04, 0xd1, 0x6f, 0xf6, 0xf1, 0xdf, 0x64, 0x06, 0xf7, 0x43, 0xbc, 0x8d, 0x25,
+	0x98, 0x3f, 0xdc, 0x3f, 0xdc, 0x3b, 0xd8, 0x7f, 0xb5, 0x97, 0x54, 0xc8, 0x22, 0x28, 0x31, 0x79,
+	0xa0, 0x95, 0xeb, 0xb0, 0x30, 0xa0, 0xee, 0xc5, 0xe2, 0xd9, 0x94, 0x78, 0xa4, 0xb3, 0x1c, 0x59,
+	0x80, 0xb9, 0x98, 0x7a, 0xb8, 0xf5, 0xe6, 0x88, 0xe9, 0x29, 0x29, 0x7a, 0xf4, 0x7a, 0xeb, 0xd5,
+	0xee, 0xf6, 0xef, 0x2a, 0xf9, 0xd4, 0x30, 0x76, 0xf4, 0xad, 0xa3, 0xdf, 0x66, 0x1a, 0xdc, 0xf8,
+	0xdb, 0x2a, 0xe4, 0xb6, 0x0e, 0xf7, 0xc9, 0x1a, 0x94, 0xf8, 0xc6, 0xc6, 0xf3, 0xd0, 0x92, 0xf8,
+	0x81, 0x58, 0xfa, 0x72, 0xaa, 0x1e, 0x23, 0x1b, 0xda, 0x35, 0xf2, 0x09, 0xc0, 0x00, 0xfd, 0x27,
+	0xcb, 0x22, 0x05, 0x1f, 0xba, 0x0e, 0xa8, 0x57, 0xa2, 0x1a, 0xcc, 0x4c, 0xaf, 0x91, 0x27, 0x50,
+	0x14, 0xd0, 0x3c, 0xe1, 0xd9, 0x59, 0x1a, 0xa8, 0x1f, 0x96, 0x7f, 0x92, 0x21, 0x1b, 0x20, 0x47,
+	0x18, 0x37, 0xe1, 0xc7, 0xab, 0x21, 0xc8, 0x7b, 0x4c, 0x9d, 0xcf, 0xa0, 0x14, 0x63, 0xd5, 0x62,
+	0x2e, 0xc3, 0xd8, 0x75, 0x7d, 0x79, 0x64, 0x8b, 0xee, 0xe1, 0x0f, 0x65, 0xb5, 0x6b, 0xe4, 0x47,
+	0x50, 0x14, 0xc8, 0xb5, 0x18, 0x63, 0x1a, 0xc7, 0x9e, 0x50, 0xf3, 0x39, 0x54, 0x92, 0x98, 0x12,
+	0x51, 0x93, 0x5a, 0x49, 0x02, 0x46, 0xf5, 0xda, 0x00, 0x42, 0x11, 0x9a, 0x79, 0x06, 0xa5, 0x18,
+	0x56, 0x12, 0x63, 0x1e, 0x86, 0x99, 0x46, 0x6b, 0x3d, 0xc9, 0x90, 0x6d, 0xf6, 0xcb, 0x83, 0x18,
+	0x1d, 0x13, 0x7d, 0x8e, 0x01, 0xcc, 0x26, 0x8c, 0xfb, 0xa7, 0x50, 0x4e, 0xc0, 0x46, 0x84, 0xff,
+	0xa3, 0xc2, 0x28, 0x68, 0x55, 0x5f, 0x1c, 0x66, 0xc4, 0xa3, 0x78, 0x01, 0xb5, 0xf4, 0x91, 0x9d,
+	0xd4, 0x13, 0x26, 0x34, 0x14, 0x0b, 0x27, 0x8c, 0x64, 0x07, 0xe6, 0x86, 0xd2, 0x37, 0x72, 0x33,
+	0xa9, 0xc4, 0xe1, 0x96, 0x46, 0x2f, 0x59, 0xb5, 0x6b, 0xe4, 0x73, 0xa8, 0x24, 0xb3, 0x37, 0xa1,
+	0x92, 0x31, 0x09, 0x5d, 0x9d, 0x8c, 0x54, 0xf7, 0xb5, 0x6b, 0x38, 0x99, 0x74, 0x66, 0x25, 0x26,
+	0x33, 0x36, 0xdd, 0x9a, 0x30, 0x99, 0x5d, 0xa8, 0xa6, 0x92, 0x21, 0x72, 0x43, 0x98, 0xd3, 0x68,
+	0x82, 0x34, 0xa1, 0x95, 0x6d, 0xa8, 0x24, 0xf3, 0x21, 0x31, 0x9b, 0x31, 0x29, 0xd2, 0xe4, 0x05,
+	0x4e, 0xa4, 0x24, 0x62, 0x81, 0x47, 0x93, 0x94, 0x09, 0x2d, 0x34, 0x40, 0x19, 0xce, 0xa9, 0xc8,
+	0x2d, 0xde, 0xcc, 0xf8, 0x54, 0x6b, 0xf2, 0x06, 0x13, 0x09, 0x88, 0xd8, 0x60, 0xe9, 0x74, 0x64,
+	0xb2, 0x2e, 0x92, 0xd9, 0x87, 0xd0, 0xc5, 0x98, 0x84, 0x64, 0x72, 0x1b, 0xc9, 0xb4, 0x44, 0xb4,
+	0x31, 0x26, 0x53, 0x99, 0x38, 0x03, 0x40, 0x73, 0x12, 0x2d, 0x9c, 0x23, 0x57, 0x57, 0x86, 0x42,
+	0x36, 0xda, 0xd6, 0x6f, 0x41, 0x35, 0x95, 0xd8, 0x08, 0x9b, 0x18, 0x97, 0xec, 0xd4, 0x87, 0x43,
+	0x3e, 0xab, 0x2e, 0x3c, 0xdb, 0x96, 0x65, 0x9d, 0xdb, 0xef, 0xf9, 0xe3, 0xde, 0x84, 0xa2, 0xb8,
+	0xcc, 0x11, 0x9a, 0x4f, 0x5f, 0xed, 0x88, 0x1e, 0x07, 0x77, 0x15, 0x6c, 0x6f, 0xef, 0x41, 0x25,
+	0x19, 0xef, 0x85, 0xc2, 0xc6, 0x64, 0x06, 0xf5, 0x1b, 0x63, 0x38, 0x22, 0x97, 0x60, 0xbb, 0x2a,
+	0x7d, 0x5f, 0x27, 0x76, 0xd5, 0xd8, 0x4b, 0xbc, 0xf3, 0xe7, 0xb0, 0xfd, 0xe9, 0xaf, 0xdf, 0xdf,
+	0xc9, 0xfc, 0xeb, 0xfb, 0x3b, 0x99, 0x7f, 0x7f, 0x7f, 0x27, 0xf3, 0x7b, 0x8f, 0xf0, 0x09, 0x51,
+	0xd8, 0x5a, 0x6b, 0x3b, 0xfd, 0x75, 0xd7, 0x68, 0x1f, 0x9f, 0x75, 0xa8, 0x97, 0xfc, 0x3a, 0xdd,
+	0x58, 0xf7, 0xbd, 0x36, 0xfe, 0xff, 0x4e, 0xab, 0xc0, 0x9a, 0xda, 0xfc, 0xdf, 0x01, 0x00, 0xa4,
+	0x94, 0x20, 0xd1, 0x91, 0x47, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5848,6 +6653,9 @@ type APIClient interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumClient, error)
 	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// FileLineage returns the datums and files that a file was derived from, or
+	// that were derived from it.
+	FileLineage(ctx context.Context, in *FileLineageRequest, opts ...grpc.CallOption) (API_FileLineageClient, error)
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
@@ -5855,6 +6663,11 @@ type APIClient interface {
 	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	RunPipeline(ctx context.Context, in *RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RollbackPipeline re-applies an older version of a pipeline as its newest
+	// version.
+	RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RunCron makes a tick on each of a pipeline's cron inputs and runs the
+	// query of each of its SQL inputs.
 	RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*types.Empty, error)
@@ -6028,6 +6841,38 @@ func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) FileLineage(ctx context.Context, in *FileLineageRequest, opts ...grpc.CallOption) (API_FileLineageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pps.API/FileLineage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFileLineageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_FileLineageClient interface {
+	Recv() (*FileLineageInfo, error)
+	grpc.ClientStream
+}
+
+type aPIFileLineageClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFileLineageClient) Recv() (*FileLineageInfo, error) {
+	m := new(FileLineageInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/pps.API/CreatePipeline", in, out, opts...)
@@ -6091,6 +6936,15 @@ func (c *aPIClient) RunPipeline(ctx context.Context, in *RunPipelineRequest, opt
 	return out, nil
 }
 
+func (c *aPIClient) RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/RollbackPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) RunCron(ctx context.Context, in *RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/pps.API/RunCron", in, out, opts...)
@@ -6146,7 +7000,7 @@ func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc
 }
 
 func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pps.API/GetLogs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pps.API/GetLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6208,6 +7062,9 @@ type APIServer interface {
 	// ListDatum returns information about each datum fed to a Pachyderm job
 	ListDatum(*ListDatumRequest, API_ListDatumServer) error
 	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
+	// FileLineage returns the datums and files that a file was derived from, or
+	// that were derived from it.
+	FileLineage(*FileLineageRequest, API_FileLineageServer) error
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
@@ -6215,6 +7072,11 @@ type APIServer interface {
 	StartPipeline(context.Context, *StartPipelineRequest) (*types.Empty, error)
 	StopPipeline(context.Context, *StopPipelineRequest) (*types.Empty, error)
 	RunPipeline(context.Context, *RunPipelineRequest) (*types.Empty, error)
+	// RollbackPipeline re-applies an older version of a pipeline as its newest
+	// version.
+	RollbackPipeline(context.Context, *RollbackPipelineRequest) (*types.Empty, error)
+	// RunCron makes a tick on each of a pipeline's cron inputs and runs the
+	// query of each of its SQL inputs.
 	RunCron(context.Context, *RunCronRequest) (*types.Empty, error)
 	CreateSecret(context.Context, *CreateSecretRequest) (*types.Empty, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*types.Empty, error)
@@ -6261,6 +7123,9 @@ func (*UnimplementedAPIServer) ListDatum(req *ListDatumRequest, srv API_ListDatu
 func (*UnimplementedAPIServer) RestartDatum(ctx context.Context, req *RestartDatumRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartDatum not implemented")
 }
+func (*UnimplementedAPIServer) FileLineage(req *FileLineageRequest, srv API_FileLineageServer) error {
+	return status.Errorf(codes.Unimplemented, "method FileLineage not implemented")
+}
 func (*UnimplementedAPIServer) CreatePipeline(ctx context.Context, req *CreatePipelineRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
 }
@@ -6282,6 +7147,9 @@ func (*UnimplementedAPIServer) StopPipeline(ctx context.Context, req *StopPipeli
 func (*UnimplementedAPIServer) RunPipeline(ctx context.Context, req *RunPipelineRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunPipeline not implemented")
 }
+func (*UnimplementedAPIServer) RollbackPipeline(ctx context.Context, req *RollbackPipelineRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPipeline not implemented")
+}
 func (*UnimplementedAPIServer) RunCron(ctx context.Context, req *RunCronRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunCron not implemented")
 }
@@ -6485,6 +7353,27 @@ func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_FileLineage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FileLineageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).FileLineage(m, &aPIFileLineageServer{stream})
+}
+
+type API_FileLineageServer interface {
+	Send(*FileLineageInfo) error
+	grpc.ServerStream
+}
+
+type aPIFileLineageServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFileLineageServer) Send(m *FileLineageInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePipelineRequest)
 	if err := dec(in); err != nil {
@@ -6611,6 +7500,24 @@ func _API_RunPipeline_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_RollbackPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RollbackPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/RollbackPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RollbackPipeline(ctx, req.(*RollbackPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_RunCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunCronRequest)
 	if err := dec(in); err != nil {
@@ -6832,6 +7739,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunPipeline",
 			Handler:    _API_RunPipeline_Handler,
 		},
+		{
+			MethodName: "RollbackPipeline",
+			Handler:    _API_RollbackPipeline_Handler,
+		},
 		{
 			MethodName: "RunCron",
 			Handler:    _API_RunCron_Handler,
@@ -6881,6 +7792,11 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_ListDatum_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "FileLineage",
+			Handler:       _API_FileLineage_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetLogs",
 			Handler:       _API_GetLogs_Handler,
@@ -6969,6 +7885,18 @@ func (m *Transform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Persistent {
+		i--
+		if m.Persistent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.Build != nil {
 		{
 			size, err := m.Build.MarshalToSizedBuffer(dAtA[:i])
@@ -7684,7 +8612,7 @@ func (m *GitInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
+func (m *SQLInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7694,12 +8622,12 @@ func (m *Input) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+func (m *SQLInput) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SQLInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7708,79 +8636,282 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Group) > 0 {
-		for iNdEx := len(m.Group) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Group[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	if m.RowsPerFile != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.RowsPerFile))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Join) > 0 {
-		for iNdEx := len(m.Join) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Join[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Pfs != nil {
-		{
-			size, err := m.Pfs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Query)))
 		i--
 		dAtA[i] = 0x32
 	}
-	if m.Git != nil {
-		{
-			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.PasswordSecret) > 0 {
+		i -= len(m.PasswordSecret)
+		copy(dAtA[i:], m.PasswordSecret)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PasswordSecret)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Cron != nil {
-		{
-			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Union) > 0 {
-		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Union[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CredentialsSecret) > 0 {
+		i -= len(m.CredentialsSecret)
+		copy(dAtA[i:], m.CredentialsSecret)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CredentialsSecret)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Glob) > 0 {
+		i -= len(m.Glob)
+		copy(dAtA[i:], m.Glob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Bucket != nil {
+		{
+			size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Group) > 0 {
+		for iNdEx := len(m.Group) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Group[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Join) > 0 {
+		for iNdEx := len(m.Join) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Join[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Pfs != nil {
+		{
+			size, err := m.Pfs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Git != nil {
+		{
+			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Cron != nil {
+		{
+			size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Union) > 0 {
+		for iNdEx := len(m.Union) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Union[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
 			}
 			i--
 			dAtA[i] = 0x1a
@@ -8313,6 +9444,20 @@ func (m *WorkerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Datums) > 0 {
+		for iNdEx := len(m.Datums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.DataRecovered != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
 		i--
@@ -8383,7 +9528,7 @@ func (m *WorkerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
+func (m *DatumStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8393,12 +9538,12 @@ func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *DatumStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DatumStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8407,9 +9552,9 @@ func (m *ResourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Gpu != nil {
+	if m.Started != nil {
 		{
-			size, err := m.Gpu.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8417,25 +9562,78 @@ func (m *ResourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Disk) > 0 {
-		i -= len(m.Disk)
-		copy(dAtA[i:], m.Disk)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Disk)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Memory) > 0 {
-		i -= len(m.Memory)
-		copy(dAtA[i:], m.Memory)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.Cpu != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Cpu))))
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Gpu != nil {
+		{
+			size, err := m.Gpu.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Disk) > 0 {
+		i -= len(m.Disk)
+		copy(dAtA[i:], m.Disk)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Disk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Memory) > 0 {
+		i -= len(m.Memory)
+		copy(dAtA[i:], m.Memory)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Cpu != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Cpu))))
 		i--
 		dAtA[i] = 0xd
 	}
@@ -9289,6 +10487,64 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.JobRetries != nil {
+		{
+			size, err := m.JobRetries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.PrioritizeNewestJobs {
+		i--
+		if m.PrioritizeNewestJobs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.DatumConcurrency != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumConcurrency))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.PropagateLabels) > 0 {
+		for iNdEx := len(m.PropagateLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PropagateLabels[iNdEx])
+			copy(dAtA[i:], m.PropagateLabels[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.PropagateLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if len(m.ReprocessSpec) > 0 {
 		i -= len(m.ReprocessSpec)
 		copy(dAtA[i:], m.ReprocessSpec)
@@ -10687,6 +11943,128 @@ func (m *ListDatumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FileLineageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileLineageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileLineageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Depth != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Downstream {
+		i--
+		if m.Downstream {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FileLineageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileLineageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileLineageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Depth != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Datum != nil {
+		{
+			size, err := m.Datum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChunkSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10777,7 +12155,7 @@ func (m *SchedulingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10787,12 +12165,12 @@ func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PipelineTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10801,27 +12179,191 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ReprocessSpec) > 0 {
-		i -= len(m.ReprocessSpec)
-		copy(dAtA[i:], m.ReprocessSpec)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x8a
-	}
-	if m.SidecarResourceLimits != nil {
-		{
-			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+	if len(m.Args) > 0 {
+		for k := range m.Args {
+			v := m.Args[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRetries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRetries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRetries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RetryableReasons) > 0 {
+		for iNdEx := len(m.RetryableReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryableReasons[iNdEx])
+			copy(dAtA[i:], m.RetryableReasons[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.RetryableReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Count != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.JobRetries != nil {
+		{
+			size, err := m.JobRetries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.PrioritizeNewestJobs {
+		i--
+		if m.PrioritizeNewestJobs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DatumConcurrency != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumConcurrency))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PropagateLabels) > 0 {
+		for iNdEx := len(m.PropagateLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PropagateLabels[iNdEx])
+			copy(dAtA[i:], m.PropagateLabels[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.PropagateLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ReprocessSpec) > 0 {
+		i -= len(m.ReprocessSpec)
+		copy(dAtA[i:], m.ReprocessSpec)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ReprocessSpec)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SidecarResourceLimits != nil {
+		{
+			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x2
-		i--
 		dAtA[i] = 0xfa
 	}
 	if m.Metadata != nil {
@@ -11408,6 +12950,60 @@ func (m *StopPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollbackPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reprocess {
+		i--
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pipeline != nil {
+		{
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RunPipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11984,6 +13580,9 @@ func (m *Transform) Size() (n int) {
 		l = m.Build.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Persistent {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12251,47 +13850,46 @@ func (m *GitInput) Size() (n int) {
 	return n
 }
 
-func (m *Input) Size() (n int) {
+func (m *SQLInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Cross) > 0 {
-		for _, e := range m.Cross {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Union) > 0 {
-		for _, e := range m.Union {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Cron != nil {
-		l = m.Cron.Size()
+	l = len(m.Commit)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Git != nil {
-		l = m.Git.Size()
+	l = len(m.URL)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Pfs != nil {
-		l = m.Pfs.Size()
+	l = len(m.PasswordSecret)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Join) > 0 {
-		for _, e := range m.Join {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Group) > 0 {
-		for _, e := range m.Group {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.RowsPerFile != 0 {
+		n += 1 + sovPps(uint64(m.RowsPerFile))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12299,34 +13897,133 @@ func (m *Input) Size() (n int) {
 	return n
 }
 
-func (m *JobInput) Size() (n int) {
+func (m *BucketInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.Glob)
+	l = len(m.Repo)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Lazy {
-		n += 2
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.URL)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	return n
-}
-
-func (m *ParallelismSpec) Size() (n int) {
+	if m.Delete {
+		n += 2
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.CredentialsSecret)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cross) > 0 {
+		for _, e := range m.Cross {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Union) > 0 {
+		for _, e := range m.Union {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Cron != nil {
+		l = m.Cron.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pfs != nil {
+		l = m.Pfs.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Join) > 0 {
+		for _, e := range m.Join {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Group) > 0 {
+		for _, e := range m.Group {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Bucket != nil {
+		l = m.Bucket.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ParallelismSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12543,6 +14240,34 @@ func (m *WorkerStatus) Size() (n int) {
 	if m.DataRecovered != 0 {
 		n += 1 + sovPps(uint64(m.DataRecovered))
 	}
+	if len(m.Datums) > 0 {
+		for _, e := range m.Datums {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatumStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13059,6 +14784,26 @@ func (m *PipelineInfo) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if len(m.PropagateLabels) > 0 {
+		for _, s := range m.PropagateLabels {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.DatumConcurrency != 0 {
+		n += 2 + sovPps(uint64(m.DatumConcurrency))
+	}
+	if m.PrioritizeNewestJobs {
+		n += 3
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobRetries != nil {
+		l = m.JobRetries.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13459,6 +15204,55 @@ func (m *ListDatumRequest) Size() (n int) {
 	return n
 }
 
+func (m *FileLineageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Downstream {
+		n += 2
+	}
+	if m.Depth != 0 {
+		n += 1 + sovPps(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileLineageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovPps(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ChunkSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13501,6 +15295,55 @@ func (m *SchedulingSpec) Size() (n int) {
 	return n
 }
 
+func (m *PipelineTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobRetries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
+	}
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.RetryableReasons) > 0 {
+		for _, s := range m.RetryableReasons {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CreatePipelineRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13624,6 +15467,26 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if len(m.PropagateLabels) > 0 {
+		for _, s := range m.PropagateLabels {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.DatumConcurrency != 0 {
+		n += 2 + sovPps(uint64(m.DatumConcurrency))
+	}
+	if m.PrioritizeNewestJobs {
+		n += 3
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobRetries != nil {
+		l = m.JobRetries.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13729,6 +15592,28 @@ func (m *StopPipelineRequest) Size() (n int) {
 	return n
 }
 
+func (m *RollbackPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.Reprocess {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RunPipelineRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14723,20 +16608,40 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistent", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Persistent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
 
@@ -16491,7 +18396,7 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *SQLInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16514,17 +18419,17 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16534,31 +18439,61 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16568,31 +18503,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16602,33 +18535,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16638,33 +18567,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitInput{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PasswordSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16674,33 +18599,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pfs == nil {
-				m.Pfs = &PFSInput{}
-			}
-			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16710,31 +18631,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Join = append(m.Join, &Input{})
-			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16744,26 +18663,43 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = append(m.Group, &Input{})
-			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsPerFile", wireType)
+			}
+			m.RowsPerFile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsPerFile |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16786,7 +18722,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInput) Unmarshal(dAtA []byte) error {
+func (m *BucketInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16809,17 +18745,17 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: BucketInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BucketInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16829,31 +18765,27 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16881,13 +18813,13 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16897,15 +18829,27 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16933,64 +18877,13 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
-			}
-			m.Constant = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17000,76 +18893,51 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Coefficient = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.Interval == nil {
+				m.Interval = &types.Duration{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17097,13 +18965,13 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsSecret", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17113,25 +18981,23 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.CredentialsSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17155,7 +19021,7 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Datum) Unmarshal(dAtA []byte) error {
+func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17178,17 +19044,17 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17198,27 +19064,29 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17245,67 +19113,14 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17332,18 +19147,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17353,15 +19168,32 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitInput{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -17387,16 +19219,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Pfs == nil {
+				m.Pfs = &PFSInput{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17423,16 +19255,14 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
-			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17459,67 +19289,52 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Group = append(m.Group, &Input{})
+			if err := m.Group[len(m.Group)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SQL == nil {
+				m.SQL = &SQLInput{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Count = 0
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17529,55 +19344,28 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			if m.Bucket == nil {
+				m.Bucket = &BucketInput{}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17600,7 +19388,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+func (m *JobInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17623,15 +19411,15 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17658,18 +19446,18 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &types.Duration{}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17679,33 +19467,29 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &types.Duration{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17715,33 +19499,17 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UploadTime == nil {
-				m.UploadTime = &types.Duration{}
-			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Lazy = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.DownloadBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17751,30 +19519,24 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownloadBytes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
-			}
-			m.UploadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17797,7 +19559,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17820,17 +19582,17 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
 			}
-			var msglen int
+			m.Constant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17840,33 +19602,78 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Coefficient = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17876,33 +19683,29 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17912,33 +19715,82 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Datum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17948,31 +19800,27 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
-			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17999,10 +19847,10 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18028,7 +19876,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18051,17 +19899,17 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18071,29 +19919,33 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18103,27 +19955,14 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= DatumState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18150,14 +19989,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18184,16 +20025,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18220,70 +20061,11 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
-			}
-			m.QueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueueSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
-			}
-			m.DataRecovered = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18306,7 +20088,7 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18329,28 +20111,17 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18360,92 +20131,55 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Disk = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpu == nil {
-				m.Gpu = &GPUSpec{}
-			}
-			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18468,7 +20202,7 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18491,17 +20225,17 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18511,29 +20245,33 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.DownloadTime == nil {
+				m.DownloadTime = &types.Duration{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			m.Number = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18543,34 +20281,125 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			if m.ProcessTime == nil {
+				m.ProcessTime = &types.Duration{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadTime == nil {
+				m.UploadTime = &types.Duration{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18593,15 +20422,15 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EtcdJobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EtcdJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18628,16 +20457,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18664,16 +20493,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18700,111 +20529,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
-			}
-			m.DataSkipped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
-			}
-			m.DataTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
-			}
-			m.DataFailed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18831,16 +20565,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18867,35 +20601,99 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
 			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18923,9 +20721,43 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
@@ -18961,9 +20793,9 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18990,14 +20822,52 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+			}
+			m.QueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			}
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
@@ -19016,6 +20886,40 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datums = append(m.Datums, &DatumStatus{})
+			if err := m.Datums[len(m.Datums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -19038,7 +20942,7 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *DatumStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19061,15 +20965,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19096,16 +21000,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19132,56 +21034,82 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowPps
 				}
 				if iNdEx >= l {
@@ -19189,33 +21117,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
-			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19225,31 +21149,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Disk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19276,18 +21196,69 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.Gpu == nil {
+				m.Gpu = &GPUSpec{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19297,33 +21268,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.State = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19333,14 +21300,65 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EtcdJobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EtcdJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19367,18 +21385,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			m.PipelineVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19388,32 +21406,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineVersion |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -19422,16 +21421,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19458,18 +21457,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var stringLen uint64
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19479,29 +21478,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19511,33 +21497,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
-			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			m.Restart = 0
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19547,16 +21516,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			m.DataProcessed = 0
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19566,16 +21535,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			m.DataTotal = 0
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19585,14 +21554,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19619,14 +21588,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19653,18 +21624,37 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19674,31 +21664,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19725,16 +21711,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.BranchInfo{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19761,18 +21747,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
 			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			m.DataSkipped = 0
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19782,14 +21768,65 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19816,38 +21853,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableStats = bool(v != 0)
-		case 33:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19857,29 +21874,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 35:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19889,27 +21910,31 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 36:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19936,16 +21961,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19972,16 +21997,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20008,16 +22033,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20044,18 +22069,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.DataFailed = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20065,16 +22090,52 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 41:
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
 			}
-			m.DatumTries = 0
+			m.PipelineVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20084,14 +22145,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				m.PipelineVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 42:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20118,18 +22179,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 43:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20139,27 +22200,31 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 44:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20187,11 +22252,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 45:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20218,18 +22283,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
 			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 46:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			m.DataRecovered = 0
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20239,16 +22304,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 47:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20258,31 +22323,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 48:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20309,69 +22376,52 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
-			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Worker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20381,29 +22431,33 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20413,67 +22467,33 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= WorkerState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.BranchInfo{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20483,80 +22503,33 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EtcdPipelineInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EtcdPipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			m.State = 0
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20566,14 +22539,14 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= PipelineState(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20600,18 +22573,18 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20621,7 +22594,1356 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableStats = bool(v != 0)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
+			}
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
+			}
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			}
+			m.DataFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataFailed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
+			}
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
+			}
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+			}
+			m.DataRecovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
+			}
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
+			}
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= WorkerState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EtcdPipelineInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EtcdPipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PipelineState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
+			}
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.JobCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+			}
+			m.LastJobState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastJobState |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PipelineState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.JobCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20636,79 +23958,204 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobCounts == nil {
-				m.JobCounts = make(map[int32]int32)
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			var mapkey int32
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
+			}
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.JobCounts[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
+			m.EnableStats = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20736,11 +24183,11 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20768,13 +24215,13 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
 			}
-			m.LastJobState = 0
+			m.MaxQueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20784,16 +24231,16 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastJobState |= JobState(b&0x7F) << shift
+				m.MaxQueueSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			m.Parallelism = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20803,65 +24250,31 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parallelism |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20888,16 +24301,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20924,16 +24337,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20960,18 +24373,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20981,14 +24394,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= PipelineState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
+			}
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithookURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21016,11 +24446,11 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
+			m.GithookURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21047,81 +24477,38 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobCounts == nil {
-				m.JobCounts = make(map[int32]int32)
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-			var mapkey int32
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.JobCounts[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			m.Standby = bool(v != 0)
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21131,33 +24518,17 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+			m.Stopped = bool(v != 0)
+		case 39:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			m.Version = 0
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21167,14 +24538,14 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.DatumTries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21201,16 +24572,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21238,13 +24609,32 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+			}
+			m.LastJobState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastJobState |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21254,31 +24644,27 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
-			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21305,18 +24691,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21326,29 +24712,53 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.TFJob == nil {
+				m.TFJob = &TFJob{}
+			}
+			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 23:
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.S3Out = bool(v != 0)
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21358,29 +24768,33 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 24:
+		case 49:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
 			}
-			var v int
+			m.WorkersRequested = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21390,17 +24804,35 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.WorkersRequested |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 25:
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
+			}
+			m.WorkersAvailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkersAvailable |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21410,27 +24842,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
+			}
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 28:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21458,13 +24894,13 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagateLabels", wireType)
 			}
-			m.MaxQueueSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21474,16 +24910,29 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQueueSize |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropagateLabels = append(m.PropagateLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumConcurrency", wireType)
+			}
+			m.DatumConcurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21493,33 +24942,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DatumConcurrency |= int64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 31:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritizeNewestJobs", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21529,31 +24961,15 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
-			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 32:
+			m.PrioritizeNewestJobs = bool(v != 0)
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21580,16 +24996,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
+			if m.Template == nil {
+				m.Template = &PipelineTemplate{}
 			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRetries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21616,16 +25032,67 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			if m.JobRetries == nil {
+				m.JobRetries = &JobRetries{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobRetries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21652,18 +25119,67 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithookURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21673,27 +25189,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithookURL = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 36:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21720,18 +25240,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 26:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var v int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21741,17 +25261,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Standby = bool(v != 0)
-		case 38:
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var v int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21761,72 +25280,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stopped = bool(v != 0)
-		case 39:
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
-			}
-			m.DatumTries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 40:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
-			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 41:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			var stringLen uint64
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21836,29 +25299,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 43:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			m.LastJobState = 0
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21868,16 +25318,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastJobState |= JobState(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 44:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			var stringLen uint64
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21887,29 +25337,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 45:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			var msglen int
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21919,31 +25356,14 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
-			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 46:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TFJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21970,36 +25390,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TFJob == nil {
-				m.TFJob = &TFJob{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.TFJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 47:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Out", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.S3Out = bool(v != 0)
-		case 48:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22026,18 +25426,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 49:
+		case 34:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersRequested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.WorkersRequested = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22047,16 +25447,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkersRequested |= int64(b&0x7F) << shift
+				m.State |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 50:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.WorkersAvailable = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22066,14 +25466,27 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkersAvailable |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 51:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22100,18 +25513,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 53:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprocessSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22121,23 +25534,27 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22161,7 +25578,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22184,15 +25601,15 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22219,11 +25636,89 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockState = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22246,7 +25741,7 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22269,13 +25764,13 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
@@ -22311,9 +25806,9 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22340,18 +25835,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
-			m.Restart = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22361,73 +25854,33 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataSkipped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			m.DataTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 30:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			m.DataFailed = 0
+			m.History = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22437,16 +25890,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				m.History |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
-			m.DataRecovered = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22456,16 +25909,17 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+			m.Full = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22475,31 +25929,78 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JqFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 33:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22526,37 +26027,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
-			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &pfs.Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 35:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPipelines", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22566,63 +26046,80 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 36:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ToPipelines = append(m.ToPipelines, &Pipeline{})
+			if err := m.ToPipelines[len(m.ToPipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 37:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22649,10 +26146,10 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22678,7 +26175,7 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22701,10 +26198,10 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22744,26 +26241,6 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BlockState = bool(v != 0)
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
@@ -22799,26 +26276,6 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22841,7 +26298,7 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22864,15 +26321,15 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22899,18 +26356,37 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22920,31 +26396,29 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22954,33 +26428,73 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			m.DataSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkipped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			iNdEx = postIndex
-		case 4:
+			m.DataFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataFailed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			m.History = 0
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22990,16 +26504,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.History |= int64(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			var v int
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23009,17 +26523,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Full = bool(v != 0)
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JqFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23029,23 +26542,27 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JqFilter = string(dAtA[iNdEx:postIndex])
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23069,7 +26586,7 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23092,15 +26609,15 @@ func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FlushJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23127,14 +26644,16 @@ func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &pfs.Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToPipelines", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23161,67 +26680,18 @@ func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToPipelines = append(m.ToPipelines, &Pipeline{})
-			if err := m.ToPipelines[len(m.ToPipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23231,82 +26701,47 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Master = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23333,16 +26768,75 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			m.Tail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tail |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseLokiBackend = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23369,10 +26863,10 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.Since == nil {
+				m.Since = &types.Duration{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23398,7 +26892,7 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23421,17 +26915,17 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateJobStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23441,50 +26935,27 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23512,13 +26983,13 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Restart = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23528,16 +26999,31 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			m.DataProcessed = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23547,16 +27033,33 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ts == nil {
+				m.Ts = &types.Timestamp{}
+			}
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.DataSkipped = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23566,16 +27069,29 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			m.DataFailed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23585,16 +27101,29 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.DataRecovered = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23604,16 +27133,17 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.User = bool(v != 0)
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
-			m.DataTotal = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23623,16 +27153,29 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23642,28 +27185,12 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Master = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23686,7 +27213,7 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23709,10 +27236,10 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23753,9 +27280,9 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23765,81 +27292,76 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Master = bool(v != 0)
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
@@ -23875,68 +27397,60 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.Follow = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Tail = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Tail |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseLokiBackend", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.UseLokiBackend = bool(v != 0)
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23963,10 +27477,10 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Since == nil {
-				m.Since = &types.Duration{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23992,7 +27506,7 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
+func (m *FileLineageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24015,17 +27529,17 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileLineageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileLineageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24035,29 +27549,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
+			if m.File == nil {
+				m.File = &pfs.File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downstream", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24067,29 +27585,17 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			m.Downstream = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var msglen int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24099,29 +27605,65 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Depth |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileLineageInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileLineageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileLineageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24148,18 +27690,18 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &types.Timestamp{}
+			if m.Input == nil {
+				m.Input = &pfs.File{}
 			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24169,81 +27711,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			if m.Output == nil {
+				m.Output = &pfs.File{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.User = bool(v != 0)
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24253,29 +27747,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumID = string(dAtA[iNdEx:postIndex])
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var v int
+			m.Depth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24285,12 +27783,11 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Depth |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24313,7 +27810,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24336,17 +27833,17 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24356,33 +27853,16 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24392,24 +27872,11 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24432,7 +27899,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24455,15 +27922,15 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24490,69 +27957,109 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24562,27 +28069,23 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24606,7 +28109,7 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
+func (m *PipelineTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24629,36 +28132,17 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
-			}
-			m.Number = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.SizeBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -24668,65 +28152,27 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24753,8 +28199,8 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			if m.Args == nil {
+				m.Args = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -24849,11 +28295,117 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
+			m.Args[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRetries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRetries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRetries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &types.Duration{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableReasons", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24881,7 +28433,7 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.RetryableReasons = append(m.RetryableReasons, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25908,6 +29460,149 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ReprocessSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagateLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropagateLabels = append(m.PropagateLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumConcurrency", wireType)
+			}
+			m.DatumConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumConcurrency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritizeNewestJobs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrioritizeNewestJobs = bool(v != 0)
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &PipelineTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobRetries == nil {
+				m.JobRetries = &JobRetries{}
+			}
+			if err := m.JobRetries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -26496,6 +30191,132 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RollbackPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reprocess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RunPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -663,6 +663,32 @@ func (a *apiServer) DeleteRoleBindingInTransaction(txnCtx *txnenv.TransactionCon
 	return nil
 }
 
+// RenameRoleBindingInTransaction is used to move the role binding of a resource when it's renamed in other services.
+// It doesn't do any auth checks itself - the calling method should ensure the user is allowed to rename this resource.
+// This is not an RPC, this is only called in-process.
+func (a *apiServer) RenameRoleBindingInTransaction(txnCtx *txnenv.TransactionContext, from, to *auth.Resource) error {
+	if err := a.isActive(txnCtx.ClientContext); err != nil {
+		return err
+	}
+
+	if from.Type == auth.ResourceType_CLUSTER || to.Type == auth.ResourceType_CLUSTER {
+		return fmt.Errorf("cannot rename cluster role binding")
+	}
+
+	roleBindings := a.roleBindings.ReadWrite(txnCtx.Stm)
+	var bindings auth.RoleBinding
+	if err := roleBindings.Get(resourceKey(from), &bindings); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err := roleBindings.Create(resourceKey(to), &bindings); err != nil {
+		return err
+	}
+	return roleBindings.Delete(resourceKey(from))
+}
+
 // rolesFromRoleSlice converts a slice of strings into *auth.Roles,
 // validating that each role name is valid.
 func rolesFromRoleSlice(rs []string) (*auth.Roles, error) {
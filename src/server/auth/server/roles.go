@@ -42,6 +42,9 @@ func permissionsForRole(role string) ([]auth.Permission, error) {
 			auth.Permission_CLUSTER_IDENTITY_UPDATE_OIDC_CLIENT,
 			auth.Permission_CLUSTER_IDENTITY_LIST_OIDC_CLIENTS,
 			auth.Permission_CLUSTER_IDENTITY_GET_OIDC_CLIENT,
+			auth.Permission_CLUSTER_IDENTITY_LIST_USERS,
+			auth.Permission_CLUSTER_IDENTITY_DISABLE_USER,
+			auth.Permission_CLUSTER_IDENTITY_DELETE_USER,
 			auth.Permission_CLUSTER_DEBUG_DUMP,
 			auth.Permission_CLUSTER_LICENSE_ACTIVATE,
 			auth.Permission_CLUSTER_LICENSE_GET_CODE,
@@ -49,6 +52,7 @@ func permissionsForRole(role string) ([]auth.Permission, error) {
 			auth.Permission_CLUSTER_LICENSE_UPDATE_CLUSTER,
 			auth.Permission_CLUSTER_LICENSE_DELETE_CLUSTER,
 			auth.Permission_CLUSTER_LICENSE_LIST_CLUSTERS,
+			auth.Permission_CLUSTER_LICENSE_SET_LIMITS,
 			auth.Permission_CLUSTER_DELETE_ALL,
 			auth.Permission_REPO_READ,
 			auth.Permission_REPO_WRITE,
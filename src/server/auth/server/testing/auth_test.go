@@ -1256,6 +1256,80 @@ func TestListRepoNotLoggedInError(t *testing.T) {
 	require.Matches(t, "no authentication token", err.Error())
 }
 
+// TestBranchProtection tests that only users who can modify a repo's role
+// bindings can change the protection of its branches
+func TestBranchProtection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	// alice creates a repo with a protected branch, and makes bob a writer
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.NoError(t, aliceClient.CreateBranchWithProtection(repo, "master", "", nil, &pfs.BranchProtection{NoDelete: true}))
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoWriterRole}))
+
+	// bob can create branches, but can't protect them or lift the protection
+	// of master
+	require.NoError(t, bobClient.CreateBranch(repo, "staging", "", nil))
+	err := bobClient.CreateBranchWithProtection(repo, "staging", "", nil, &pfs.BranchProtection{NoDelete: true})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	err = bobClient.CreateBranchWithProtection(repo, "master", "", nil, &pfs.BranchProtection{})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	require.YesError(t, bobClient.DeleteBranch(repo, "master", false))
+	// setting the protection that a branch already has isn't a change
+	require.NoError(t, bobClient.CreateBranchWithProtection(repo, "master", "", nil, &pfs.BranchProtection{NoDelete: true}))
+
+	// alice can lift it
+	require.NoError(t, aliceClient.CreateBranchWithProtection(repo, "master", "", nil, &pfs.BranchProtection{}))
+	branchInfo, err := aliceClient.InspectBranch(repo, "master")
+	require.NoError(t, err)
+	require.Nil(t, branchInfo.Protection)
+}
+
+// TestGrepFile tests that GrepFile requires read access to the repo
+func TestGrepFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	alice, bob := robot(tu.UniqueString("alice")), robot(tu.UniqueString("bob"))
+	aliceClient, bobClient := tu.GetAuthenticatedPachClient(t, alice), tu.GetAuthenticatedPachClient(t, bob)
+
+	// alice creates a repo and adds a file to it
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	require.NoError(t, aliceClient.PutFile(repo, "master", "/file", strings.NewReader("foo\nbar\n")))
+
+	grep := func(c *client.APIClient) ([]*pfs.GrepFileResponse, error) {
+		var resps []*pfs.GrepFileResponse
+		err := c.GrepFile(repo, "master", "/*", "bar", false, false, func(resp *pfs.GrepFileResponse) error {
+			resps = append(resps, resp)
+			return nil
+		})
+		return resps, err
+	}
+
+	// bob can't grep alice's repo
+	_, err := grep(bobClient)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// alice adds bob to the repo as a reader, and now bob can grep it
+	require.NoError(t, aliceClient.ModifyRepoRoleBinding(repo, bob, []string{auth.RepoReaderRole}))
+	resps, err := grep(bobClient)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resps))
+	require.Equal(t, "bar", resps[0].Line)
+}
+
 // TestListRepoNoAuthInfoIfDeactivated tests that if auth isn't activated, then
 // ListRepo returns RepoInfos where AuthInfo isn't set (i.e. is nil)
 func TestListRepoNoAuthInfoIfDeactivated(t *testing.T) {
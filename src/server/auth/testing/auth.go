@@ -68,6 +68,11 @@ func (a *InactiveAPIServer) DeleteRoleBindingInTransaction(*txnenv.TransactionCo
 	return auth.ErrNotActivated
 }
 
+// RenameRoleBindingInTransaction implements the RenameRoleBindingInTransaction internal API, but just returns NotActivatedError
+func (a *InactiveAPIServer) RenameRoleBindingInTransaction(*txnenv.TransactionContext, *auth.Resource, *auth.Resource) error {
+	return auth.ErrNotActivated
+}
+
 // Authenticate implements the Authenticate RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) Authenticate(context.Context, *auth.AuthenticateRequest) (*auth.AuthenticateResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -825,6 +825,24 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(diffDocs, "diff"))
 
+	forkDocs := &cobra.Command{
+		Short: "Create a new Pachyderm resource from an existing one.",
+		Long:  "Create a new Pachyderm resource from an existing one.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(forkDocs, "fork"))
+
+	mergeDocs := &cobra.Command{
+		Short: "Combine the changes in two Pachyderm resources.",
+		Long:  "Combine the changes in two Pachyderm resources.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(mergeDocs, "merge"))
+
+	renameDocs := &cobra.Command{
+		Short: "Change the name of a Pachyderm resource.",
+		Long:  "Change the name of a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(renameDocs, "rename"))
+
 	stopDocs := &cobra.Command{
 		Short: "Cancel an ongoing task.",
 		Long:  "Cancel an ongoing task.",
@@ -843,6 +861,12 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(resumeDocs, "resume"))
 
+	rollbackDocs := &cobra.Command{
+		Short: "Return a Pachyderm resource to an earlier version.",
+		Long:  "Return a Pachyderm resource to an earlier version.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(rollbackDocs, "rollback"))
+
 	runDocs := &cobra.Command{
 		Short: "Manually run a Pachyderm resource.",
 		Long:  "Manually run a Pachyderm resource.",
@@ -902,6 +926,7 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"tag":
 			// These are ignored - they will show up in the help topics section
 		case
+			"apply",
 			"copy",
 			"create",
 			"delete",
@@ -909,12 +934,17 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"edit",
 			"finish",
 			"flush",
+			"fork",
 			"get",
 			"glob",
+			"grep",
 			"inspect",
 			"list",
+			"merge",
 			"put",
+			"rename",
 			"restart",
+			"rollback",
 			"start",
 			"stop",
 			"subscribe",
@@ -186,6 +186,9 @@ func GetStateCmd() *cobra.Command {
 			}
 			fmt.Printf("Pachyderm Enterprise token state: %s\nExpiration: %s\n",
 				resp.State.String(), ts.String())
+			for _, limit := range resp.LimitsExceeded {
+				fmt.Printf("Usage limit exceeded: %s\n", limit)
+			}
 			return nil
 		}),
 	}
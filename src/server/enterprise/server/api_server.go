@@ -3,12 +3,15 @@ package server
 import (
 	"encoding/base64"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	logrus "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	ec "github.com/pachyderm/pachyderm/v2/src/enterprise"
@@ -19,6 +22,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/keycache"
 	"github.com/pachyderm/pachyderm/v2/src/internal/license"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	lc "github.com/pachyderm/pachyderm/v2/src/license"
 )
@@ -127,6 +131,7 @@ func (a *apiServer) heartbeatIfConfigured(ctx context.Context) error {
 			LastHeartbeat:   types.TimestampNow(),
 			License:         resp.License,
 			HeartbeatFailed: false,
+			LimitsExceeded:  resp.LimitsExceeded,
 		})
 	})
 	return err
@@ -161,12 +166,73 @@ func (a *apiServer) heartbeatToServer(ctx context.Context, licenseServer, id, se
 		return nil, err
 	}
 
-	return pachClient.License.Heartbeat(ctx, &lc.HeartbeatRequest{
+	resp, err := pachClient.License.Heartbeat(ctx, &lc.HeartbeatRequest{
 		Id:          id,
 		Secret:      secret,
 		Version:     versionResp,
 		AuthEnabled: authEnabled,
+		Usage:       a.collectUsage(localClient, authEnabled),
 	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.LimitsExceeded) > 0 {
+		logrus.Warnf("enterprise license usage limits exceeded: %s", strings.Join(resp.LimitsExceeded, "; "))
+	}
+	return resp, nil
+}
+
+// collectUsage summarizes the resources this cluster is using, to be reported
+// to the license server. Collecting usage is best-effort: if part of it can't
+// be determined (for example, because this pachd is a standalone enterprise
+// server without PFS), that part is reported as zero.
+func (a *apiServer) collectUsage(pachClient *client.APIClient, authEnabled bool) *lc.ClusterUsage {
+	usage := &lc.ClusterUsage{}
+	if authEnabled {
+		// Usage spans every repo and pipeline, so it's collected as PPS
+		// rather than as the caller
+		var token types.StringValue
+		if err := col.NewCollection(a.env.GetEtcdClient(), ppsconsts.PPSTokenKey, nil, &types.StringValue{}, nil, nil).ReadOnly(pachClient.Ctx()).Get("", &token); err != nil {
+			logrus.WithError(err).Warn("could not get PPS token to collect usage")
+			return usage
+		}
+		pachClient = pachClient.WithCtx(pachClient.Ctx())
+		pachClient.SetAuthToken(token.Value)
+	}
+
+	repoInfos, err := pachClient.ListRepo()
+	if err != nil {
+		logrus.WithError(err).Warn("could not list repos to collect usage")
+	} else {
+		usage.Repos = int64(len(repoInfos))
+		for _, ri := range repoInfos {
+			usage.StoredBytes += int64(ri.SizeBytes)
+		}
+	}
+
+	pipelineInfos, err := pachClient.ListPipeline()
+	if err != nil {
+		// Without PPS there are no workers to count either
+		logrus.WithError(err).Warn("could not list pipelines to collect usage")
+		return usage
+	}
+	usage.Pipelines = int64(len(pipelineInfos))
+
+	pods, err := a.env.GetKubeClient().CoreV1().Pods(a.env.Config().Namespace).List(metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{
+			"component": "worker",
+		})),
+	})
+	if err != nil {
+		logrus.WithError(err).Warn("could not list worker pods to collect usage")
+	} else {
+		for _, pod := range pods.Items {
+			if pod.Status.Phase == v1.PodRunning {
+				usage.ActiveWorkers++
+			}
+		}
+	}
+	return usage
 }
 
 // Heartbeat implements the Heartbeat RPC. It exists mostly to test the heartbeat logic
@@ -191,7 +257,10 @@ func (a *apiServer) Activate(ctx context.Context, req *ec.ActivateRequest) (resp
 		return nil, err
 	}
 
-	record := &ec.EnterpriseRecord{License: heartbeatResp.License}
+	record := &ec.EnterpriseRecord{
+		License:        heartbeatResp.License,
+		LimitsExceeded: heartbeatResp.LimitsExceeded,
+	}
 
 	// If the test heartbeat succeeded, write the state and config to etcd
 	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
@@ -238,6 +307,10 @@ func (a *apiServer) GetState(ctx context.Context, req *ec.GetStateRequest) (resp
 		State: record.State,
 	}
 
+	if cached, ok := a.enterpriseTokenCache.Load().(*ec.EnterpriseRecord); ok {
+		resp.LimitsExceeded = cached.LimitsExceeded
+	}
+
 	if record.ActivationCode != "" {
 		activationCode, err := license.Unmarshal(record.ActivationCode)
 		if err != nil {
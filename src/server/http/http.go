@@ -12,6 +12,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/julienschmidt/httprouter"
@@ -85,6 +86,15 @@ func (s *server) getFileHandler(w http.ResponseWriter, r *http.Request, ps httpr
 		httpError(w, err)
 		return
 	}
+	fileInfo, err := c.InspectFile(ps.ByName("repoName"), ps.ByName("commitID"), ps.ByName("filePath"))
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	// http.ServeContent only guesses the type if it isn't set already.
+	if contentType, ok := fileInfo.Metadata[pfs.ContentTypeMetadataKey]; ok {
+		w.Header().Set("Content-Type", contentType)
+	}
 	content, err := c.GetFileReadSeeker(ps.ByName("repoName"), ps.ByName("commitID"), ps.ByName("filePath"))
 	if err != nil {
 		httpError(w, err)
@@ -6,6 +6,8 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
@@ -14,6 +16,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serde"
 
+	"github.com/docker/go-units"
 	"github.com/spf13/cobra"
 )
 
@@ -415,6 +418,102 @@ func ListOIDCClientsCmd() *cobra.Command {
 	return cmdutil.CreateAlias(listConnectors, "idp list-client")
 }
 
+// ListUsersCmd returns a cobra.Command to list users who have logged in
+// through the identity server
+func ListUsersCmd() *cobra.Command {
+	var all bool
+	listUsers := &cobra.Command{
+		Use:   "{{alias}}",
+		Short: "List users who have logged in through the identity server.",
+		Long:  `List users who have logged in through the identity server, and when they were last seen.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewEnterpriseClientOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			resp, err := c.ListUsers(c.Ctx(), &identity.ListUsersRequest{IncludeDisabled: all})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 1, 1, ' ', 0)
+			fmt.Fprintln(writer, "EMAIL\tLAST SEEN\tENABLED")
+			for _, user := range resp.Users {
+				lastSeen := "-"
+				if user.LastAuthenticated != nil {
+					lastSeen = fmt.Sprintf("%s ago", units.HumanDuration(time.Since(*user.LastAuthenticated)))
+				}
+				fmt.Fprintf(writer, "%v\t%v\t%v\n", user.Email, lastSeen, user.Enabled)
+			}
+			return writer.Flush()
+		}),
+	}
+	listUsers.PersistentFlags().BoolVar(&all, "all", false, `Include disabled users.`)
+	return cmdutil.CreateAlias(listUsers, "idp list-user")
+}
+
+// DisableUserCmd returns a cobra.Command to disable a user
+func DisableUserCmd() *cobra.Command {
+	disableUser := &cobra.Command{
+		Use:   "{{alias}} <email>",
+		Short: "Disable a user and revoke their tokens.",
+		Long:  `Disable a user, preventing them from logging in, and revoke all of their existing tokens.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewEnterpriseClientOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			_, err = c.DisableUser(c.Ctx(), &identity.DisableUserRequest{Email: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(disableUser, "idp disable-user")
+}
+
+// EnableUserCmd returns a cobra.Command to re-enable a disabled user
+func EnableUserCmd() *cobra.Command {
+	enableUser := &cobra.Command{
+		Use:   "{{alias}} <email>",
+		Short: "Re-enable a disabled user.",
+		Long:  `Re-enable a disabled user.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewEnterpriseClientOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			_, err = c.EnableUser(c.Ctx(), &identity.EnableUserRequest{Email: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(enableUser, "idp enable-user")
+}
+
+// DeleteUserCmd returns a cobra.Command to delete a user
+func DeleteUserCmd() *cobra.Command {
+	deleteUser := &cobra.Command{
+		Use:   "{{alias}} <email>",
+		Short: "Delete a user and revoke their tokens.",
+		Long:  `Delete a user's record from the identity server and revoke all of their existing tokens. The user will be recreated if they log in again, use disable-user to prevent this.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewEnterpriseClientOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			_, err = c.DeleteUser(c.Ctx(), &identity.DeleteUserRequest{Email: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteUser, "idp delete-user")
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds() []*cobra.Command {
@@ -438,6 +537,10 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, UpdateOIDCClientCmd())
 	commands = append(commands, DeleteOIDCClientCmd())
 	commands = append(commands, ListOIDCClientsCmd())
+	commands = append(commands, ListUsersCmd())
+	commands = append(commands, DisableUserCmd())
+	commands = append(commands, EnableUserCmd())
+	commands = append(commands, DeleteUserCmd())
 
 	return commands
 }
@@ -8,6 +8,7 @@ import (
 	dex_storage "github.com/dexidp/dex/storage"
 	logrus "github.com/sirupsen/logrus"
 
+	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
@@ -210,6 +211,80 @@ func (a *apiServer) DeleteOIDCClient(ctx context.Context, req *identity.DeleteOI
 	return &identity.DeleteOIDCClientResponse{}, nil
 }
 
+func (a *apiServer) ListUsers(ctx context.Context, req *identity.ListUsersRequest) (resp *identity.ListUsersResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	var users []*identity.User
+	var err error
+	if req.IncludeDisabled {
+		users, err = listAllUsers(ctx, a.env.GetDBClient())
+	} else {
+		users, err = listUsers(ctx, a.env.GetDBClient())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.ListUsersResponse{Users: users}, nil
+}
+
+func (a *apiServer) DisableUser(ctx context.Context, req *identity.DisableUserRequest) (resp *identity.DisableUserResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if err := setUserEnabled(ctx, a.env.GetDBClient(), req.Email, false); err != nil {
+		return nil, err
+	}
+
+	if err := a.revokeUserTokens(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
+	return &identity.DisableUserResponse{}, nil
+}
+
+func (a *apiServer) EnableUser(ctx context.Context, req *identity.EnableUserRequest) (resp *identity.EnableUserResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if err := setUserEnabled(ctx, a.env.GetDBClient(), req.Email, true); err != nil {
+		return nil, err
+	}
+
+	return &identity.EnableUserResponse{}, nil
+}
+
+func (a *apiServer) DeleteUser(ctx context.Context, req *identity.DeleteUserRequest) (resp *identity.DeleteUserResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	if err := deleteUser(ctx, a.env.GetDBClient(), req.Email); err != nil {
+		return nil, err
+	}
+
+	if err := a.revokeUserTokens(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
+	return &identity.DeleteUserResponse{}, nil
+}
+
+// revokeUserTokens revokes the Dex refresh tokens and Pachyderm auth tokens
+// that were issued to a user, so that a disabled or deleted user is logged
+// out immediately rather than when their tokens expire.
+func (a *apiServer) revokeUserTokens(ctx context.Context, email string) error {
+	if err := a.api.revokeRefreshTokens(email); err != nil {
+		return err
+	}
+
+	pachClient := a.env.GetPachClient(ctx)
+	_, err := pachClient.RevokeAuthTokensForUser(pachClient.Ctx(), &auth.RevokeAuthTokensForUserRequest{
+		Username: auth.UserPrefix + email,
+	})
+	return err
+}
+
 func (a *apiServer) DeleteAll(ctx context.Context, req *identity.DeleteAllRequest) (resp *identity.DeleteAllResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
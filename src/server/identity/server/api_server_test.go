@@ -72,6 +72,22 @@ func TestAuthNotActivated(t *testing.T) {
 	require.YesError(t, err)
 	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
 
+	_, err = client.ListUsers(client.Ctx(), &identity.ListUsersRequest{})
+	require.YesError(t, err)
+	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
+
+	_, err = client.DisableUser(client.Ctx(), &identity.DisableUserRequest{})
+	require.YesError(t, err)
+	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
+
+	_, err = client.EnableUser(client.Ctx(), &identity.EnableUserRequest{})
+	require.YesError(t, err)
+	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
+
+	_, err = client.DeleteUser(client.Ctx(), &identity.DeleteUserRequest{})
+	require.YesError(t, err)
+	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
+
 	_, err = client.IdentityAPIClient.DeleteAll(client.Ctx(), &identity.DeleteAllRequest{})
 	require.YesError(t, err)
 	require.Equal(t, "rpc error: code = Unimplemented desc = the auth service is not activated", err.Error())
@@ -136,6 +152,22 @@ func TestUserNotAdmin(t *testing.T) {
 	require.YesError(t, err)
 	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
 
+	_, err = aliceClient.ListUsers(aliceClient.Ctx(), &identity.ListUsersRequest{})
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
+
+	_, err = aliceClient.DisableUser(aliceClient.Ctx(), &identity.DisableUserRequest{})
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
+
+	_, err = aliceClient.EnableUser(aliceClient.Ctx(), &identity.EnableUserRequest{})
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
+
+	_, err = aliceClient.DeleteUser(aliceClient.Ctx(), &identity.DeleteUserRequest{})
+	require.YesError(t, err)
+	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
+
 	_, err = aliceClient.IdentityAPIClient.DeleteAll(aliceClient.Ctx(), &identity.DeleteAllRequest{})
 	require.YesError(t, err)
 	require.Matches(t, fmt.Sprintf("rpc error: code = Unknown desc = %v is not authorized to perform this operation", alice), err.Error())
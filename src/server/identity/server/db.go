@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/pachyderm/pachyderm/v2/src/identity"
 
@@ -10,7 +11,16 @@ import (
 
 func listUsers(ctx context.Context, db *sqlx.DB) ([]*identity.User, error) {
 	users := make([]*identity.User, 0)
-	err := db.SelectContext(ctx, &users, "SELECT email, last_authenticated FROM identity.users WHERE enabled=true;")
+	err := db.SelectContext(ctx, &users, "SELECT email, last_authenticated, enabled FROM identity.users WHERE enabled=true;")
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func listAllUsers(ctx context.Context, db *sqlx.DB) ([]*identity.User, error) {
+	users := make([]*identity.User, 0)
+	err := db.SelectContext(ctx, &users, "SELECT email, last_authenticated, enabled FROM identity.users;")
 	if err != nil {
 		return nil, err
 	}
@@ -21,3 +31,40 @@ func addUserInTx(ctx context.Context, tx *sqlx.Tx, email string) error {
 	_, err := tx.ExecContext(ctx, `INSERT INTO identity.users (email, last_authenticated, enabled) VALUES ($1, now(), true) ON CONFLICT(email) DO UPDATE SET last_authenticated=NOW()`, email)
 	return err
 }
+
+// userEnabledInTx returns false if the user has been explicitly disabled.
+// Users that have never logged in are considered enabled.
+func userEnabledInTx(ctx context.Context, tx *sqlx.Tx, email string) (bool, error) {
+	var enabled []bool
+	if err := tx.SelectContext(ctx, &enabled, `SELECT enabled FROM identity.users WHERE email=$1`, email); err != nil {
+		return false, err
+	}
+	return len(enabled) == 0 || enabled[0], nil
+}
+
+func setUserEnabled(ctx context.Context, db *sqlx.DB, email string, enabled bool) error {
+	res, err := db.ExecContext(ctx, `UPDATE identity.users SET enabled=$2 WHERE email=$1`, email, enabled)
+	if err != nil {
+		return err
+	}
+	return checkUserUpdated(res)
+}
+
+func deleteUser(ctx context.Context, db *sqlx.DB, email string) error {
+	res, err := db.ExecContext(ctx, `DELETE FROM identity.users WHERE email=$1`, email)
+	if err != nil {
+		return err
+	}
+	return checkUserUpdated(res)
+}
+
+func checkUserUpdated(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return identity.ErrInvalidID
+	}
+	return nil
+}
@@ -214,6 +214,25 @@ func (a *dexAPI) getClient(id string) (*identity.OIDCClient, error) {
 	return storageClientToPach(client), nil
 }
 
+// revokeRefreshTokens deletes all of the Dex refresh tokens issued to a user,
+// so that they can't obtain new ID tokens without logging in again.
+func (a *dexAPI) revokeRefreshTokens(email string) error {
+	tokens, err := a.storage.ListRefreshTokens()
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tokens {
+		if t.Claims.Email != email {
+			continue
+		}
+		if err := a.storage.DeleteRefresh(t.ID); err != nil && !errors.Is(err, dex_storage.ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *dexAPI) validateConnector(id, connType string, jsonConfig []byte) error {
 	typeConf, ok := dex_server.ConnectorsConfig[connType]
 	if !ok {
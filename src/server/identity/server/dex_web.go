@@ -174,7 +174,21 @@ func (w *dexWeb) interceptApproval(server *dex_server.Server) func(http.Response
 			rw.WriteHeader(http.StatusInternalServerError)
 			return
 		}
+		enabled, err := userEnabledInTx(r.Context(), tx, authReq.Claims.Email)
+		if err != nil {
+			tx.Rollback()
+			w.logger.WithError(err).Error("unable to look up user identity for login")
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !enabled {
+			tx.Rollback()
+			w.logger.WithField("email", authReq.Claims.Email).Info("rejecting login for disabled user")
+			http.Error(rw, "this user has been disabled", http.StatusForbidden)
+			return
+		}
 		if err := addUserInTx(r.Context(), tx, authReq.Claims.Email); err != nil {
+			tx.Rollback()
 			w.logger.WithError(err).Error("unable to record user identity for login")
 			rw.WriteHeader(http.StatusInternalServerError)
 			return
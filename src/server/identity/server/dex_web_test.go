@@ -202,3 +202,57 @@ func TestLogApprovedUsers(t *testing.T) {
 	require.Equal(t, "test@example.com", newUsers[0].Email)
 	require.NotEqual(t, users[0].LastAuthenticated, newUsers[0].LastAuthenticated)
 }
+
+// TestDisabledUserRejected tests that the web server refuses approvals for
+// users that have been disabled.
+func TestDisabledUserRejected(t *testing.T) {
+	webDir = "../../../../dex-assets"
+	logger := logrus.NewEntry(logrus.New())
+	sp := dex_memory.New(logger)
+	env := getTestEnv(t)
+	api := NewIdentityServer(env, sp, false)
+
+	server := newDexWeb(env, sp, api)
+	defer server.stopWebServer()
+
+	err := sp.CreateConnector(dex_storage.Connector{ID: "conn", Type: "github"})
+	require.NoError(t, err)
+
+	require.NoError(t, sp.CreateAuthRequest(dex_storage.AuthRequest{
+		ID:       "testreq",
+		ClientID: "testclient",
+		Expiry:   time.Now().Add(time.Hour),
+		LoggedIn: true,
+		Claims:   dex_storage.Claims{Email: "test@example.com"},
+	}))
+
+	req := httptest.NewRequest("GET", "/approval?req=testreq", nil)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusSeeOther, recorder.Result().StatusCode)
+
+	require.NoError(t, setUserEnabled(context.Background(), env.GetDBClient(), "test@example.com", false))
+
+	users, err := listUsers(context.Background(), env.GetDBClient())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(users))
+
+	allUsers, err := listAllUsers(context.Background(), env.GetDBClient())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(allUsers))
+	require.False(t, allUsers[0].Enabled)
+
+	// A subsequent login by the disabled user should be rejected
+	require.NoError(t, sp.CreateAuthRequest(dex_storage.AuthRequest{
+		ID:       "testreq2",
+		ClientID: "testclient",
+		Expiry:   time.Now().Add(time.Hour),
+		LoggedIn: true,
+		Claims:   dex_storage.Claims{Email: "test@example.com"},
+	}))
+
+	req = httptest.NewRequest("GET", "/approval?req=testreq2", nil)
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
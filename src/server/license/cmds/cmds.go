@@ -142,8 +142,12 @@ func ListClustersCmd() *cobra.Command {
 			}
 
 			for _, cluster := range resp.Clusters {
-				fmt.Printf("id: %v\naddress: %v\nversion: %v\nauth_enabled: %v\nlast_heartbeat: %v\n---\n", cluster.Id, cluster.Address, cluster.Version, cluster.AuthEnabled, cluster.LastHeartbeat)
+				fmt.Printf("id: %v\naddress: %v\nversion: %v\nauth_enabled: %v\nlast_heartbeat: %v\n", cluster.Id, cluster.Address, cluster.Version, cluster.AuthEnabled, cluster.LastHeartbeat)
+				printUsage(cluster.Usage)
+				fmt.Println("---")
 			}
+			fmt.Println("total:")
+			printUsage(resp.TotalUsage)
 
 			return nil
 		}),
@@ -151,6 +155,39 @@ func ListClustersCmd() *cobra.Command {
 	return cmdutil.CreateAlias(listClusters, "license list-clusters")
 }
 
+func printUsage(usage *license.ClusterUsage) {
+	if usage == nil {
+		usage = &license.ClusterUsage{}
+	}
+	fmt.Printf("repos: %v\npipelines: %v\nactive_workers: %v\nstored_bytes: %v\n", usage.Repos, usage.Pipelines, usage.ActiveWorkers, usage.StoredBytes)
+}
+
+// SetUsageLimitsCmd returns a cobra.Command to set the usage limits for the license
+func SetUsageLimitsCmd() *cobra.Command {
+	var limits license.UsageLimits
+	setLimits := &cobra.Command{
+		Short: "Set limits on the combined usage of all registered clusters.",
+		Long:  "Set limits on the combined usage of all registered clusters. While the clusters are over any limit, they can't create new pipelines. A limit of 0 means unlimited.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewEnterpriseClientOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+
+			_, err = c.License.SetUsageLimits(c.Ctx(), &license.SetUsageLimitsRequest{
+				Limits: &limits,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	setLimits.PersistentFlags().Int64Var(&limits.MaxRepos, "max-repos", 0, `The maximum number of repos across all clusters`)
+	setLimits.PersistentFlags().Int64Var(&limits.MaxPipelines, "max-pipelines", 0, `The maximum number of pipelines across all clusters`)
+	setLimits.PersistentFlags().Int64Var(&limits.MaxActiveWorkers, "max-workers", 0, `The maximum number of running workers across all clusters`)
+	setLimits.PersistentFlags().Int64Var(&limits.MaxStoredBytes, "max-bytes", 0, `The maximum number of bytes stored across all clusters`)
+	return cmdutil.CreateAlias(setLimits, "license set-limits")
+}
+
 // DeleteAllCmd returns a cobra.Command to disable enterprise features and
 // clear the configuration of the license service.
 func DeleteAllCmd() *cobra.Command {
@@ -220,6 +257,7 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, UpdateClusterCmd())
 	commands = append(commands, DeleteClusterCmd())
 	commands = append(commands, ListClustersCmd())
+	commands = append(commands, SetUsageLimitsCmd())
 	commands = append(commands, DeleteAllCmd())
 	commands = append(commands, GetStateCmd())
 
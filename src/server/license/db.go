@@ -20,3 +20,16 @@ CREATE TABLE IF NOT EXISTS license.clusters (
 `)
 	return err
 }
+
+// AddClusterUsageColumns adds columns to the clusters table which record the
+// usage each cluster reported in its most recent heartbeat
+func AddClusterUsageColumns(ctx context.Context, tx *sqlx.Tx) error {
+	_, err := tx.ExecContext(ctx, `
+ALTER TABLE license.clusters
+	ADD COLUMN repos BIGINT NOT NULL DEFAULT 0,
+	ADD COLUMN pipelines BIGINT NOT NULL DEFAULT 0,
+	ADD COLUMN active_workers BIGINT NOT NULL DEFAULT 0,
+	ADD COLUMN stored_bytes BIGINT NOT NULL DEFAULT 0;
+`)
+	return err
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"path"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -26,6 +27,11 @@ import (
 
 const (
 	licenseRecordKey = "license"
+	usageLimitsKey   = "limits"
+	// usageLimitsPrefix is the etcd prefix, under the server's prefix, of the
+	// usage limits collection. It's separate from the license record's keys,
+	// which the enterprise token cache watches.
+	usageLimitsPrefix = "usage-limits"
 )
 
 var defaultRecord = &ec.LicenseRecord{}
@@ -39,6 +45,10 @@ type apiServer struct {
 	// enterpriseToken is a collection containing at most one Pachyderm enterprise
 	// token
 	enterpriseToken col.Collection
+
+	// usageLimits is a collection containing at most one set of usage limits
+	// for the license
+	usageLimits col.Collection
 }
 
 func (a *apiServer) LogReq(request interface{}) {
@@ -61,6 +71,7 @@ func New(env serviceenv.ServiceEnv, etcdPrefix string) (lc.APIServer, error) {
 		env:                  env,
 		enterpriseTokenCache: keycache.NewCache(enterpriseToken, licenseRecordKey, defaultRecord),
 		enterpriseToken:      enterpriseToken,
+		usageLimits:          col.NewCollection(env.GetEtcdClient(), path.Join(etcdPrefix, usageLimitsPrefix), nil, &lc.UsageLimits{}, nil, nil),
 	}
 	go s.enterpriseTokenCache.Watch()
 	return s, nil
@@ -261,7 +272,12 @@ func (a *apiServer) Heartbeat(ctx context.Context, req *lc.HeartbeatRequest) (re
 		return nil, lc.ErrInvalidIDOrSecret
 	}
 
-	if _, err := a.env.GetDBClient().ExecContext(ctx, `UPDATE license.clusters SET version=$1, auth_enabled=$2, last_heartbeat=NOW() WHERE id=$3`, req.Version, req.AuthEnabled, req.Id); err != nil {
+	usage := req.Usage
+	if usage == nil {
+		usage = &lc.ClusterUsage{}
+	}
+	if _, err := a.env.GetDBClient().ExecContext(ctx, `UPDATE license.clusters SET version=$1, auth_enabled=$2, last_heartbeat=NOW(), repos=$3, pipelines=$4, active_workers=$5, stored_bytes=$6 WHERE id=$7`,
+		req.Version, req.AuthEnabled, usage.Repos, usage.Pipelines, usage.ActiveWorkers, usage.StoredBytes, req.Id); err != nil {
 		return nil, errors.Wrapf(err, "unable to update cluster in database")
 	}
 
@@ -270,11 +286,73 @@ func (a *apiServer) Heartbeat(ctx context.Context, req *lc.HeartbeatRequest) (re
 		return nil, errors.New("unable to load current enterprise key")
 	}
 
+	limitsExceeded, err := a.checkUsageLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &lc.HeartbeatResponse{
-		License: record,
+		License:        record,
+		LimitsExceeded: limitsExceeded,
 	}, nil
 }
 
+// getUsageLimits returns the usage limits configured for the license, or
+// an empty set of limits if none have been configured.
+func (a *apiServer) getUsageLimits(ctx context.Context) (*lc.UsageLimits, error) {
+	var limits lc.UsageLimits
+	if err := a.usageLimits.ReadOnly(ctx).Get(usageLimitsKey, &limits); err != nil && !col.IsErrNotFound(err) {
+		return nil, errors.Wrapf(err, "unable to read usage limits")
+	}
+	return &limits, nil
+}
+
+// getTotalUsage sums the most recent usage reported by every registered cluster.
+func (a *apiServer) getTotalUsage(ctx context.Context) (*lc.ClusterUsage, error) {
+	var total lc.ClusterUsage
+	if err := a.env.GetDBClient().GetContext(ctx, &total, `SELECT COALESCE(SUM(repos), 0) AS repos, COALESCE(SUM(pipelines), 0) AS pipelines, COALESCE(SUM(active_workers), 0) AS active_workers, COALESCE(SUM(stored_bytes), 0) AS stored_bytes FROM license.clusters`); err != nil {
+		return nil, errors.Wrapf(err, "unable to aggregate cluster usage")
+	}
+	return &total, nil
+}
+
+// checkUsageLimits compares the combined usage of all registered clusters
+// to the license's usage limits, and returns a description of each limit
+// that has been exceeded.
+func (a *apiServer) checkUsageLimits(ctx context.Context) ([]string, error) {
+	limits, err := a.getUsageLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	total, err := a.getTotalUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return exceededLimits(total, limits), nil
+}
+
+// exceededLimits reports the limits that usage is over. The repo and pipeline
+// limits are reported once they're reached, since every new pipeline (and its
+// output repo) would take usage over them.
+func exceededLimits(usage *lc.ClusterUsage, limits *lc.UsageLimits) []string {
+	var result []string
+	check := func(name string, used, limit int64) {
+		if limit > 0 && used > limit {
+			result = append(result, fmt.Sprintf("%s: %d exceeds limit of %d", name, used, limit))
+		}
+	}
+	checkCreate := func(name string, used, limit int64) {
+		if limit > 0 && used+1 > limit {
+			result = append(result, fmt.Sprintf("%s: limit of %d reached (%d in use)", name, limit, used))
+		}
+	}
+	checkCreate("repos", usage.Repos, limits.MaxRepos)
+	checkCreate("pipelines", usage.Pipelines, limits.MaxPipelines)
+	check("active workers", usage.ActiveWorkers, limits.MaxActiveWorkers)
+	check("stored bytes", usage.StoredBytes, limits.MaxStoredBytes)
+	return result
+}
+
 func (a *apiServer) DeleteAll(ctx context.Context, req *lc.DeleteAllRequest) (resp *lc.DeleteAllResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.pachLogger.Log(req, resp, retErr, time.Since(start)) }(time.Now())
@@ -290,6 +368,10 @@ func (a *apiServer) DeleteAll(ctx context.Context, req *lc.DeleteAllRequest) (re
 		if err != nil && !col.IsErrNotFound(err) {
 			return err
 		}
+		err = a.usageLimits.ReadWrite(stm).Delete(usageLimitsKey)
+		if err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
 		return nil
 	}); err != nil {
 		return nil, err
@@ -316,17 +398,63 @@ func (a *apiServer) ListClusters(ctx context.Context, req *lc.ListClustersReques
 	a.LogReq(req)
 	defer func(start time.Time) { a.pachLogger.Log(req, resp, retErr, time.Since(start)) }(time.Now())
 
-	clusters := make([]*lc.ClusterStatus, 0)
-	err := a.env.GetDBClient().SelectContext(ctx, &clusters, "SELECT id, address, version, auth_enabled, last_heartbeat FROM license.clusters;")
+	type clusterRow struct {
+		lc.ClusterStatus
+		lc.ClusterUsage
+	}
+	rows := make([]*clusterRow, 0)
+	err := a.env.GetDBClient().SelectContext(ctx, &rows, "SELECT id, address, version, auth_enabled, last_heartbeat, repos, pipelines, active_workers, stored_bytes FROM license.clusters;")
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*lc.ClusterStatus, len(rows))
+	for i, row := range rows {
+		usage := row.ClusterUsage
+		clusters[i] = &row.ClusterStatus
+		clusters[i].Usage = &usage
+	}
+
+	total, err := a.getTotalUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := a.getUsageLimits(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &lc.ListClustersResponse{
-		Clusters: clusters,
+		Clusters:   clusters,
+		TotalUsage: total,
+		Limits:     limits,
 	}, nil
 }
 
+// SetUsageLimits sets the limits on the combined usage of all registered
+// clusters. Clusters are notified that they're over the limits on their
+// next heartbeat.
+func (a *apiServer) SetUsageLimits(ctx context.Context, req *lc.SetUsageLimitsRequest) (resp *lc.SetUsageLimitsResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.pachLogger.Log(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	limits := req.Limits
+	if limits == nil {
+		limits = &lc.UsageLimits{}
+	}
+	if limits.MaxRepos < 0 || limits.MaxPipelines < 0 || limits.MaxActiveWorkers < 0 || limits.MaxStoredBytes < 0 {
+		return nil, errors.New("usage limits cannot be negative")
+	}
+
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.usageLimits.ReadWrite(stm).Put(usageLimitsKey, limits)
+	}); err != nil {
+		return nil, err
+	}
+	return &lc.SetUsageLimitsResponse{}, nil
+}
+
 func (a *apiServer) DeleteCluster(ctx context.Context, req *lc.DeleteClusterRequest) (resp *lc.DeleteClusterResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.pachLogger.Log(req, resp, retErr, time.Since(start)) }(time.Now())
@@ -8,10 +8,12 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/enterprise"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"github.com/pachyderm/pachyderm/v2/src/license"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
 )
 
 // TestActivate tests that we can activate the license server
@@ -370,3 +372,90 @@ func TestHeartbeatWrongSecret(t *testing.T) {
 	})
 	require.YesError(t, err)
 }
+
+// TestHeartbeatUsageLimits tests that usage reported in heartbeats is
+// aggregated in ListClusters, and that heartbeats report when the combined
+// usage exceeds the license's usage limits
+func TestHeartbeatUsageLimits(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	rootClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	_, err := rootClient.License.SetUsageLimits(rootClient.Ctx(), &license.SetUsageLimitsRequest{
+		Limits: &license.UsageLimits{MaxRepos: 10, MaxPipelines: 2},
+	})
+	require.NoError(t, err)
+
+	pachClient := tu.GetUnauthenticatedPachClient(t)
+	resp, err := pachClient.License.Heartbeat(pachClient.Ctx(), &license.HeartbeatRequest{
+		Id:          "localhost",
+		Secret:      "localhost",
+		Version:     "some weird version",
+		AuthEnabled: true,
+		Usage: &license.ClusterUsage{
+			Repos:         5,
+			Pipelines:     3,
+			ActiveWorkers: 4,
+			StoredBytes:   1024,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.LimitsExceeded))
+	require.Matches(t, "pipelines", resp.LimitsExceeded[0])
+
+	clusters, err := rootClient.License.ListClusters(rootClient.Ctx(), &license.ListClustersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(clusters.Clusters))
+	require.Equal(t, int64(5), clusters.Clusters[0].Usage.Repos)
+	require.Equal(t, int64(1024), clusters.Clusters[0].Usage.StoredBytes)
+	require.Equal(t, int64(3), clusters.TotalUsage.Pipelines)
+	require.Equal(t, int64(4), clusters.TotalUsage.ActiveWorkers)
+	require.Equal(t, int64(2), clusters.Limits.MaxPipelines)
+}
+
+// TestUsageLimitsBlockPipelines tests that new pipelines can't be created,
+// either directly or in a transaction, once the cluster has reached the
+// license's pipeline limit
+func TestUsageLimitsBlockPipelines(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	tu.DeleteAll(t)
+	defer tu.DeleteAll(t)
+	rootClient := tu.GetAuthenticatedPachClient(t, auth.RootUser)
+
+	_, err := rootClient.License.SetUsageLimits(rootClient.Ctx(), &license.SetUsageLimitsRequest{
+		Limits: &license.UsageLimits{MaxPipelines: 1},
+	})
+	require.NoError(t, err)
+
+	repo := tu.UniqueString("in")
+	require.NoError(t, rootClient.CreateRepo(repo))
+	createPipeline := func(c *client.APIClient, name string) error {
+		return c.CreatePipeline(name, "", []string{"bash"}, []string{"cp /pfs/in/* /pfs/out/"},
+			&pps.ParallelismSpec{Constant: 1}, client.NewPFSInput(repo, "/*"), "", false)
+	}
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, createPipeline(rootClient, pipeline))
+	// Activating enterprise again heartbeats to the license server, after
+	// which the cluster is at its pipeline limit
+	tu.ActivateEnterprise(t, rootClient)
+
+	require.YesError(t, createPipeline(rootClient, tu.UniqueString("pipeline")))
+	// Updating an existing pipeline is allowed, but update can't be used to
+	// create a new one
+	require.NoError(t, rootClient.CreatePipeline(pipeline, "", []string{"bash"}, []string{"cp /pfs/in/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1}, client.NewPFSInput(repo, "/*"), "", true))
+	require.YesError(t, rootClient.CreatePipeline(tu.UniqueString("pipeline"), "", []string{"bash"}, []string{"cp /pfs/in/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1}, client.NewPFSInput(repo, "/*"), "", true))
+	_, err = rootClient.ExecuteInTransaction(func(c *client.APIClient) error {
+		return createPipeline(c, tu.UniqueString("pipeline"))
+	})
+	require.YesError(t, err)
+	require.Matches(t, "usage limits", err.Error())
+}
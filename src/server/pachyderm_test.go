@@ -27,6 +27,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
+	"github.com/pachyderm/pachyderm/v2/src/internal/dbutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
@@ -2683,6 +2684,70 @@ func TestUpdatePipeline(t *testing.T) {
 	require.Equal(t, "buzz\n", buffer.String())
 }
 
+func TestRollbackPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestRollbackPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := tu.UniqueString("pipeline")
+	createPipeline := func(stdin string, update bool) {
+		require.NoError(t, c.CreatePipeline(
+			pipelineName,
+			"",
+			[]string{"bash"},
+			[]string{stdin},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewPFSInput(dataRepo, "/*"),
+			"",
+			update,
+		))
+	}
+	putFile := func(content string) {
+		require.NoError(t, c.PutFile(dataRepo, "master", "file", strings.NewReader(content)))
+		_, err := c.FlushCommitAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+		require.NoError(t, err)
+	}
+	checkOutput := func(expected string) {
+		var buffer bytes.Buffer
+		require.NoError(t, c.GetFile(pipelineName, "master", "file", &buffer))
+		require.Equal(t, expected, buffer.String())
+	}
+
+	createPipeline("echo foo >/pfs/out/file", false)
+	putFile("1")
+	checkOutput("foo\n")
+	createPipeline("echo bar >/pfs/out/file", true)
+	putFile("2")
+	checkOutput("bar\n")
+
+	// Rolling back to the current version, or one that doesn't exist, fails.
+	require.YesError(t, c.RollbackPipeline(pipelineName, 2, false))
+	require.YesError(t, c.RollbackPipeline(pipelineName, 5, false))
+
+	// Rolling back creates a new version with the old spec, which only
+	// processes new data.
+	require.NoError(t, c.RollbackPipeline(pipelineName, 1, false))
+	pipelineInfo, err := c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), pipelineInfo.Version)
+	require.Equal(t, "echo foo >/pfs/out/file", pipelineInfo.Transform.Stdin[0])
+	checkOutput("bar\n")
+	putFile("3")
+	checkOutput("foo\n")
+
+	// With reprocess, the rolled back version reprocesses the existing data.
+	require.NoError(t, c.RollbackPipeline(pipelineName, 2, true))
+	_, err = c.FlushCommitAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	checkOutput("bar\n")
+}
+
 func TestUpdatePipelineWithInProgressCommitsAndStats(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -6235,6 +6300,100 @@ func TestCronPipeline(t *testing.T) {
 	})
 }
 
+// TestSQLInput tests that a SQL input commits the results of its query, both
+// on its schedule and when RunCron is called, and that each run replaces the
+// results of the previous one.
+func TestSQLInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	db := tu.NewTestDB(t)
+	var dbName string
+	require.NoError(t, db.Get(&dbName, "SELECT current_database()"))
+	_, err := db.Exec(`CREATE TABLE things (id INT PRIMARY KEY, name TEXT)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO things VALUES (1, 'a'), (2, 'b')`)
+	require.NoError(t, err)
+	// pachd reaches the test database through the cluster's postgres service
+	url := fmt.Sprintf("postgres://%s@postgres:5432/%s?sslmode=disable", dbutil.DefaultUser, dbName)
+	query := "SELECT id, name FROM things ORDER BY id"
+
+	t.Run("RunCron", func(t *testing.T) {
+		pipeline := tu.UniqueString("sql-")
+		input := client.NewSQLInput("things", url, query, "")
+		input.SQL.Format = "csv"
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{"cp /pfs/things/* /pfs/out/"},
+			nil,
+			input,
+			"",
+			false,
+		))
+		pipelineInfo, err := c.InspectPipeline(pipeline)
+		require.NoError(t, err)
+		require.Equal(t, pipeline+"_things", pipelineInfo.Input.SQL.Repo)
+		repo := pipelineInfo.Input.SQL.Repo
+
+		check := func(expected string) {
+			_, err := c.PpsAPIClient.RunCron(context.Background(), &pps.RunCronRequest{Pipeline: client.NewPipeline(pipeline)})
+			require.NoError(t, err)
+			commitInfo, err := c.InspectCommit(repo, "master")
+			require.NoError(t, err)
+			require.Equal(t, query, commitInfo.Labels[ppsconsts.SQLQueryLabel])
+			_, err = time.Parse(time.RFC3339Nano, commitInfo.Labels[ppsconsts.SQLSnapshotTimeLabel])
+			require.NoError(t, err)
+			commitInfos, err := c.FlushCommitAll([]*pfs.Commit{commitInfo.Commit}, []*pfs.Repo{client.NewRepo(pipeline)})
+			require.NoError(t, err)
+			require.Equal(t, 1, len(commitInfos))
+			files, err := c.ListFileAll(pipeline, commitInfos[0].Commit.ID, "/")
+			require.NoError(t, err)
+			require.Equal(t, 1, len(files))
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(pipeline, commitInfos[0].Commit.ID, "/00000000.csv", &buf))
+			require.Equal(t, expected, buf.String())
+		}
+		check("id,name\n1,a\n2,b\n")
+		_, err = db.Exec(`INSERT INTO things VALUES (3, 'c')`)
+		require.NoError(t, err)
+		check("id,name\n1,a\n2,b\n3,c\n")
+	})
+
+	t.Run("Schedule", func(t *testing.T) {
+		pipeline := tu.UniqueString("sql-")
+		require.NoError(t, c.CreatePipeline(
+			pipeline,
+			"",
+			[]string{"bash"},
+			[]string{"cp /pfs/things/* /pfs/out/"},
+			nil,
+			client.NewSQLInput("things", url, query, "@every 10s"),
+			"",
+			false,
+		))
+		repo := pipeline + "_things"
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		countBreakFunc := newCountBreakFunc(2)
+		require.NoError(t, c.WithCtx(ctx).SubscribeCommit(repo, "master", nil, "", pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+			return countBreakFunc(func() error {
+				require.Equal(t, query, ci.Labels[ppsconsts.SQLQueryLabel])
+				files, err := c.ListFileAll(repo, ci.Commit.ID, "/")
+				require.NoError(t, err)
+				require.Equal(t, 1, len(files))
+				require.Equal(t, "/00000000.jsonl", files[0].File.Path)
+				return nil
+			})
+		}))
+	})
+}
+
 func TestSelfReferentialPipeline(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -6599,6 +6758,168 @@ func TestMaxQueueSize(t *testing.T) {
 	}
 }
 
+func TestJobRetries(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestJobRetries_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, c.PutFile(dataRepo, commit1.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo")))
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	// Each datum fails the first time that it's processed, and succeeds after
+	// that.
+	pipeline := tu.UniqueString("TestJobRetries")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("for f in /pfs/%s/*; do", dataRepo),
+					"  name=$(basename $f)",
+					"  if [ ! -f /tmp/$name ]; then touch /tmp/$name; exit 1; fi",
+					"  cp $f /pfs/out/$name",
+					"done",
+				},
+			},
+			Input: client.NewPFSInput(dataRepo, "/*"),
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant: 1,
+			},
+			DatumTries: 1,
+			JobRetries: &pps.JobRetries{
+				Count:   2,
+				Backoff: types.DurationProto(time.Second),
+			},
+		})
+	require.NoError(t, err)
+
+	commitInfos, err := c.FlushCommitAll([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+	jobInfos, err := c.ListJob(pipeline, nil, nil, -1, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfos[0].State)
+	require.Equal(t, uint64(1), jobInfos[0].Restart)
+	require.Equal(t, int64(5), jobInfos[0].DataProcessed)
+	require.Equal(t, int64(0), jobInfos[0].DataFailed)
+	files, err := c.ListFileAll(pipeline, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 5, len(files))
+}
+
+func TestPropagateLabels(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPropagateLabels_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipeline := tu.UniqueString("TestPropagateLabels")
+	_, err := c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd:   []string{"bash"},
+				Stdin: []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+			},
+			Input:           client.NewPFSInput(dataRepo, "/*"),
+			PropagateLabels: []string{"batch", "source"},
+		})
+	require.NoError(t, err)
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(dataRepo, commit1.ID, "file", strings.NewReader("foo")))
+	_, err = c.PfsAPIClient.FinishCommit(c.Ctx(), &pfs.FinishCommitRequest{
+		Commit: commit1,
+		Labels: map[string]string{
+			"batch":    "1",
+			"source":   "partner",
+			"internal": "true",
+		},
+	})
+	require.NoError(t, err)
+	_, err = c.FlushCommitAll([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+
+	// Only the labels listed in propagate_labels are copied onto the output
+	// commit.
+	commitInfo, err := c.InspectCommit(pipeline, "master")
+	require.NoError(t, err)
+	require.Equal(t, "1", commitInfo.Labels["batch"])
+	require.Equal(t, "partner", commitInfo.Labels["source"])
+	_, ok := commitInfo.Labels["internal"]
+	require.False(t, ok)
+}
+
+func TestRenamePipelineInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestRenamePipelineInput_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	require.NoError(t, c.PutFile(dataRepo, "master", "a", strings.NewReader("a")))
+	pipeline := tu.UniqueString("TestRenamePipelineInput")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{"cp /pfs/in/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		&pps.Input{Pfs: &pps.PFSInput{Name: "in", Repo: dataRepo, Glob: "/*"}},
+		"",
+		false,
+	))
+	_, err := c.FlushCommitAll([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+
+	// Renaming the repo and then the branch updates the pipeline's input and
+	// the provenance of its output branch.
+	renamed := tu.UniqueString("TestRenamePipelineInput_renamed")
+	require.NoError(t, c.RenameRepo(dataRepo, renamed))
+	require.NoError(t, c.RenameBranch(renamed, "master", "main"))
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, renamed, pipelineInfo.Input.Pfs.Repo)
+	require.Equal(t, "main", pipelineInfo.Input.Pfs.Branch)
+	require.Equal(t, "in", pipelineInfo.Input.Pfs.Name)
+	branchInfo, err := c.InspectBranch(pipeline, "master")
+	require.NoError(t, err)
+	var provenance []string
+	for _, b := range branchInfo.Provenance {
+		provenance = append(provenance, b.Repo.Name+"@"+b.Name)
+	}
+	require.OneOfEquals(t, renamed+"@main", provenance)
+	for _, p := range provenance {
+		require.False(t, strings.HasPrefix(p, dataRepo+"@"))
+	}
+
+	// New commits on the renamed branch still trigger the pipeline.
+	require.NoError(t, c.PutFile(renamed, "main", "b", strings.NewReader("b")))
+	_, err = c.FlushCommitAll([]*pfs.Commit{client.NewCommit(renamed, "main")}, nil)
+	require.NoError(t, err)
+	files, err := c.ListFileAll(pipeline, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(files))
+}
+
 func TestHTTPAuth(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -7884,6 +8205,77 @@ func TestListDatumDuringJob(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(dis))
 }
+func TestFileLineage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestFileLineage_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	require.NoError(t, c.PutFile(dataRepo, commit1.ID, "a", strings.NewReader("foo")))
+	require.NoError(t, c.PutFile(dataRepo, commit1.ID, "b", strings.NewReader("bar")))
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	pipeline1 := tu.UniqueString("TestFileLineage1")
+	require.NoError(t, c.CreatePipeline(
+		pipeline1,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+		},
+		nil,
+		client.NewPFSInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+	pipeline2 := tu.UniqueString("TestFileLineage2")
+	require.NoError(t, c.CreatePipeline(
+		pipeline2,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("cp /pfs/%s/* /pfs/out/", pipeline1),
+		},
+		nil,
+		client.NewPFSInput(pipeline1, "/*"),
+		"",
+		false,
+	))
+
+	_, err = c.FlushCommitAll([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+
+	upstream, err := c.FileLineageAll(pipeline2, "master", "a", false, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(upstream))
+	require.Equal(t, pipeline1, upstream[0].Input.Commit.Repo.Name)
+	require.Equal(t, "/a", upstream[0].Input.Path)
+	require.Equal(t, int64(1), upstream[0].Depth)
+	require.Equal(t, dataRepo, upstream[1].Input.Commit.Repo.Name)
+	require.Equal(t, commit1.ID, upstream[1].Input.Commit.ID)
+	require.Equal(t, "/a", upstream[1].Input.Path)
+	require.Equal(t, int64(2), upstream[1].Depth)
+
+	limited, err := c.FileLineageAll(pipeline2, "master", "a", false, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(limited))
+
+	downstream, err := c.FileLineageAll(dataRepo, commit1.ID, "b", true, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(downstream))
+	require.Equal(t, pipeline1, downstream[0].Output.Commit.Repo.Name)
+	require.Equal(t, "/b", downstream[0].Output.Path)
+	require.Equal(t, pipeline2, downstream[1].Output.Commit.Repo.Name)
+	require.Equal(t, "/b", downstream[1].Output.Path)
+}
+
 func TestPipelineWithDatumTimeoutControl(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -12,9 +12,11 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/mattn/go-isatty"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
@@ -26,8 +28,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
 	"github.com/pachyderm/pachyderm/v2/src/internal/tabwriter"
 	pfsclient "github.com/pachyderm/pachyderm/v2/src/pfs"
+	ppsclient "github.com/pachyderm/pachyderm/v2/src/pps"
 	"github.com/pachyderm/pachyderm/v2/src/server/cmd/pachctl/shell"
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs/pretty"
+	ppspretty "github.com/pachyderm/pachyderm/v2/src/server/pps/pretty"
 	txncmds "github.com/pachyderm/pachyderm/v2/src/server/transaction/cmds"
 
 	"github.com/spf13/cobra"
@@ -67,11 +71,18 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var labels []string
+	var removeLabels []string
+	var selector []string
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
 		Long:  "Create a new repo.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			repoLabels, err := parseLabels(labels)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -84,6 +95,7 @@ or type (e.g. csv, binary, images, etc).`,
 					&pfsclient.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Labels:      repoLabels,
 					},
 				)
 				return err
@@ -92,6 +104,7 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().StringArrayVar(&labels, "label", nil, "A label to set on the repo, in the form 'key=value'. May be given multiple times.")
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -99,6 +112,10 @@ or type (e.g. csv, binary, images, etc).`,
 		Short: "Update a repo.",
 		Long:  "Update a repo.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			repoLabels, err := parseLabels(labels)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -112,17 +129,79 @@ or type (e.g. csv, binary, images, etc).`,
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
 						Update:      true,
+						Labels:      repoLabels,
 					},
 				)
 				return err
 			})
-			return grpcutil.ScrubGRPC(err)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if len(removeLabels) > 0 {
+				return c.SetRepoLabels(args[0], nil, removeLabels...)
+			}
+			return nil
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().StringArrayVar(&labels, "label", nil, "A label to set on the repo, in the form 'key=value'. May be given multiple times.")
+	updateRepo.Flags().StringArrayVar(&removeLabels, "remove-label", nil, "The key of a label to remove from the repo. May be given multiple times.")
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
+	forkRepo := &cobra.Command{
+		Use:   "{{alias}} <src-repo>@<branch-or-commit> <new-repo>",
+		Short: "Create a new repo from a commit in another repo.",
+		Long:  "Create a new repo whose first commit, on the master branch, has the same contents as a commit in another repo. No data is copied, and the new repo's history is independent of the source repo.",
+		Example: `
+# create repo "sandbox" from the head of branch "master" in repo "data"
+$ {{alias}} data@master sandbox`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			source, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			commit, err := c.PfsAPIClient.ForkRepo(
+				c.Ctx(),
+				&pfsclient.ForkRepoRequest{
+					Source:      source,
+					Repo:        client.NewRepo(args[1]),
+					Description: description,
+				},
+			)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Println(commit.ID)
+			return nil
+		}),
+	}
+	forkRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	shell.RegisterCompletionFunc(forkRepo, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(forkRepo, "fork repo"))
+
+	renameRepo := &cobra.Command{
+		Use:   "{{alias}} <repo> <new-name>",
+		Short: "Rename a repo.",
+		Long:  "Rename a repo, keeping its commits, branches, provenance and role bindings. Pipelines which read from the repo are updated to read from the new name.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.RenameRepo(args[0], args[1])
+		}),
+	}
+	shell.RegisterCompletionFunc(renameRepo, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(renameRepo, "rename repo"))
+
 	inspectRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Return info about a repo.",
@@ -159,12 +238,16 @@ or type (e.g. csv, binary, images, etc).`,
 		Short: "Return all repos.",
 		Long:  "Return all repos.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			labelSelector, err := parseLabels(selector)
+			if err != nil {
+				return err
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
-			repoInfos, err := c.ListRepo()
+			repoInfos, err := c.ListRepoByLabels(labelSelector)
 			if err != nil {
 				return err
 			}
@@ -188,6 +271,7 @@ or type (e.g. csv, binary, images, etc).`,
 			return writer.Flush()
 		}),
 	}
+	listRepo.Flags().StringArrayVar(&selector, "selector", nil, "Only list repos with this label, in the form 'key=value'. May be given multiple times.")
 	listRepo.Flags().AddFlagSet(rawFlags)
 	listRepo.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(listRepo, "list repo"))
@@ -267,6 +351,10 @@ $ {{alias}} test -p XXX`,
 			if err != nil {
 				return err
 			}
+			commitLabels, err := parseLabels(labels)
+			if err != nil {
+				return err
+			}
 			c, err := newClient("user")
 			if err != nil {
 				return err
@@ -282,6 +370,7 @@ $ {{alias}} test -p XXX`,
 						Branch:      branch.Name,
 						Parent:      client.NewCommit(branch.Repo.Name, parent),
 						Description: description,
+						Labels:      commitLabels,
 					},
 				)
 				return err
@@ -296,6 +385,7 @@ $ {{alias}} test -p XXX`,
 	startCommit.MarkFlagCustom("parent", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	startCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents")
 	startCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	startCommit.Flags().StringArrayVar(&labels, "label", nil, "A label to set on the commit, in the form 'key=value'. May be given multiple times.")
 	shell.RegisterCompletionFunc(startCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(startCommit, "start commit"))
 
@@ -308,6 +398,10 @@ $ {{alias}} test -p XXX`,
 			if err != nil {
 				return err
 			}
+			commitLabels, err := parseLabels(labels)
+			if err != nil {
+				return err
+			}
 			c, err := newClient("user")
 			if err != nil {
 				return err
@@ -320,6 +414,7 @@ $ {{alias}} test -p XXX`,
 					&pfsclient.FinishCommitRequest{
 						Commit:      commit,
 						Description: description,
+						Labels:      commitLabels,
 					},
 				)
 				return err
@@ -329,9 +424,42 @@ $ {{alias}} test -p XXX`,
 	}
 	finishCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents (overwrites any existing commit description)")
 	finishCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	finishCommit.Flags().StringArrayVar(&labels, "label", nil, "A label to set on the commit, in the form 'key=value'. May be given multiple times.")
 	shell.RegisterCompletionFunc(finishCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(finishCommit, "finish commit"))
 
+	updateCommit := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>",
+		Short: "Update the labels of a commit.",
+		Long:  "Update the labels of a commit. The commit may be open or finished.",
+		Example: `
+# set the label "stage=prod" on the head of branch "master" in repo "foo"
+$ {{alias}} foo@master --label stage=prod
+
+# remove the label "stage" from commit XXX in repo "foo"
+$ {{alias}} foo@XXX --remove-label stage`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			commitLabels, err := parseLabels(labels)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.SetCommitLabels(commit.Repo.Name, commit.ID, commitLabels, removeLabels...)
+		}),
+	}
+	updateCommit.Flags().StringArrayVar(&labels, "label", nil, "A label to set on the commit, in the form 'key=value'. May be given multiple times.")
+	updateCommit.Flags().StringArrayVar(&removeLabels, "remove-label", nil, "The key of a label to remove from the commit. May be given multiple times.")
+	shell.RegisterCompletionFunc(updateCommit, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(updateCommit, "update commit"))
+
 	inspectCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Return info about a commit.",
@@ -371,6 +499,10 @@ $ {{alias}} test -p XXX`,
 
 	var from string
 	var number int
+	var startedAfter, startedBefore, finishedAfter, finishedBefore string
+	var origins []string
+	var status string
+	var startAfter string
 	listCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>[@<branch>]",
 		Short: "Return all commits on a repo.",
@@ -386,7 +518,16 @@ $ {{alias}} foo@master
 $ {{alias}} foo@master -n 20
 
 # return commits in repo "foo" since commit XXX
-$ {{alias}} foo@master --from XXX`,
+$ {{alias}} foo@master --from XXX
+
+# return commits in repo "foo" with the label "stage=prod"
+$ {{alias}} foo --selector stage=prod
+
+# return user commits in repo "foo" finished in the last 24 hours
+$ {{alias}} foo --origin user --status closed --finished-after 24h
+
+# return the next 100 commits in repo "foo" after commit XXX
+$ {{alias}} foo -n 100 --start-after XXX`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
@@ -398,14 +539,55 @@ $ {{alias}} foo@master --from XXX`,
 			if err != nil {
 				return err
 			}
+			req := &pfsclient.ListCommitRequest{
+				Repo:       branch.Repo,
+				Number:     uint64(number),
+				StartAfter: startAfter,
+			}
+			if from != "" {
+				req.From = client.NewCommit(branch.Repo.Name, from)
+			}
+			if branch.Name != "" {
+				req.To = client.NewCommit(branch.Repo.Name, branch.Name)
+			}
+			if req.LabelSelector, err = parseLabels(selector); err != nil {
+				return err
+			}
+			for _, t := range []struct {
+				arg string
+				dst **types.Timestamp
+			}{
+				{startedAfter, &req.StartedAfter},
+				{startedBefore, &req.StartedBefore},
+				{finishedAfter, &req.FinishedAfter},
+				{finishedBefore, &req.FinishedBefore},
+			} {
+				if *t.dst, err = parseTimeArg(t.arg); err != nil {
+					return err
+				}
+			}
+			for _, origin := range origins {
+				kind, ok := pfsclient.OriginKind_value[strings.ToUpper(origin)]
+				if !ok {
+					return errors.Errorf("unknown commit origin %q, must be one of 'user', 'auto' or 'fsck'", origin)
+				}
+				req.OriginKinds = append(req.OriginKinds, pfsclient.OriginKind(kind))
+			}
+			if status != "" {
+				s, ok := pfsclient.CommitStatus_value[strings.ToUpper(status)]
+				if !ok {
+					return errors.Errorf("unknown commit status %q, must be one of 'open', 'closed' or 'failed'", status)
+				}
+				req.Status = pfsclient.CommitStatus(s)
+			}
 
 			if raw {
-				return c.ListCommitF(branch.Repo.Name, branch.Name, from, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
+				return c.ListCommitFilterF(req, func(ci *pfsclient.CommitInfo) error {
 					return marshaller.Marshal(os.Stdout, ci)
 				})
 			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
-			if err := c.ListCommitF(branch.Repo.Name, branch.Name, from, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
+			if err := c.ListCommitFilterF(req, func(ci *pfsclient.CommitInfo) error {
 				pretty.PrintCommitInfo(writer, ci, fullTimestamps)
 				return nil
 			}); err != nil {
@@ -417,6 +599,14 @@ $ {{alias}} foo@master --from XXX`,
 	listCommit.Flags().StringVarP(&from, "from", "f", "", "list all commits since this commit")
 	listCommit.Flags().IntVarP(&number, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
 	listCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
+	listCommit.Flags().StringArrayVar(&selector, "selector", nil, "Only list commits with this label, in the form 'key=value'. May be given multiple times.")
+	listCommit.Flags().StringVar(&startedAfter, "started-after", "", "Only list commits started after this time, either an RFC 3339 timestamp or a duration before now, e.g. '24h'.")
+	listCommit.Flags().StringVar(&startedBefore, "started-before", "", "Only list commits started before this time, either an RFC 3339 timestamp or a duration before now, e.g. '24h'.")
+	listCommit.Flags().StringVar(&finishedAfter, "finished-after", "", "Only list commits finished after this time, either an RFC 3339 timestamp or a duration before now, e.g. '24h'.")
+	listCommit.Flags().StringVar(&finishedBefore, "finished-before", "", "Only list commits finished before this time, either an RFC 3339 timestamp or a duration before now, e.g. '24h'.")
+	listCommit.Flags().StringSliceVar(&origins, "origin", nil, "Only list commits with one of these origins, any of 'user', 'auto' and 'fsck'.")
+	listCommit.Flags().StringVar(&status, "status", "", "Only list commits with this status, one of 'open', 'closed' or 'failed'.")
+	listCommit.Flags().StringVar(&startAfter, "start-after", "", "List commits after this commit, which should be the last commit of the previous page.")
 	listCommit.Flags().AddFlagSet(rawFlags)
 	listCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(listCommit, shell.RepoCompletion)
@@ -501,6 +691,10 @@ $ {{alias}} test@master --new`,
 			if newCommits && from != "" {
 				return errors.Errorf("--new and --from cannot be used together")
 			}
+			labelSelector, err := parseLabels(selector)
+			if err != nil {
+				return err
+			}
 
 			if newCommits {
 				from = branch.Name
@@ -521,7 +715,7 @@ $ {{alias}} test@master --new`,
 					retErr = err
 				}
 			}()
-			return c.SubscribeCommit(branch.Repo.Name, branch.Name, prov, from, pfsclient.CommitState_STARTED, func(ci *pfsclient.CommitInfo) error {
+			return c.SubscribeCommitByLabels(branch.Repo.Name, branch.Name, prov, from, pfsclient.CommitState_STARTED, labelSelector, func(ci *pfsclient.CommitInfo) error {
 				if raw {
 					return marshaller.Marshal(os.Stdout, ci)
 				}
@@ -534,6 +728,7 @@ $ {{alias}} test@master --new`,
 	subscribeCommit.Flags().StringVar(&pipeline, "pipeline", "", "subscribe to all commits created by this pipeline")
 	subscribeCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	subscribeCommit.Flags().BoolVar(&newCommits, "new", false, "subscribe to only new commits created from now on")
+	subscribeCommit.Flags().StringArrayVar(&selector, "selector", nil, "subscribe to only commits with this label, in the form 'key=value'. May be given multiple times.")
 	subscribeCommit.Flags().AddFlagSet(rawFlags)
 	subscribeCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(subscribeCommit, shell.BranchCompletion)
@@ -577,6 +772,7 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	var branchProvenance cmdutil.RepeatedStringArg
 	var head string
 	trigger := &pfsclient.Trigger{}
+	var protect []string
 	createBranch := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Create a new branch, or update an existing branch, on a repo.",
@@ -597,6 +793,13 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 				trigger.Branch == "" {
 				return errors.Errorf("trigger condition specified without a branch to trigger on, specify a branch with --trigger")
 			}
+			protection, err := parseBranchProtection(protect)
+			if err != nil {
+				return err
+			}
+			if protection != nil && trigger.Branch != "" {
+				return errors.Errorf("cannot set protection and triggers in the same command")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -607,6 +810,9 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 				if trigger.Branch != "" {
 					return c.CreateBranchTrigger(branch.Repo.Name, branch.Name, head, trigger)
 				}
+				if protection != nil {
+					return c.CreateBranchWithProtection(branch.Repo.Name, branch.Name, head, provenance, protection)
+				}
 				return c.CreateBranch(branch.Repo.Name, branch.Name, head, provenance)
 			})
 		}),
@@ -620,8 +826,35 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	createBranch.Flags().StringVar(&trigger.Size_, "trigger-size", "", "The data size to use in triggering.")
 	createBranch.Flags().Int64Var(&trigger.Commits, "trigger-commits", 0, "The number of commits to use in triggering.")
 	createBranch.Flags().BoolVar(&trigger.All, "trigger-all", false, "Only trigger when all conditions are met, rather than when any are met.")
+	createBranch.Flags().StringSliceVar(&protect, "protect", nil, "Protection rules for the branch, any of 'no-delete', 'no-direct-commits' and 'fast-forward-only', or 'all'. Use 'none' to remove the branch's protection.")
 	commands = append(commands, cmdutil.CreateAlias(createBranch, "create branch"))
 
+	updateBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch>",
+		Short: "Update the labels of a branch.",
+		Long:  "Update the labels of a branch.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			branchLabels, err := parseLabels(labels)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.SetBranchLabels(branch.Repo.Name, branch.Name, branchLabels, removeLabels...)
+		}),
+	}
+	updateBranch.Flags().StringArrayVar(&labels, "label", nil, "A label to set on the branch, in the form 'key=value'. May be given multiple times.")
+	updateBranch.Flags().StringArrayVar(&removeLabels, "remove-label", nil, "The key of a label to remove from the branch. May be given multiple times.")
+	shell.RegisterCompletionFunc(updateBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(updateBranch, "update branch"))
+
 	inspectBranch := &cobra.Command{
 		Use:   "{{alias}}  <repo>@<branch>",
 		Short: "Return info about a branch.",
@@ -713,6 +946,317 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	renameBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <new-name>",
+		Short: "Rename a branch.",
+		Long:  "Rename a branch. Pipelines which read from the branch are updated to read from the new name.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.RenameBranch(branch.Repo.Name, branch.Name, args[1])
+		}),
+	}
+	shell.RegisterCompletionFunc(renameBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(renameBranch, "rename branch"))
+
+	var prefer string
+	var dryRun bool
+	mergeBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<source-branch> <target-branch>",
+		Short: "Merge the changes on one branch into another.",
+		Long: `Merge the changes on one branch into another.
+
+The changes made on the source branch since its most recent common ancestor with
+the target branch are applied to the target branch as a new commit. Files which
+were changed differently on both branches are conflicts; by default a merge with
+conflicts fails and lists them, use --prefer to resolve them instead.`,
+		Example: `
+# merge branch "feature" into branch "master" in repo "foo"
+$ {{alias}} foo@feature master
+
+# show what merging "feature" into "master" would do
+$ {{alias}} foo@feature master --dry-run
+
+# merge "feature" into "master", keeping the version on "feature" of conflicting files
+$ {{alias}} foo@feature master --prefer source`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			source, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			var preference pfsclient.MergePreference
+			switch prefer {
+			case "":
+				preference = pfsclient.MergePreference_PREFER_NEITHER
+			case "source":
+				preference = pfsclient.MergePreference_PREFER_SOURCE
+			case "target":
+				preference = pfsclient.MergePreference_PREFER_TARGET
+			default:
+				return errors.Errorf("--prefer must be 'source' or 'target'")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			resp, err := c.PfsAPIClient.MergeBranch(
+				c.Ctx(),
+				&pfsclient.MergeBranchRequest{
+					Source:      source,
+					Target:      client.NewBranch(source.Repo.Name, args[1]),
+					Prefer:      preference,
+					Description: description,
+					DryRun:      dryRun,
+				},
+			)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, resp)
+			}
+			for _, p := range resp.Merged {
+				fmt.Printf("merged: %s\n", p)
+			}
+			for _, conflict := range resp.Conflicts {
+				fmt.Printf("conflict: %s\n", conflict.Path)
+			}
+			if resp.Commit != nil {
+				fmt.Println(resp.Commit.ID)
+			} else if len(resp.Conflicts) > 0 && preference == pfsclient.MergePreference_PREFER_NEITHER {
+				return errors.Errorf("merge has %d conflicts, resolve them with --prefer", len(resp.Conflicts))
+			}
+			return nil
+		}),
+	}
+	mergeBranch.Flags().StringVar(&prefer, "prefer", "", "Resolve conflicts with the version from this side of the merge, either 'source' or 'target'.")
+	mergeBranch.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes the merge would make without creating a commit.")
+	mergeBranch.Flags().StringVarP(&description, "message", "m", "", "A description of the merge commit.")
+	mergeBranch.Flags().AddFlagSet(rawFlags)
+	shell.RegisterCompletionFunc(mergeBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(mergeBranch, "merge branch"))
+
+	tagDocs := &cobra.Command{
+		Short: "Docs for tags.",
+		Long: `A tag in Pachyderm is an immutable name for a commit.
+
+Unlike a branch, a tag always refers to the commit it was created on, so it can
+be used to give a stable name to a version of a dataset. Tags share a namespace
+with the branches of their repo, and tagged commits can't be squashed until
+their tags are deleted.
+
+Any pachctl command that can take a Commit ID, can take a tag name instead.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(tagDocs, "tag", " tag$"))
+
+	var tagCommit string
+	createTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Create a new tag on a commit.",
+		Long:  "Create a new tag on a commit. Tags can't be moved once they're created, delete the tag first to re-create it on a different commit.",
+		Example: `
+# tag the head of branch "master" in repo "foo" as "v1"
+$ {{alias}} foo@v1 --commit master
+
+# tag the parent of the head of "master"
+$ {{alias}} foo@v1 --commit master^`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			if tagCommit == "" {
+				return errors.Errorf("the commit to tag must be specified with --commit")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.CreateTag(tag.Repo.Name, tag.Name, tagCommit, description)
+		}),
+	}
+	createTag.Flags().StringVarP(&tagCommit, "commit", "c", "", "The commit to tag, may be a commit ID, branch or tag.")
+	createTag.MarkFlagCustom("commit", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
+	createTag.Flags().StringVarP(&description, "description", "d", "", "A description of the tag.")
+	commands = append(commands, cmdutil.CreateAlias(createTag, "create tag"))
+
+	inspectTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Return info about a tag.",
+		Long:  "Return info about a tag.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tagInfo, err := c.InspectTag(tag.Repo.Name, tag.Name)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, tagInfo)
+			}
+			return pretty.PrintDetailedTagInfo(tagInfo)
+		}),
+	}
+	inspectTag.Flags().AddFlagSet(rawFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectTag, "inspect tag"))
+
+	listTag := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return all tags on a repo.",
+		Long:  "Return all tags on a repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			tags, err := c.ListTag(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, tag := range tags {
+					if err := marshaller.Marshal(os.Stdout, tag); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			for _, tag := range tags {
+				pretty.PrintTag(writer, tag, fullTimestamps)
+			}
+			return writer.Flush()
+		}),
+	}
+	listTag.Flags().AddFlagSet(rawFlags)
+	listTag.Flags().AddFlagSet(fullTimestampsFlags)
+	shell.RegisterCompletionFunc(listTag, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(listTag, "list tag"))
+
+	deleteTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Delete a tag.",
+		Long:  "Delete a tag, while leaving the commit it refers to intact.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.DeleteTag(tag.Repo.Name, tag.Name)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteTag, "delete tag"))
+
+	retentionDocs := &cobra.Command{
+		Short: "Docs for retention policies.",
+		Long: `Retention policies squash old commits automatically.
+
+A retention policy can be set on a repo, which applies to all of its branches,
+or on a single branch, which overrides the repo's policy. A commit is squashed
+once it is neither one of the most recent commits on a branch nor newer than
+the policy's duration. Branch heads and commits in the provenance of the head
+of a downstream branch are always kept. Policies are enforced periodically by
+pachd, or on demand with 'run retention'.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(retentionDocs, "retention", " retention$"))
+
+	var keepCommits int64
+	var keepFor string
+	var clearRetention bool
+	updateRetention := &cobra.Command{
+		Use:   "{{alias}} <repo>[@<branch>]",
+		Short: "Set the retention policy of a repo or branch.",
+		Long:  "Set the retention policy of a repo or branch.",
+		Example: `
+# keep the 10 most recent commits on each branch of repo "foo"
+$ {{alias}} foo --keep-commits 10
+
+# keep the commits made in the last 30 days on branch "master" of repo "foo"
+$ {{alias}} foo@master --keep-for 720h
+
+# remove the retention policy of repo "foo"
+$ {{alias}} foo --clear`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			var policy *pfsclient.RetentionPolicy
+			if !clearRetention {
+				policy = &pfsclient.RetentionPolicy{KeepCommits: keepCommits}
+				if keepFor != "" {
+					d, err := time.ParseDuration(keepFor)
+					if err != nil {
+						return errors.Wrapf(err, "invalid duration %q", keepFor)
+					}
+					policy.KeepDuration = types.DurationProto(d)
+				}
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.SetRetentionPolicy(branch.Repo.Name, branch.Name, policy)
+		}),
+	}
+	updateRetention.Flags().Int64Var(&keepCommits, "keep-commits", 0, "Keep the given number of most recent commits on each branch.")
+	updateRetention.Flags().StringVar(&keepFor, "keep-for", "", "Keep commits that finished less than the given duration ago (e.g. 720h).")
+	updateRetention.Flags().BoolVar(&clearRetention, "clear", false, "Remove the retention policy.")
+	shell.RegisterCompletionFunc(updateRetention, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(updateRetention, "update retention"))
+
+	runRetention := &cobra.Command{
+		Use:   "{{alias}} [<repo>]",
+		Short: "Squash the commits that are no longer kept by a retention policy.",
+		Long:  "Squash the commits that are no longer kept by a retention policy. If no repo is given, the policies of all repos are enforced.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			var repo string
+			if len(args) > 0 {
+				repo = args[0]
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			commits, err := c.EnforceRetention(repo, dryRun)
+			if err != nil {
+				return err
+			}
+			for _, commit := range commits {
+				fmt.Printf("%s@%s\n", commit.Repo.Name, commit.ID)
+			}
+			return nil
+		}),
+	}
+	runRetention.Flags().BoolVar(&dryRun, "dry-run", false, "Print the commits that would be squashed without squashing them.")
+	shell.RegisterCompletionFunc(runRetention, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(runRetention, "run retention"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -728,6 +1272,7 @@ from commits with 'get file'.`,
 	var recursive bool
 	var parallelism int
 	var appendFile bool
+	var metadataArgs []string
 	var compress bool
 	var enableProgress bool
 	putFile := &cobra.Command{
@@ -781,6 +1326,10 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if err != nil {
 				return err
 			}
+			metadata, err := parseMetadata(metadataArgs)
+			if err != nil {
+				return err
+			}
 			opts := []client.Option{client.WithMaxConcurrentStreams(parallelism)}
 			if compress {
 				opts = append(opts, client.WithGZIPCompression())
@@ -846,19 +1395,19 @@ $ {{alias}} repo@branch -i http://host/path`,
 						if source == "-" {
 							return errors.Errorf("must specify filename when reading data from stdin")
 						}
-						if err := putFileHelper(mf, joinPaths("", source), source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, joinPaths("", source), source, recursive, appendFile, metadata); err != nil {
 							return err
 						}
 					} else if len(sources) == 1 {
 						// We have a single source and the user has specified a path,
 						// we use the path and ignore source (in terms of naming the file).
-						if err := putFileHelper(mf, file.Path, source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, file.Path, source, recursive, appendFile, metadata); err != nil {
 							return err
 						}
 					} else {
 						// We have multiple sources and the user has specified a path,
 						// we use that path as a prefix for the filepaths.
-						if err := putFileHelper(mf, joinPaths(file.Path, source), source, recursive, appendFile); err != nil {
+						if err := putFileHelper(mf, joinPaths(file.Path, source), source, recursive, appendFile, metadata); err != nil {
 							return err
 						}
 					}
@@ -873,6 +1422,7 @@ $ {{alias}} repo@branch -i http://host/path`,
 	putFile.Flags().BoolVarP(&compress, "compress", "", false, "Compress data during upload. This parameter might help you upload your uncompressed data, such as CSV files, to Pachyderm faster. Use 'compress' with caution, because if your data is already compressed, this parameter might slow down the upload speed instead of increasing.")
 	putFile.Flags().IntVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be uploaded in parallel.")
 	putFile.Flags().BoolVarP(&appendFile, "append", "a", false, "Append to the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
+	putFile.Flags().StringArrayVar(&metadataArgs, "metadata", nil, "Metadata to set on the file, in the form 'key=value'. May be given multiple times. The 'Content-Type' key sets the file's MIME type.")
 	putFile.Flags().BoolVar(&enableProgress, "progress", isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()), "Print progress bars.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
@@ -982,10 +1532,18 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 	shell.RegisterCompletionFunc(getFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(getFile, "get file"))
 
+	var lineage, downstream bool
+	var depth int64
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
 		Long:  "Return info about a file.",
+		Example: `
+# trace the input files that produced file "model.bin" in repo "train"
+$ {{alias}} train@master:/model.bin --lineage
+
+# list the downstream files that were derived from file "raw.csv" in repo "data"
+$ {{alias}} data@master:/raw.csv --downstream`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
@@ -996,6 +1554,21 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 				return err
 			}
 			defer c.Close()
+			if lineage || downstream {
+				if raw {
+					return c.FileLineage(file.Commit.Repo.Name, file.Commit.ID, file.Path, downstream, depth, func(fli *ppsclient.FileLineageInfo) error {
+						return marshaller.Marshal(os.Stdout, fli)
+					})
+				}
+				writer := tabwriter.NewWriter(os.Stdout, ppspretty.FileLineageHeader)
+				if err := c.FileLineage(file.Commit.Repo.Name, file.Commit.ID, file.Path, downstream, depth, func(fli *ppsclient.FileLineageInfo) error {
+					ppspretty.PrintFileLineageInfo(writer, fli)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return writer.Flush()
+			}
 			fileInfo, err := c.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
 			if err != nil {
 				return err
@@ -1010,6 +1583,9 @@ $ {{alias}} 'foo@master:/test\[\].txt'`,
 		}),
 	}
 	inspectFile.Flags().AddFlagSet(rawFlags)
+	inspectFile.Flags().BoolVar(&lineage, "lineage", false, "Print the datums and input files that the file was derived from, recursively.")
+	inspectFile.Flags().BoolVar(&downstream, "downstream", false, "Print the datums and output files that were derived from the file, recursively (implies --lineage).")
+	inspectFile.Flags().Int64Var(&depth, "depth", 0, "Limit the number of pipelines traced by --lineage, 0 means no limit.")
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
 
@@ -1127,6 +1703,55 @@ $ {{alias}} "foo@master:data/*"`,
 	shell.RegisterCompletionFunc(globFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(globFile, "glob file"))
 
+	var literal bool
+	var ignoreCase bool
+	var filesWithMatches bool
+	grepFile := &cobra.Command{
+		Use:   "{{alias}} <pattern> <repo>@<branch-or-commit>[:<glob>]",
+		Short: "Search the contents of files in a commit.",
+		Long:  "Search the contents of the files in a commit that match a glob pattern for lines matching a regular expression. The search runs in parallel inside pachd, so file contents aren't downloaded. Regular expressions use the RE2 syntax documented [here](https://github.com/google/re2/wiki/Syntax).",
+		Example: `
+# Search all files in repo "foo" on branch "master" for "error"
+$ {{alias}} error foo@master
+
+# Search the JSON files under "records" for an ID, ignoring case
+$ {{alias}} -i "id\":\s*\"abc123" "foo@master:records/**.json"
+
+# List the files which contain the literal string "a.b"
+$ {{alias}} -F -l a.b foo@master`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			file, err := cmdutil.ParseFile(args[1])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			lastPath := ""
+			return c.GrepFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, args[0], literal, ignoreCase, func(resp *pfsclient.GrepFileResponse) error {
+				if raw {
+					return marshaller.Marshal(os.Stdout, resp)
+				}
+				if filesWithMatches {
+					if resp.File.Path != lastPath {
+						fmt.Println(resp.File.Path)
+					}
+					lastPath = resp.File.Path
+					return nil
+				}
+				fmt.Printf("%s:%d:%s\n", resp.File.Path, resp.LineNumber, resp.Line)
+				return nil
+			})
+		}),
+	}
+	grepFile.Flags().BoolVarP(&literal, "fixed-strings", "F", false, "Interpret the pattern as a literal string rather than a regular expression.")
+	grepFile.Flags().BoolVarP(&ignoreCase, "ignore-case", "i", false, "Ignore case when matching.")
+	grepFile.Flags().BoolVarP(&filesWithMatches, "files-with-matches", "l", false, "Only print the paths of files with matching lines.")
+	grepFile.Flags().AddFlagSet(rawFlags)
+	commands = append(commands, cmdutil.CreateAlias(grepFile, "grep"))
+
 	var shallow bool
 	var nameOnly bool
 	var diffCmdArg string
@@ -1261,6 +1886,8 @@ Objects are a low-level resource and should not be accessed directly by most use
 	commands = append(commands, cmdutil.CreateDocsAlias(objectDocs, "object", " object$"))
 
 	var fix bool
+	var storage bool
+	var sampleRate float64
 	fsck := &cobra.Command{
 		Use:   "{{alias}}",
 		Short: "Run a file system consistency check on pfs.",
@@ -1272,15 +1899,21 @@ Objects are a low-level resource and should not be accessed directly by most use
 			}
 			defer c.Close()
 			errors := false
-			if err = c.Fsck(fix, func(resp *pfsclient.FsckResponse) error {
+			cb := func(resp *pfsclient.FsckResponse) error {
 				if resp.Error != "" {
 					errors = true
 					fmt.Printf("Error: %s\n", resp.Error)
 				} else {
-					fmt.Printf("Fix applied: %v", resp.Fix)
+					fmt.Printf("Fix applied: %v\n", resp.Fix)
 				}
 				return nil
-			}); err != nil {
+			}
+			if storage {
+				err = c.FsckStorage(fix, sampleRate, cb)
+			} else {
+				err = c.Fsck(fix, cb)
+			}
+			if err != nil {
 				return err
 			}
 			if !errors {
@@ -1290,6 +1923,8 @@ Objects are a low-level resource and should not be accessed directly by most use
 		}),
 	}
 	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
+	fsck.Flags().BoolVar(&storage, "storage", false, "Also verify that the file sets and chunks referenced by each commit are present and intact in object storage. With --fix, commits whose data is missing or corrupt are marked as broken.")
+	fsck.Flags().Float64Var(&sampleRate, "sample-rate", 1, "The fraction of chunks (between 0 and 1) whose content is read and verified by --storage, 1 verifies all chunks. Chunks that are not sampled are only checked for existence.")
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
 	// Add the mount commands (which aren't available on Windows, so they're in
@@ -1299,7 +1934,7 @@ Objects are a low-level resource and should not be accessed directly by most use
 	return commands
 }
 
-func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendFile bool) (retErr error) {
+func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendFile bool, metadata map[string]string) (retErr error) {
 	// Resolve the path, then trim any prefixed '../' to avoid sending bad paths
 	// to the server, and convert to unix path in case we're on windows.
 	path = filepath.ToSlash(filepath.Clean(path))
@@ -1310,6 +1945,9 @@ func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendF
 	if appendFile {
 		opts = append(opts, client.WithAppendPutFile())
 	}
+	if metadata != nil {
+		opts = append(opts, client.WithMetadataPutFile(metadata))
+	}
 	// try parsing the filename as a url, if it is one do a PutFileURL
 	if url, err := url.Parse(source); err == nil && url.Scheme != "" {
 		return mf.PutFileURL(path, url.String(), recursive, opts...)
@@ -1335,7 +1973,7 @@ func putFileHelper(mf client.ModifyFile, path, source string, recursive, appendF
 			// don't do a second recursive 'put file', just put the one file at
 			// filePath into childDest, and then this walk loop will go on to the
 			// next one
-			return putFileHelper(mf, childDest, filePath, false, appendFile)
+			return putFileHelper(mf, childDest, filePath, false, appendFile, metadata)
 		})
 	}
 	f, err := progress.Open(source)
@@ -1438,3 +2076,81 @@ func newClient(name string, options ...client.Option) (*client.APIClient, error)
 	}
 	return client.NewOnUserMachine(name, options...)
 }
+
+func parseBranchProtection(rules []string) (*pfsclient.BranchProtection, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	protection := &pfsclient.BranchProtection{}
+	for _, rule := range rules {
+		switch rule {
+		case "all":
+			protection.NoDelete = true
+			protection.NoDirectCommits = true
+			protection.FastForwardOnly = true
+		case "no-delete":
+			protection.NoDelete = true
+		case "no-direct-commits":
+			protection.NoDirectCommits = true
+		case "fast-forward-only":
+			protection.FastForwardOnly = true
+		case "none":
+			if len(rules) > 1 {
+				return nil, errors.Errorf("'none' cannot be combined with other protection rules")
+			}
+		default:
+			return nil, errors.Errorf("unknown branch protection rule %q", rule)
+		}
+	}
+	return protection, nil
+}
+
+// parseLabels parses labels of the form 'key=value' into a map.
+func parseLabels(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	labels := make(map[string]string)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid label %q, labels must be of the form 'key=value'", arg)
+		}
+		labels[parts[0]] = parts[1]
+	}
+	return labels, nil
+}
+
+// parseMetadata parses file metadata of the form 'key=value'. No args returns
+// nil.
+func parseMetadata(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	metadata := make(map[string]string)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid metadata %q, metadata must be of the form 'key=value'", arg)
+		}
+		metadata[parts[0]] = parts[1]
+	}
+	return metadata, nil
+}
+
+// parseTimeArg parses either an RFC 3339 timestamp, or a duration which is
+// interpreted as that long before now. An empty arg returns nil.
+func parseTimeArg(arg string) (*types.Timestamp, error) {
+	if arg == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, arg)
+	if err != nil {
+		d, durErr := time.ParseDuration(arg)
+		if durErr != nil {
+			return nil, errors.Errorf("invalid time %q, must be an RFC 3339 timestamp or a duration", arg)
+		}
+		t = time.Now().Add(-d)
+	}
+	return types.TimestampProto(t)
+}
@@ -1,6 +1,8 @@
 package fuse
 
 import (
+	"archive/tar"
+	"bytes"
 	"io/ioutil"
 	"os"
 	"os/signal"
@@ -13,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/progress"
+	"github.com/pachyderm/pachyderm/v2/src/internal/tarutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 )
 
@@ -83,6 +86,9 @@ func Mount(c *client.APIClient, target string, opts *Options) (retErr error) {
 			return err
 		}
 		if err := func() (retErr error) {
+			if target, ok := symlinkTarget(root.rootPath, path); ok {
+				return putSymlink(mfc, pathpkg.Join(parts[1:]...), target)
+			}
 			f, err := progress.Open(filepath.Join(root.rootPath, path))
 			if err != nil {
 				if errors.Is(err, os.ErrNotExist) {
@@ -95,10 +101,51 @@ func Mount(c *client.APIClient, target string, opts *Options) (retErr error) {
 					retErr = errors.WithStack(err)
 				}
 			}()
-			return mfc.PutFile(pathpkg.Join(parts[1:]...), f)
+			fi, err := f.Stat()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return mfc.PutFile(pathpkg.Join(parts[1:]...), f, client.WithModePutFile(fi.Mode()))
 		}(); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// symlinkTarget returns the target of the symlink at path, relative to the
+// root of its repo, if it's a symlink to a file in the same repo.
+func symlinkTarget(rootPath, path string) (string, bool) {
+	linkPath := filepath.Join(rootPath, path)
+	fi, err := os.Lstat(linkPath)
+	if err != nil || fi.Mode()&os.ModeSymlink == 0 {
+		return "", false
+	}
+	target, err := os.Readlink(linkPath)
+	if err != nil {
+		return "", false
+	}
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(filepath.Dir(linkPath), target)
+	}
+	repoPath := filepath.Join(rootPath, strings.Split(path, "/")[0])
+	relTarget, err := filepath.Rel(repoPath, target)
+	if err != nil || relTarget == ".." || strings.HasPrefix(relTarget, "../") {
+		return "", false
+	}
+	return "/" + filepath.ToSlash(relTarget), true
+}
+
+func putSymlink(mfc client.ModifyFile, path, target string) error {
+	buf := &bytes.Buffer{}
+	if err := tarutil.WithWriter(buf, func(tw *tar.Writer) error {
+		return tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeSymlink,
+			Name:     path,
+			Linkname: target,
+		})
+	}); err != nil {
+		return err
+	}
+	return mfc.PutFileTar(buf)
+}
@@ -283,8 +283,13 @@ func (n *loopbackNode) Symlink(ctx context.Context, target, name string, out *fu
 	if err := n.download(p, full); err != nil {
 		return nil, fs.ToErrno(err)
 	}
-	target = filepath.Join(n.root().rootPath, n.trimTargetPath(target))
-	if err := n.download(target, full); err != nil {
+	// Relative targets are kept relative, so they can be stored as symlinks.
+	resolved := filepath.Join(n.path(), target)
+	if filepath.IsAbs(target) {
+		target = filepath.Join(n.root().rootPath, n.trimTargetPath(target))
+		resolved = target
+	}
+	if err := n.download(resolved, full); err != nil {
 		return nil, fs.ToErrno(err)
 	}
 	defer func() {
@@ -587,6 +592,24 @@ func (n *loopbackNode) download(path string, state fileState) (retErr error) {
 		if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
 			return errors.WithStack(err)
 		}
+		if fi.FileType == pfs.FileType_SYMLINK {
+			// The target is made relative so that it's read through the mount.
+			target, err := filepath.Rel(pathpkg.Dir(pathpkg.Join("/", fi.File.Path)), pfs.ResolveSymlink(fi.File.Path, fi.SymlinkTarget))
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+				return errors.WithStack(err)
+			}
+			return errors.WithStack(os.Symlink(target, p))
+		}
+		if fi.Mode != 0 {
+			defer func() {
+				if err := os.Chmod(p, os.FileMode(fi.Mode).Perm()); err != nil && retErr == nil {
+					retErr = errors.WithStack(err)
+				}
+			}()
+		}
 		f, err := os.Create(p)
 		if err != nil {
 			return errors.WithStack(err)
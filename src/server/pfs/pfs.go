@@ -3,6 +3,7 @@ package pfs
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
@@ -72,6 +73,27 @@ type ErrCommitNotFinished struct {
 	Commit *pfs.Commit
 }
 
+// ErrBranchProtected represents an error where an operation is not allowed by
+// a branch's protection rules.
+type ErrBranchProtected struct {
+	Branch *pfs.Branch
+	Reason string
+}
+
+// ErrCommitTagged represents an error where a commit cannot be deleted
+// because tags refer to it.
+type ErrCommitTagged struct {
+	Commit *pfs.Commit
+	Tags   []string
+}
+
+// ErrCommitBroken represents an error where a commit can't be read because
+// fsck found that its data is missing or corrupt.
+type ErrCommitBroken struct {
+	Commit *pfs.Commit
+	Reason string
+}
+
 func (e ErrFileNotFound) Error() string {
 	return fmt.Sprintf("file %v not found in repo %v at commit %v", e.File.Path, e.File.Commit.Repo.Name, e.File.Commit.ID)
 }
@@ -121,6 +143,18 @@ func (e ErrCommitNotFinished) Error() string {
 	return fmt.Sprintf("commit %v not finished", e.Commit.ID)
 }
 
+func (e ErrBranchProtected) Error() string {
+	return fmt.Sprintf("branch %v@%v is protected: %v", e.Branch.Repo.Name, e.Branch.Name, e.Reason)
+}
+
+func (e ErrCommitTagged) Error() string {
+	return fmt.Sprintf("commit %v in repo %v is tagged (%v) and cannot be deleted, delete its tags first", e.Commit.ID, e.Commit.Repo.Name, strings.Join(e.Tags, ", "))
+}
+
+func (e ErrCommitBroken) Error() string {
+	return fmt.Sprintf("commit %v in repo %v is broken, its data is missing or corrupt: %v", e.Commit.ID, e.Commit.Repo.Name, e.Reason)
+}
+
 var (
 	commitNotFoundRe          = regexp.MustCompile("commit [^ ]+ not found in repo [^ ]+")
 	commitDeletedRe           = regexp.MustCompile("commit [^ ]+/[^ ]+ was deleted")
@@ -132,6 +166,9 @@ var (
 	hasNoHeadRe               = regexp.MustCompile(`the branch .+ has no head \(create one with 'start commit'\)`)
 	outputCommitNotFinishedRe = regexp.MustCompile("output commit .+ not finished")
 	commitNotFinishedRe       = regexp.MustCompile("commit .+ not finished")
+	branchProtectedRe         = regexp.MustCompile("branch [^ ]+ is protected")
+	commitTaggedRe            = regexp.MustCompile(`commit [^ ]+ in repo [^ ]+ is tagged \(.*\) and cannot be deleted`)
+	commitBrokenRe            = regexp.MustCompile("commit [^ ]+ in repo [^ ]+ is broken")
 )
 
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
@@ -223,3 +260,30 @@ func IsCommitNotFinishedErr(err error) bool {
 	}
 	return commitNotFinishedRe.MatchString(err.Error())
 }
+
+// IsBranchProtectedErr returns true if 'err' is an error message about an
+// operation not being allowed by a branch's protection rules
+func IsBranchProtectedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return branchProtectedRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// IsCommitTaggedErr returns true if 'err' is an error message about a commit
+// not being deletable because it's tagged
+func IsCommitTaggedErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return commitTaggedRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// IsCommitBrokenErr returns true if 'err' is an error message about a commit
+// that fsck marked as broken
+func IsCommitBrokenErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return commitBrokenRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
@@ -5,10 +5,12 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/v2/src/internal/pretty"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
@@ -22,6 +24,8 @@ const (
 	CommitHeader = "REPO\tBRANCH\tCOMMIT\tFINISHED\tSIZE\tPROGRESS\tDESCRIPTION\n"
 	// BranchHeader is the header for branches.
 	BranchHeader = "BRANCH\tHEAD\tTRIGGER\t\n"
+	// TagHeader is the header for tags.
+	TagHeader = "TAG\tCOMMIT\tCREATED\tDESCRIPTION\t\n"
 	// FileHeader is the header for files.
 	FileHeader = "NAME\tTYPE\tSIZE\t\n"
 	// FileHeaderWithCommit is the header for files that includes a commit field.
@@ -67,7 +71,10 @@ func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}
-Size of HEAD on master: {{prettySize .SizeBytes}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .SizeBytes}}{{if .ForkedFrom}}
+Forked from: {{.ForkedFrom.Repo.Name}}@{{.ForkedFrom.ID}}{{end}}{{if .Retention}}
+Retention: {{printRetention .Retention}}{{end}}{{if .Labels}}
+Labels: {{printLabels .Labels}}{{end}}{{if .AuthInfo}}
 Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 `)
 	if err != nil {
@@ -100,6 +107,43 @@ func printTrigger(trigger *pfs.Trigger) string {
 	return fmt.Sprintf("%s on %s", trigger.Branch, cond)
 }
 
+func printRetention(policy *pfs.RetentionPolicy) string {
+	var rules []string
+	if policy.KeepCommits != 0 {
+		rules = append(rules, fmt.Sprintf("last %d commits", policy.KeepCommits))
+	}
+	if policy.KeepDuration != nil {
+		d, err := types.DurationFromProto(policy.KeepDuration)
+		if err == nil {
+			rules = append(rules, fmt.Sprintf("commits newer than %s", d))
+		}
+	}
+	return "keep " + strings.Join(rules, " or ")
+}
+
+func printProtection(protection *pfs.BranchProtection) string {
+	var rules []string
+	if protection.NoDelete {
+		rules = append(rules, "no-delete")
+	}
+	if protection.NoDirectCommits {
+		rules = append(rules, "no-direct-commits")
+	}
+	if protection.FastForwardOnly {
+		rules = append(rules, "fast-forward-only")
+	}
+	return strings.Join(rules, ", ")
+}
+
+func printLabels(labels map[string]string) string {
+	var pairs []string
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
 // PrintBranch pretty-prints a Branch.
 func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	fmt.Fprintf(w, "%s\t", branchInfo.Branch.Name)
@@ -122,7 +166,10 @@ func PrintDetailedBranchInfo(branchInfo *pfs.BranchInfo) error {
 		`Name: {{.Branch.Repo.Name}}@{{.Branch.Name}}{{if .Head}}
 Head Commit: {{ .Head.Repo.Name}}@{{.Head.ID}} {{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}@{{.Name}} {{end}} {{end}}{{if .Trigger}}
-Trigger: {{printTrigger .Trigger}} {{end}}
+Trigger: {{printTrigger .Trigger}} {{end}}{{if .Retention}}
+Retention: {{printRetention .Retention}}{{end}}{{if .Protection}}
+Protection: {{printProtection .Protection}}{{end}}{{if .Labels}}
+Labels: {{printLabels .Labels}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -134,6 +181,33 @@ Trigger: {{printTrigger .Trigger}} {{end}}
 	return nil
 }
 
+// PrintTag pretty-prints a Tag.
+func PrintTag(w io.Writer, tagInfo *pfs.TagInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", tagInfo.Tag.Name)
+	fmt.Fprintf(w, "%s\t", tagInfo.Commit.ID)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", tagInfo.Created.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(tagInfo.Created))
+	}
+	fmt.Fprintf(w, "%s\t", tagInfo.Description)
+	fmt.Fprintln(w)
+}
+
+// PrintDetailedTagInfo pretty-prints detailed tag info.
+func PrintDetailedTagInfo(tagInfo *pfs.TagInfo) error {
+	template, err := template.New("TagInfo").Funcs(funcMap).Parse(
+		`Name: {{.Tag.Repo.Name}}@{{.Tag.Name}}
+Commit: {{.Commit.Repo.Name}}@{{.Commit.ID}}
+Created: {{prettyAgo .Created}}{{if .Description}}
+Description: {{.Description}}{{end}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, tagInfo)
+}
+
 // PrintCommitInfo pretty-prints commit info.
 func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps bool) {
 	fmt.Fprintf(w, "%s\t", commitInfo.Commit.Repo.Name)
@@ -196,7 +270,9 @@ Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{.Finished}}{{else}}
 Finished: {{prettyAgo .Finished}}{{end}}{{end}}
 Size: {{prettySize .SizeBytes}}{{if .Provenance}}
-Provenance: {{range .Provenance}} {{.Commit.Repo.Name}}@{{.Commit.ID}} ({{.Branch.Name}}) {{end}} {{end}}
+Provenance: {{range .Provenance}} {{.Commit.Repo.Name}}@{{.Commit.ID}} ({{.Branch.Name}}) {{end}} {{end}}{{if .Labels}}
+Labels: {{printLabels .Labels}}{{end}}{{if .Error}}
+Error: {{.Error}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -212,11 +288,7 @@ func PrintFileInfo(w io.Writer, fileInfo *pfs.FileInfo, fullTimestamps, withComm
 		fmt.Fprintf(w, "%s\t", fileInfo.File.Commit.ID)
 	}
 	fmt.Fprintf(w, "%s\t", fileInfo.File.Path)
-	if fileInfo.FileType == pfs.FileType_FILE {
-		fmt.Fprint(w, "file\t")
-	} else {
-		fmt.Fprint(w, "dir\t")
-	}
+	fmt.Fprintf(w, "%s\t", fileType(fileInfo.FileType))
 	if withCommit {
 		if fileInfo.Committed == nil {
 			fmt.Fprintf(w, "-\t")
@@ -244,8 +316,11 @@ func PrintDiffFileInfo(w io.Writer, added bool, fileInfo *pfs.FileInfo, fullTime
 func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 	template, err := template.New("FileInfo").Funcs(funcMap).Parse(
 		`Path: {{.File.Path}}
-Type: {{fileType .FileType}}
-Size: {{prettySize .SizeBytes}}
+Type: {{fileType .FileType}}{{if .SymlinkTarget}}
+Target: {{.SymlinkTarget}}{{end}}
+Size: {{prettySize .SizeBytes}}{{if .Mode}}
+Mode: {{printMode .Mode}}{{end}}{{if .Metadata}}
+Metadata: {{printLabels .Metadata}}{{end}}
 `)
 	if err != nil {
 		return err
@@ -254,17 +329,28 @@ Size: {{prettySize .SizeBytes}}
 }
 
 func fileType(fileType pfs.FileType) string {
-	if fileType == pfs.FileType_FILE {
+	switch fileType {
+	case pfs.FileType_FILE:
 		return "file"
+	case pfs.FileType_SYMLINK:
+		return "symlink"
 	}
 	return "dir"
 }
 
+func printMode(mode uint32) string {
+	return fmt.Sprintf("%04o", mode)
+}
+
 var funcMap = template.FuncMap{
-	"prettyAgo":    pretty.Ago,
-	"prettySize":   pretty.Size,
-	"fileType":     fileType,
-	"printTrigger": printTrigger,
+	"prettyAgo":       pretty.Ago,
+	"prettySize":      pretty.Size,
+	"fileType":        fileType,
+	"printMode":       printMode,
+	"printTrigger":    printTrigger,
+	"printRetention":  printRetention,
+	"printProtection": printProtection,
+	"printLabels":     printLabels,
 }
 
 // CompactPrintBranch renders 'b' as a compact string, e.g.
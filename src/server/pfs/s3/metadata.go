@@ -0,0 +1,55 @@
+package s3
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// userMetadataPrefix is the prefix of the headers which carry S3 user-defined
+// object metadata. Other than the Content-Type, PFS file metadata is served
+// under this prefix.
+const userMetadataPrefix = "X-Amz-Meta-"
+
+type responseWriterKey struct{}
+
+// withResponseWriter attaches w to r, so that controller methods can set
+// response headers which s2 doesn't support.
+func withResponseWriter(r *http.Request, w http.ResponseWriter) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), responseWriterKey{}, w))
+}
+
+// requestMetadata returns the PFS file metadata described by the headers of
+// an object write request, or nil if there isn't any.
+func requestMetadata(r *http.Request) map[string]string {
+	metadata := make(map[string]string)
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		metadata[pfs.ContentTypeMetadataKey] = contentType
+	}
+	for key, values := range r.Header {
+		if strings.HasPrefix(key, userMetadataPrefix) && len(values) > 0 {
+			metadata[strings.ToLower(strings.TrimPrefix(key, userMetadataPrefix))] = values[0]
+		}
+	}
+	if len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
+
+// writeMetadata sets the response headers describing a file's metadata.
+func writeMetadata(r *http.Request, metadata map[string]string) {
+	w, ok := r.Context().Value(responseWriterKey{}).(http.ResponseWriter)
+	if !ok {
+		return
+	}
+	for key, value := range metadata {
+		if key == pfs.ContentTypeMetadataKey {
+			w.Header().Set("Content-Type", value)
+			continue
+		}
+		w.Header().Set(userMetadataPrefix+key, value)
+	}
+}
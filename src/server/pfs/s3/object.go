@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	pfsServer "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/s2"
@@ -62,6 +63,8 @@ func (c *controller) GetObject(r *http.Request, bucketName, file, version string
 		return nil, err
 	}
 
+	writeMetadata(r, fileInfo.Metadata)
+
 	result := s2.GetObjectResult{
 		ModTime:      modTime,
 		Content:      content,
@@ -104,7 +107,15 @@ func (c *controller) CopyObject(r *http.Request, srcBucketName, srcFile string,
 		return "", s2.NotImplementedError(r)
 	}
 
-	if err = pc.CopyFile(destBucket.Repo, destBucket.Commit, destFile, srcBucket.Repo, srcBucket.Commit, srcFile); err != nil {
+	// CopyFile preserves the source's metadata, which is the default
+	// behavior in s3. Otherwise the object is rewritten with the metadata from
+	// the request.
+	if r.Header.Get("x-amz-metadata-directive") == "REPLACE" {
+		err = pc.PutFile(destBucket.Repo, destBucket.Commit, destFile, srcObj.Content, client.WithMetadataPutFile(requestMetadata(r)))
+	} else {
+		err = pc.CopyFile(destBucket.Repo, destBucket.Commit, destFile, srcBucket.Repo, srcBucket.Commit, srcFile)
+	}
+	if err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
 			return "", writeToOutputBranchError(r)
 		} else if errutil.IsNotADirectoryError(err) {
@@ -151,7 +162,7 @@ func (c *controller) PutObject(r *http.Request, bucketName, file string, reader
 		return nil, s2.NotImplementedError(r)
 	}
 
-	if err := pc.PutFile(bucket.Repo, bucket.Commit, file, reader); err != nil {
+	if err := pc.PutFile(bucket.Repo, bucket.Commit, file, reader, client.WithMetadataPutFile(requestMetadata(r))); err != nil {
 		if errutil.IsWriteToOutputBranchError(err) {
 			return nil, writeToOutputBranchError(r)
 		} else if errutil.IsNotADirectoryError(err) {
@@ -118,7 +118,7 @@ func Server(port uint16, driver Driver, clientFactory ClientFactory) (*http.Serv
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Log that a request was made
 			logger.Infof("http request: %s %s", r.Method, r.RequestURI)
-			router.ServeHTTP(w, r)
+			router.ServeHTTP(w, withResponseWriter(r, w))
 		}),
 		// NOTE: this is not closed. If the standard logger gets customized, this will need to be fixed
 		ErrorLog: stdlog.New(logger.Writer(), "", 0),
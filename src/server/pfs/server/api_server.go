@@ -17,6 +17,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/log"
 	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/metrics"
@@ -74,7 +75,13 @@ func (a *apiServer) CreateRepoInTransaction(txnCtx *txnenv.TransactionContext, r
 	if repo := request.GetRepo(); repo != nil && repo.Name == fileSetsRepo {
 		return errors.Errorf("%s is a reserved name", fileSetsRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	if err := a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update); err != nil {
+		return err
+	}
+	if len(request.Labels) > 0 {
+		return a.driver.setLabels(txnCtx, request.Repo, nil, nil, request.Labels, nil)
+	}
+	return nil
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -89,6 +96,24 @@ func (a *apiServer) CreateRepo(ctx context.Context, request *pfs.CreateRepoReque
 	return &types.Empty{}, nil
 }
 
+// ForkRepo implements the protobuf pfs.ForkRepo RPC
+func (a *apiServer) ForkRepo(ctx context.Context, request *pfs.ForkRepoRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if repo := request.GetRepo(); repo != nil && repo.Name == fileSetsRepo {
+		return nil, errors.Errorf("%s is a reserved name", fileSetsRepo)
+	}
+	var commit *pfs.Commit
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		var err error
+		commit, err = a.driver.forkRepo(txnCtx, request.Source, request.Repo, request.Branch, request.Description)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
 // InspectRepoInTransaction is identical to InspectRepo except that it can run
 // inside an existing etcd STM transaction.  This is not an RPC.
 func (a *apiServer) InspectRepoInTransaction(txnCtx *txnenv.TransactionContext, originalRequest *pfs.InspectRepoRequest) (*pfs.RepoInfo, error) {
@@ -117,7 +142,17 @@ func (a *apiServer) ListRepo(ctx context.Context, request *pfs.ListRepoRequest)
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	repoInfos, err := a.driver.listRepo(a.env.GetPachClient(ctx), true)
-	return repoInfos, err
+	if err != nil || len(request.LabelSelector) == 0 {
+		return repoInfos, err
+	}
+	var matched []*pfs.RepoInfo
+	for _, repoInfo := range repoInfos.RepoInfo {
+		if matchLabels(repoInfo.Labels, request.LabelSelector) {
+			matched = append(matched, repoInfo)
+		}
+	}
+	repoInfos.RepoInfo = matched
+	return repoInfos, nil
 }
 
 // DeleteRepoInTransaction is identical to DeleteRepo except that it can run
@@ -141,6 +176,38 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+// RenameRepo implements the protobuf pfs.RenameRepo RPC
+func (a *apiServer) RenameRepo(ctx context.Context, request *pfs.RenameRepoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.renameInputs(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return a.driver.renameRepo(txnCtx, request.Repo, request.NewName)
+	}, &pfs.Branch{Repo: request.Repo}, &pfs.Branch{Repo: &pfs.Repo{Name: request.NewName}}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// renameInputs runs rename and updates the inputs of the pipelines which read
+// from 'from' in the same transaction.
+func (a *apiServer) renameInputs(ctx context.Context, rename func(*txnenv.TransactionContext) error, from, to *pfs.Branch) error {
+	specCommits := make(map[string]*pfs.Commit)
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		if err := rename(txnCtx); err != nil {
+			return err
+		}
+		return txnCtx.Pps().RenameInputInTransaction(txnCtx, from, to, specCommits)
+	}); err != nil {
+		// attempt to clean up any spec commits we created
+		pachClient := a.env.GetPachClient(ctx)
+		for _, specCommit := range specCommits {
+			pachClient.SquashCommit(ppsconsts.SpecRepo, specCommit.ID)
+		}
+		return err
+	}
+	return nil
+}
+
 // StartCommitInTransaction is identical to StartCommit except that it can run
 // inside an existing etcd STM transaction.  This is not an RPC.  The target
 // commit can be specified but is optional.  This is so that the transaction can
@@ -151,7 +218,16 @@ func (a *apiServer) StartCommitInTransaction(txnCtx *txnenv.TransactionContext,
 	if commit != nil {
 		id = commit.ID
 	}
-	return a.driver.startCommit(txnCtx, id, request.Parent, request.Branch, request.Provenance, request.Description)
+	commit, err := a.driver.startCommit(txnCtx, id, request.Parent, request.Branch, request.Provenance, request.Description)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Labels) > 0 {
+		if err := a.driver.setLabels(txnCtx, nil, nil, commit, request.Labels, nil); err != nil {
+			return nil, err
+		}
+	}
+	return commit, nil
 }
 
 // StartCommit implements the protobuf pfs.StartCommit RPC
@@ -176,7 +252,13 @@ func (a *apiServer) FinishCommitInTransaction(txnCtx *txnenv.TransactionContext,
 		if request.Empty {
 			request.Description += pfs.EmptyStr
 		}
-		return a.driver.finishCommit(txnCtx, request.Commit, request.Description)
+		if err := a.driver.finishCommit(txnCtx, request.Commit, request.Description); err != nil {
+			return err
+		}
+		if len(request.Labels) > 0 {
+			return a.driver.setLabels(txnCtx, nil, nil, request.Commit, request.Labels, nil)
+		}
+		return nil
 	})
 }
 
@@ -212,7 +294,11 @@ func (a *apiServer) ListCommit(request *pfs.ListCommitRequest, respServer pfs.AP
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d commits", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listCommit(a.env.GetPachClient(respServer.Context()), request.Repo, request.To, request.From, request.Number, request.Reverse, func(ci *pfs.CommitInfo) error {
+	filter, err := newCommitFilter(request)
+	if err != nil {
+		return err
+	}
+	return a.driver.listCommit(a.env.GetPachClient(respServer.Context()), request.Repo, request.To, request.From, request.Number, request.Reverse, request.StartAfter, filter, func(ci *pfs.CommitInfo) error {
 		sent++
 		return respServer.Send(ci)
 	})
@@ -247,7 +333,12 @@ func (a *apiServer) FlushCommit(request *pfs.FlushCommitRequest, stream pfs.API_
 func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream pfs.API_SubscribeCommitServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
-	return a.driver.subscribeCommit(a.env.GetPachClient(stream.Context()), request.Repo, request.Branch, request.Prov, request.From, request.State, stream.Send)
+	return a.driver.subscribeCommit(a.env.GetPachClient(stream.Context()), request.Repo, request.Branch, request.Prov, request.From, request.State, func(ci *pfs.CommitInfo) error {
+		if !matchLabels(ci.Labels, request.LabelSelector) {
+			return nil
+		}
+		return stream.Send(ci)
+	})
 }
 
 // ClearCommit deletes all data in the commit.
@@ -260,7 +351,13 @@ func (a *apiServer) ClearCommit(ctx context.Context, request *pfs.ClearCommitReq
 // CreateBranchInTransaction is identical to CreateBranch except that it can run
 // inside an existing etcd STM transaction.  This is not an RPC.
 func (a *apiServer) CreateBranchInTransaction(txnCtx *txnenv.TransactionContext, request *pfs.CreateBranchRequest) error {
-	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger)
+	if err := a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger, request.Protection); err != nil {
+		return err
+	}
+	if len(request.Labels) > 0 {
+		return a.driver.setLabels(txnCtx, nil, request.Branch, nil, request.Labels, nil)
+	}
+	return nil
 }
 
 // CreateBranch implements the protobuf pfs.CreateBranch RPC
@@ -323,6 +420,113 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// RenameBranch implements the protobuf pfs.RenameBranch RPC
+func (a *apiServer) RenameBranch(ctx context.Context, request *pfs.RenameBranchRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if request.Branch == nil {
+		return nil, errors.New("branch cannot be nil")
+	}
+	if err := a.renameInputs(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return a.driver.renameBranch(txnCtx, request.Branch, request.NewName)
+	}, request.Branch, &pfs.Branch{Repo: request.Branch.Repo, Name: request.NewName}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// MergeBranch implements the protobuf pfs.MergeBranch RPC
+func (a *apiServer) MergeBranch(ctx context.Context, request *pfs.MergeBranchRequest) (response *pfs.MergeBranchResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.mergeBranch(a.env.GetPachClient(ctx), request.Source, request.Target, request.Prefer, request.Description, request.DryRun)
+}
+
+// CreateTag implements the protobuf pfs.CreateTag RPC
+func (a *apiServer) CreateTag(ctx context.Context, request *pfs.CreateTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return a.driver.createTag(txnCtx, request.Tag, request.Commit, request.Description)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// InspectTag implements the protobuf pfs.InspectTag RPC
+func (a *apiServer) InspectTag(ctx context.Context, request *pfs.InspectTagRequest) (response *pfs.TagInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	var tagInfo *pfs.TagInfo
+	if err := a.txnEnv.WithReadContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		var err error
+		tagInfo, err = a.driver.inspectTag(txnCtx, request.Tag)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return tagInfo, nil
+}
+
+// ListTag implements the protobuf pfs.ListTag RPC
+func (a *apiServer) ListTag(ctx context.Context, request *pfs.ListTagRequest) (response *pfs.TagInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	tags, err := a.driver.listTag(a.env.GetPachClient(ctx), request.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.TagInfos{TagInfo: tags}, nil
+}
+
+// DeleteTag implements the protobuf pfs.DeleteTag RPC
+func (a *apiServer) DeleteTag(ctx context.Context, request *pfs.DeleteTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return a.driver.deleteTag(txnCtx, request.Tag)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// SetRetentionPolicy implements the protobuf pfs.SetRetentionPolicy RPC
+func (a *apiServer) SetRetentionPolicy(ctx context.Context, request *pfs.SetRetentionPolicyRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return a.driver.setRetentionPolicy(txnCtx, request.Repo, request.Branch, request.Policy)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// EnforceRetention implements the protobuf pfs.EnforceRetention RPC
+func (a *apiServer) EnforceRetention(ctx context.Context, request *pfs.EnforceRetentionRequest) (response *pfs.EnforceRetentionResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	commits, err := a.driver.enforceRetention(ctx, request.Repo, request.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.EnforceRetentionResponse{Commits: commits}, nil
+}
+
+// SetLabels implements the protobuf pfs.SetLabels RPC
+func (a *apiServer) SetLabels(ctx context.Context, request *pfs.SetLabelsRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return a.driver.setLabels(txnCtx, request.Repo, request.Branch, request.Commit, request.Labels, request.Remove)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) ModifyFile(server pfs.API_ModifyFileServer) (retErr error) {
 	pachClient := a.env.GetPachClient(server.Context())
 	request, err := server.Recv()
@@ -407,15 +611,36 @@ func putFileTar(uw *fileset.UnorderedWriter, server modifyFileSource, req *pfs.P
 			}
 			return tfsr.bytesRead, err
 		}
-		if hdr.Typeflag == tar.TypeDir {
+		attrs := putFileAttributes(req)
+		appendFile := req.Append
+		switch hdr.Typeflag {
+		case tar.TypeDir:
 			continue
+		case tar.TypeSymlink:
+			// Symlinks have no content to append to.
+			attrs.SymlinkTarget = hdr.Linkname
+			appendFile = false
+		default:
+			if mode := uint32(hdr.Mode) & modeMask; mode != 0 {
+				attrs.Mode = mode
+			}
 		}
-		if err := uw.Put(hdr.Name, req.Append, tr, req.Tag); err != nil {
+		if err := uw.PutWithAttributes(hdr.Name, appendFile, tr, attrs, req.Tag); err != nil {
 			return tfsr.bytesRead, err
 		}
 	}
 }
 
+// modeMask selects the permission, setuid, setgid and sticky bits of a mode.
+const modeMask = 07777
+
+func putFileAttributes(req *pfs.PutFile) fileset.Attributes {
+	return fileset.Attributes{
+		Metadata: req.Metadata,
+		Mode:     req.Mode & modeMask,
+	}
+}
+
 type tarFileSourceReader struct {
 	server    modifyFileSource
 	r         *bytes.Reader
@@ -459,7 +684,7 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, req *pfs.PutFi
 				retErr = err
 			}
 		}()
-		return 0, uw.Put(src.Path, req.Append, resp.Body, req.Tag)
+		return 0, uw.PutWithAttributes(src.Path, req.Append, resp.Body, putFileAttributes(req), req.Tag)
 	default:
 		url, err := obj.ParseURL(src.URL)
 		if err != nil {
@@ -475,14 +700,14 @@ func putFileURL(ctx context.Context, uw *fileset.UnorderedWriter, req *pfs.PutFi
 				return obj.WithPipe(func(w io.Writer) error {
 					return objClient.Get(ctx, name, w)
 				}, func(r io.Reader) error {
-					return uw.Put(filepath.Join(src.Path, strings.TrimPrefix(name, path)), req.Append, r, req.Tag)
+					return uw.PutWithAttributes(filepath.Join(src.Path, strings.TrimPrefix(name, path)), req.Append, r, putFileAttributes(req), req.Tag)
 				})
 			})
 		}
 		return 0, obj.WithPipe(func(w io.Writer) error {
 			return objClient.Get(ctx, url.Object, w)
 		}, func(r io.Reader) error {
-			return uw.Put(src.Path, req.Append, r, req.Tag)
+			return uw.PutWithAttributes(src.Path, req.Append, r, putFileAttributes(req), req.Tag)
 		})
 	}
 }
@@ -494,7 +719,7 @@ func putFileRaw(uw *fileset.UnorderedWriter, server modifyFileSource, req *pfs.P
 		r:      bytes.NewReader(src.Data),
 		done:   src.EOF,
 	}
-	err := uw.Put(src.Path, req.Append, rfsr, req.Tag)
+	err := uw.PutWithAttributes(src.Path, req.Append, rfsr, putFileAttributes(req), req.Tag)
 	return rfsr.bytesRead, err
 }
 
@@ -534,7 +759,7 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileS
 		ctx := server.Context()
 		commit := request.File.Commit
 		glob := request.File.Path
-		src, err := a.driver.getFile(a.env.GetPachClient(ctx), commit, glob)
+		src, err := a.driver.getFile(a.env.GetPachClient(ctx), commit, glob, request.FollowSymlinks)
 		if err != nil {
 			return 0, err
 		}
@@ -662,6 +887,19 @@ func (a *apiServer) GlobFile(request *pfs.GlobFileRequest, respServer pfs.API_Gl
 	})
 }
 
+// GrepFile implements the protobuf pfs.GrepFile RPC
+func (a *apiServer) GrepFile(request *pfs.GrepFileRequest, respServer pfs.API_GrepFileServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	var sent int
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
+	}(time.Now())
+	return a.driver.grepFile(a.env.GetPachClient(respServer.Context()), request.Commit, request.Glob, request.Pattern, request.Literal, request.IgnoreCase, func(resp *pfs.GrepFileResponse) error {
+		sent++
+		return respServer.Send(resp)
+	})
+}
+
 // DiffFile implements the protobuf pfs.DiffFile RPC
 func (a *apiServer) DiffFile(request *pfs.DiffFileRequest, server pfs.API_DiffFileServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -698,12 +936,17 @@ func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d messages", sent), retErr, time.Since(start))
 	}(time.Now())
-	if err := a.driver.fsck(a.env.GetPachClient(fsckServer.Context()), request.Fix, func(resp *pfs.FsckResponse) error {
+	pachClient := a.env.GetPachClient(fsckServer.Context())
+	send := func(resp *pfs.FsckResponse) error {
 		sent++
 		return fsckServer.Send(resp)
-	}); err != nil {
+	}
+	if err := a.driver.fsck(pachClient, request.Fix, send); err != nil {
 		return err
 	}
+	if request.Storage {
+		return a.driver.fsckStorage(pachClient, request.Fix, request.SampleRate, send)
+	}
 	return nil
 }
 
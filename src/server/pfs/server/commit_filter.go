@@ -0,0 +1,125 @@
+package server
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// commitFilter restricts the commits returned by listCommit. The zero value
+// matches every commit.
+type commitFilter struct {
+	labels         map[string]string
+	startedAfter   time.Time
+	startedBefore  time.Time
+	finishedAfter  time.Time
+	finishedBefore time.Time
+	originKinds    map[pfs.OriginKind]bool
+	status         pfs.CommitStatus
+}
+
+func newCommitFilter(request *pfs.ListCommitRequest) (*commitFilter, error) {
+	f := &commitFilter{
+		labels: request.LabelSelector,
+		status: request.Status,
+	}
+	for _, t := range []struct {
+		ts  *types.Timestamp
+		dst *time.Time
+	}{
+		{request.StartedAfter, &f.startedAfter},
+		{request.StartedBefore, &f.startedBefore},
+		{request.FinishedAfter, &f.finishedAfter},
+		{request.FinishedBefore, &f.finishedBefore},
+	} {
+		if t.ts == nil {
+			continue
+		}
+		var err error
+		if *t.dst, err = types.TimestampFromProto(t.ts); err != nil {
+			return nil, errors.Wrapf(err, "invalid time range")
+		}
+	}
+	if len(request.OriginKinds) > 0 {
+		f.originKinds = make(map[pfs.OriginKind]bool)
+		for _, kind := range request.OriginKinds {
+			f.originKinds[kind] = true
+		}
+	}
+	return f, nil
+}
+
+// match returns true if commitInfo passes the filter.
+func (f *commitFilter) match(commitInfo *pfs.CommitInfo) bool {
+	if f == nil {
+		return true
+	}
+	if !matchLabels(commitInfo.Labels, f.labels) {
+		return false
+	}
+	if f.originKinds != nil {
+		kind := pfs.OriginKind_USER
+		if commitInfo.Origin != nil {
+			kind = commitInfo.Origin.Kind
+		}
+		if !f.originKinds[kind] {
+			return false
+		}
+	}
+	failed := commitInfo.Finished != nil && strings.Contains(commitInfo.Description, pfs.EmptyStr)
+	switch f.status {
+	case pfs.CommitStatus_OPEN:
+		if commitInfo.Finished != nil {
+			return false
+		}
+	case pfs.CommitStatus_CLOSED:
+		if commitInfo.Finished == nil || failed {
+			return false
+		}
+	case pfs.CommitStatus_FAILED:
+		if !failed {
+			return false
+		}
+	}
+	if !inRange(commitInfo.Started, f.startedAfter, f.startedBefore) {
+		return false
+	}
+	if !f.finishedAfter.IsZero() || !f.finishedBefore.IsZero() {
+		if commitInfo.Finished == nil || !inRange(commitInfo.Finished, f.finishedAfter, f.finishedBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// startedBeforeRange returns true if commitInfo was started before the
+// filter's start time range. Since a commit's ancestors were all started
+// before it, none of them can match either.
+func (f *commitFilter) startedBeforeRange(commitInfo *pfs.CommitInfo) bool {
+	if f == nil || f.startedAfter.IsZero() || commitInfo.Started == nil {
+		return false
+	}
+	started, err := types.TimestampFromProto(commitInfo.Started)
+	return err == nil && started.Before(f.startedAfter)
+}
+
+func inRange(ts *types.Timestamp, after, before time.Time) bool {
+	if after.IsZero() && before.IsZero() {
+		return true
+	}
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return false
+	}
+	if !after.IsZero() && t.Before(after) {
+		return false
+	}
+	if !before.IsZero() && !t.Before(before) {
+		return false
+	}
+	return true
+}
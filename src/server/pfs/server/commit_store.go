@@ -24,6 +24,10 @@ type commitStore interface {
 	GetDiffFileset(ctx context.Context, commit *pfs.Commit) (*fileset.ID, error)
 	// DropFilesets clears the diff and total filesets for the commit.
 	DropFilesets(ctx context.Context, commit *pfs.Commit) error
+	// GetFilesetIDs returns the IDs of the total fileset, or nil if it isn't
+	// set, and of the diff filesets recorded for a commit. Unlike
+	// GetTotalFileset and GetDiffFileset, it doesn't create a new fileset.
+	GetFilesetIDs(ctx context.Context, commit *pfs.Commit) (*fileset.ID, []fileset.ID, error)
 }
 
 var _ commitStore = &postgresCommitStore{}
@@ -101,6 +105,26 @@ func (cs *postgresCommitStore) GetDiffFileset(ctx context.Context, commit *pfs.C
 	return cs.s.Compose(ctx, ids, defaultTTL)
 }
 
+func (cs *postgresCommitStore) GetFilesetIDs(ctx context.Context, commit *pfs.Commit) (*fileset.ID, []fileset.ID, error) {
+	var total *fileset.ID
+	var diffs []fileset.ID
+	if err := dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
+		var err error
+		total, err = getTotal(tx, commit)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		diffs, err = getDiff(tx, commit)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	return total, diffs, nil
+}
+
 func (cs *postgresCommitStore) SetTotalFileset(ctx context.Context, commit *pfs.Commit, id fileset.ID) error {
 	return dbutil.WithTx(ctx, cs.db, func(tx *sqlx.Tx) error {
 		if err := cs.dropTotal(tx, commit); err != nil {
@@ -82,6 +82,7 @@ type driver struct {
 	repos       col.Collection
 	commits     collectionFactory
 	branches    collectionFactory
+	tags        collectionFactory
 	openCommits col.Collection
 
 	storage         *fileset.Storage
@@ -110,6 +111,9 @@ func newDriver(env serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, etcdPre
 		branches: func(repo string) col.Collection {
 			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
 		},
+		tags: func(repo string) col.Collection {
+			return pfsdb.Tags(etcdClient, etcdPrefix, repo)
+		},
 		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
 		// TODO: set maxFanIn based on downward API.
 	}
@@ -399,7 +403,7 @@ func (d *driver) deleteRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, f
 		// branch is provenant on another (such as with stats branches) we
 		// delete them in the right order.
 		branch := branchInfos[len(branchInfos)-1-i].Branch
-		if err := d.deleteBranch(txnCtx, branch, force); err != nil {
+		if err := d.removeBranch(txnCtx, branch, force); err != nil {
 			return errors.Wrapf(err, "delete branch %s", branch)
 		}
 	}
@@ -409,6 +413,7 @@ func (d *driver) deleteRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, f
 	// exist in etcd but branches do.
 	branches := d.branches(repo.Name).ReadWrite(txnCtx.Stm)
 	branches.DeleteAll()
+	d.tags(repo.Name).ReadWrite(txnCtx.Stm).DeleteAll()
 	// Similarly with commits
 	commitsX := d.commits(repo.Name).ReadWrite(txnCtx.Stm)
 	commitsX.DeleteAll()
@@ -425,6 +430,18 @@ func (d *driver) deleteRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, f
 // ID can be passed in for transactions, which need to ensure the ID doesn't
 // change after the commit ID has been reported to a client.
 func (d *driver) startCommit(txnCtx *txnenv.TransactionContext, ID string, parent *pfs.Commit, branch string, provenance []*pfs.CommitProvenance, description string) (*pfs.Commit, error) {
+	if branch != "" && parent != nil && parent.Repo != nil {
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches(parent.Repo.Name).ReadWrite(txnCtx.Stm).Get(branch, branchInfo); err != nil && !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		if branchInfo.Protection != nil && branchInfo.Protection.NoDirectCommits {
+			return nil, pfsserver.ErrBranchProtected{
+				Branch: client.NewBranch(parent.Repo.Name, branch),
+				Reason: "commits cannot be started on it directly",
+			}
+		}
+	}
 	return d.makeCommit(txnCtx, ID, parent, branch, nil, provenance, description, time.Time{}, time.Time{}, 0)
 }
 
@@ -482,6 +499,9 @@ func (d *driver) makeCommit(
 		if err := ancestry.ValidateName(branch); err != nil {
 			return nil, err
 		}
+		if err := d.checkNotTagName(txnCtx.Stm, parent.Repo.Name, branch); err != nil {
+			return nil, err
+		}
 	}
 
 	// check if this is happening in a spout pipeline, and append the correct provenance
@@ -1248,15 +1268,26 @@ func (d *driver) resolveCommit(stm col.STM, userCommit *pfs.Commit) (*pfs.Commit
 	if !uuid.IsUUIDWithoutDashes(commit.ID) {
 		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
 		branchInfo := &pfs.BranchInfo{}
-		// See if we are given a branch
+		// See if we are given a branch, or failing that a tag
 		if err := branches.Get(commit.ID, branchInfo); err != nil {
-			return nil, err
-		}
-		if branchInfo.Head == nil {
-			return nil, pfsserver.ErrNoHead{branchInfo.Branch}
+			if !col.IsErrNotFound(err) {
+				return nil, err
+			}
+			tagInfo := &pfs.TagInfo{}
+			if tagErr := d.tags(commit.Repo.Name).ReadWrite(stm).Get(commit.ID, tagInfo); tagErr != nil {
+				if col.IsErrNotFound(tagErr) {
+					return nil, err
+				}
+				return nil, tagErr
+			}
+			commit.ID = tagInfo.Commit.ID
+		} else {
+			if branchInfo.Head == nil {
+				return nil, pfsserver.ErrNoHead{branchInfo.Branch}
+			}
+			commitBranch = branchInfo.Branch
+			commit.ID = branchInfo.Head.ID
 		}
-		commitBranch = branchInfo.Branch
-		commit.ID = branchInfo.Head.ID
 	}
 
 	// Traverse commits' parents until you've reached the right ancestor
@@ -1306,7 +1337,11 @@ func (d *driver) resolveCommit(stm col.STM, userCommit *pfs.Commit) (*pfs.Commit
 	return commitInfo, nil
 }
 
-func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool, cb func(*pfs.CommitInfo) error) error {
+// listCommit calls cb with the commits in repo which pass filter, up to number
+// of them. If startAfter is set, listing resumes after the commit with that
+// ID, which must have been returned by a previous call with the same
+// arguments.
+func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool, startAfter string, filter *commitFilter, cb func(*pfs.CommitInfo) error) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
@@ -1354,6 +1389,17 @@ func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo, to *pf
 	}
 	commits := d.commits(repo.Name).ReadOnly(ctx)
 	ci := &pfs.CommitInfo{}
+	var startAfterInfo pfs.CommitInfo
+	var startAfterRev int64
+	if startAfter != "" {
+		var err error
+		if startAfterRev, err = commits.GetRev(startAfter, &startAfterInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrCommitNotFound{Commit: client.NewCommit(repo.Name, startAfter)}
+			}
+			return err
+		}
+	}
 
 	if from != nil && to == nil {
 		return errors.Errorf("cannot use `from` commit without `to` commit")
@@ -1366,19 +1412,30 @@ func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo, to *pf
 		}
 		// we hold onto a revisions worth of cis so that we can sort them by provenance
 		var cis []*pfs.CommitInfo
+		// skipping is true until the startAfter commit has been passed. Listing
+		// starts at startAfter's revision, so only the commits created with it
+		// are skipped.
+		skipping := startAfter != ""
 		// sendCis sorts cis and passes them to f
 		sendCis := func() error {
-			// Sort in reverse provenance order, i.e. commits come before their provenance
-			sort.Slice(cis, func(i, j int) bool { return len(cis[i].Provenance) > len(cis[j].Provenance) })
+			// Sort in reverse provenance order, i.e. commits come before their
+			// provenance. The sort is stable so that pages are consistent.
+			sort.SliceStable(cis, func(i, j int) bool { return len(cis[i].Provenance) > len(cis[j].Provenance) })
 			for i, ci := range cis {
 				if number == 0 {
 					return errutil.ErrBreak
 				}
-				number--
-
 				if reverse {
 					ci = cis[len(cis)-1-i]
 				}
+				if skipping {
+					skipping = ci.Commit.ID != startAfter
+					continue
+				}
+				if !filter.match(ci) {
+					continue
+				}
+				number--
 				if err := cb(ci); err != nil {
 					return err
 				}
@@ -1387,19 +1444,24 @@ func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo, to *pf
 			return nil
 		}
 		lastRev := int64(-1)
-		if err := commits.ListRev(ci, &opts, func(commitID string, createRev int64) error {
+		listCb := func(commitID string, createRev int64) error {
 			if createRev != lastRev {
 				if err := sendCis(); err != nil {
-					if errors.Is(err, errutil.ErrBreak) {
-						return nil
-					}
+					// ErrBreak stops the listing once number commits are sent
 					return err
 				}
 				lastRev = createRev
 			}
 			cis = append(cis, proto.Clone(ci).(*pfs.CommitInfo))
 			return nil
-		}); err != nil {
+		}
+		var err error
+		if startAfter != "" {
+			err = commits.ListRevFrom(ci, &opts, startAfterRev, listCb)
+		} else {
+			err = commits.ListRev(ci, &opts, listCb)
+		}
+		if err != nil {
 			return err
 		}
 		// Call sendCis one last time to send whatever's pending in 'cis'
@@ -1411,18 +1473,30 @@ func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo, to *pf
 			return errors.Errorf("cannot use 'Reverse' while also using 'From' or 'To'")
 		}
 		cursor := to
+		if startAfter != "" {
+			if from != nil && startAfter == from.ID {
+				return nil
+			}
+			cursor = startAfterInfo.ParentCommit
+		}
 		for number != 0 && cursor != nil && (from == nil || cursor.ID != from.ID) {
 			var commitInfo pfs.CommitInfo
 			if err := commits.Get(cursor.ID, &commitInfo); err != nil {
 				return err
 			}
+			if filter.startedBeforeRange(&commitInfo) {
+				return nil
+			}
+			cursor = commitInfo.ParentCommit
+			if !filter.match(&commitInfo) {
+				continue
+			}
 			if err := cb(&commitInfo); err != nil {
 				if errors.Is(err, errutil.ErrBreak) {
 					return nil
 				}
 				return err
 			}
-			cursor = commitInfo.ParentCommit
 			number--
 		}
 	}
@@ -1493,6 +1567,10 @@ func (d *driver) squashCommit(txnCtx *txnenv.TransactionContext, userCommit *pfs
 	for _, subv := range userCommitInfo.Subvenance {
 		deleteCommit(subv.Lower, subv.Upper)
 	}
+	// Tagged commits must be untagged before they can be deleted.
+	if err := d.checkNotTagged(txnCtx.Stm, deleted); err != nil {
+		return err
+	}
 
 	// 5) Remove the commits in 'deleted' from all remaining upstream commits'
 	// subvenance.
@@ -1916,7 +1994,7 @@ func (d *driver) clearCommit(pachClient *client.APIClient, commit *pfs.Commit) e
 //
 // This invariant is assumed to hold for all branches upstream of 'branch', but not
 // for 'branch' itself once 'b.Provenance' has been set.
-func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger) error {
+func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger, protection *pfs.BranchProtection) error {
 	// Validate arguments
 	if branch == nil {
 		return errors.New("branch cannot be nil")
@@ -1936,6 +2014,9 @@ func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Bra
 	if err := ancestry.ValidateName(branch.Name); err != nil {
 		return err
 	}
+	if err := d.checkNotTagName(txnCtx.Stm, branch.Repo.Name, branch.Name); err != nil {
+		return err
+	}
 	// The request must do exactly one of:
 	// 1) updating 'branch's provenance (commit is nil OR commit == branch)
 	// 2) re-pointing 'branch' at a new commit
@@ -1985,6 +2066,9 @@ func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Bra
 	if err := branches.Upsert(branch.Name, branchInfo, func() error {
 		branchInfo.Name = branch.Name // set in case 'branch' is new
 		branchInfo.Branch = branch
+		if err := d.checkFastForward(txnCtx.Stm, branchInfo, commit); err != nil {
+			return err
+		}
 		branchInfo.Head = commit
 		branchInfo.DirectProvenance = nil
 		for _, provBranch := range provenance {
@@ -1996,6 +2080,20 @@ func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Bra
 		if trigger != nil && trigger.Branch != "" {
 			branchInfo.Trigger = trigger
 		}
+		if protection != nil {
+			if !protection.NoDelete && !protection.NoDirectCommits && !protection.FastForwardOnly {
+				protection = nil
+			}
+			// Changing a branch's protection requires the same permission
+			// as changing who can write to it, otherwise any writer could
+			// lift it.
+			if !proto.Equal(branchInfo.Protection, protection) {
+				if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.Name, auth.Permission_REPO_MODIFY_BINDINGS); err != nil {
+					return err
+				}
+			}
+			branchInfo.Protection = protection
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -2181,7 +2279,56 @@ func (d *driver) listBranch(pachClient *client.APIClient, repo *pfs.Repo, revers
 	return result, nil
 }
 
+// checkFastForward returns an error if branchInfo only allows fast-forwards
+// and moving its head to commit would not be one.
+func (d *driver) checkFastForward(stm col.STM, branchInfo *pfs.BranchInfo, commit *pfs.Commit) error {
+	if branchInfo.Protection == nil || !branchInfo.Protection.FastForwardOnly || branchInfo.Head == nil {
+		return nil
+	}
+	if commit == nil {
+		return pfsserver.ErrBranchProtected{Branch: branchInfo.Branch, Reason: "its head cannot be removed"}
+	}
+	commitInfo, err := d.resolveCommit(stm, commit)
+	if err != nil {
+		return err
+	}
+	commits := d.commits(commitInfo.Commit.Repo.Name).ReadWrite(stm)
+	for {
+		if commitInfo.Commit.ID == branchInfo.Head.ID {
+			return nil
+		}
+		if commitInfo.ParentCommit == nil {
+			break
+		}
+		parentInfo := &pfs.CommitInfo{}
+		if err := commits.Get(commitInfo.ParentCommit.ID, parentInfo); err != nil {
+			return err
+		}
+		commitInfo = parentInfo
+	}
+	return pfsserver.ErrBranchProtected{
+		Branch: branchInfo.Branch,
+		Reason: fmt.Sprintf("commit %s does not descend from its head %s", commit.ID, branchInfo.Head.ID),
+	}
+}
+
+// deleteBranch deletes branch, unless its protection rules forbid it.
 func (d *driver) deleteBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, force bool) error {
+	if branch != nil && branch.Repo != nil {
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches(branch.Repo.Name).ReadWrite(txnCtx.Stm).Get(branch.Name, branchInfo); err != nil && !col.IsErrNotFound(err) {
+			return errors.Wrapf(err, "branches.Get")
+		}
+		if branchInfo.Protection != nil && branchInfo.Protection.NoDelete {
+			return pfsserver.ErrBranchProtected{Branch: branch, Reason: "it cannot be deleted"}
+		}
+	}
+	return d.removeBranch(txnCtx, branch, force)
+}
+
+// removeBranch deletes branch regardless of its protection rules, it is used
+// directly when deleting a whole repo.
+func (d *driver) removeBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, force bool) error {
 	// Validate arguments
 	if branch == nil {
 		return errors.New("branch cannot be nil")
@@ -10,6 +10,7 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/auth"
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
 	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
@@ -112,16 +113,26 @@ func (d *driver) getDefaultTag() string {
 }
 
 func (d *driver) openCommit(pachClient *client.APIClient, commit *pfs.Commit, opts ...index.Option) (*pfs.CommitInfo, fileset.FileSet, error) {
+	commitInfo, id, err := d.commitFileset(pachClient, commit)
+	if err != nil {
+		return nil, nil, err
+	}
+	fs, err := d.storage.Open(pachClient.Ctx(), []fileset.ID{*id}, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return commitInfo, fs, nil
+}
+
+// commitFileset returns the ID of the file set holding a commit's files, so
+// that it can be opened more than once.
+func (d *driver) commitFileset(pachClient *client.APIClient, commit *pfs.Commit) (*pfs.CommitInfo, *fileset.ID, error) {
 	if commit.Repo.Name == fileSetsRepo {
 		fsid, err := fileset.ParseID(commit.ID)
 		if err != nil {
 			return nil, nil, err
 		}
-		fs, err := d.storage.Open(pachClient.Ctx(), []fileset.ID{*fsid}, opts...)
-		if err != nil {
-			return nil, nil, err
-		}
-		return &pfs.CommitInfo{Commit: commit}, fs, nil
+		return &pfs.CommitInfo{Commit: commit}, fsid, nil
 	}
 	if err := authserver.CheckRepoIsAuthorized(pachClient, commit.Repo.Name, auth.Permission_REPO_READ); err != nil {
 		return nil, nil, err
@@ -134,11 +145,7 @@ func (d *driver) openCommit(pachClient *client.APIClient, commit *pfs.Commit, op
 	if err != nil {
 		return nil, nil, err
 	}
-	fs, err := d.storage.Open(pachClient.Ctx(), []fileset.ID{*id}, opts...)
-	if err != nil {
-		return nil, nil, err
-	}
-	return commitInfo, fs, nil
+	return commitInfo, id, nil
 }
 
 func (d *driver) copyFile(pachClient *client.APIClient, uw *fileset.UnorderedWriter, dst string, src *pfs.File, appendFile bool, tag string) (retErr error) {
@@ -172,9 +179,13 @@ func (d *driver) copyFile(pachClient *client.APIClient, uw *fileset.UnorderedWri
 	return uw.Copy(ctx, fs, appendFile, tag)
 }
 
-func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob string) (Source, error) {
+func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob string, followSymlinks bool) (Source, error) {
 	glob = cleanPath(glob)
-	commitInfo, fs, err := d.openCommit(pachClient, commit, index.WithPrefix(globLiteralPrefix(glob)))
+	commitInfo, id, err := d.commitFileset(pachClient, commit)
+	if err != nil {
+		return nil, err
+	}
+	fs, err := d.storage.Open(pachClient.Ctx(), []fileset.ID{*id}, index.WithPrefix(globLiteralPrefix(glob)))
 	if err != nil {
 		return nil, err
 	}
@@ -184,14 +195,47 @@ func (d *driver) getFile(pachClient *client.APIClient, commit *pfs.Commit, glob
 	}
 	opts := []SourceOption{
 		WithFilter(func(fs fileset.FileSet) fileset.FileSet {
-			return fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+			fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
 				return mf(idx.Path)
 			}, true)
+			if followSymlinks {
+				fs = newSymlinkResolver(fs, func(ctx context.Context, p string) (fileset.File, error) {
+					return d.lookupFile(ctx, commitInfo.Commit, *id, p)
+				})
+			}
+			return fs
 		}),
 	}
 	return NewSource(d.storage, commitInfo, fs, opts...), nil
 }
 
+// lookupFile returns the regular file or symlink at path p in a commit, whose
+// files are in the file set id.
+func (d *driver) lookupFile(ctx context.Context, commit *pfs.Commit, id fileset.ID, p string) (fileset.File, error) {
+	fs, err := d.storage.Open(ctx, []fileset.ID{id}, index.WithPrefix(p))
+	if err != nil {
+		return nil, err
+	}
+	var file fileset.File
+	if err := fs.Iterate(ctx, func(f fileset.File) error {
+		idx := f.Index()
+		if idx.Path == p {
+			file = f
+			return errutil.ErrBreak
+		}
+		if strings.HasPrefix(idx.Path, p+"/") {
+			return errors.Errorf("cannot follow symlink to directory %s", p)
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, err
+	}
+	if file == nil {
+		return nil, &pfsserver.ErrFileNotFound{File: client.NewFile(commit.Repo.Name, commit.ID, p)}
+	}
+	return file, nil
+}
+
 func (d *driver) inspectFile(pachClient *client.APIClient, file *pfs.File) (*pfs.FileInfo, error) {
 	ctx := pachClient.Ctx()
 	p := cleanPath(file.Path)
@@ -429,6 +473,9 @@ func (d *driver) getFileset(pachClient *client.APIClient, commit *pfs.Commit) (*
 	if err != nil {
 		return nil, err
 	}
+	if commitInfo.Error != "" {
+		return nil, pfsserver.ErrCommitBroken{Commit: commitInfo.Commit, Reason: commitInfo.Error}
+	}
 	if commitInfo.Finished != nil {
 		return d.commitStore.GetTotalFileset(pachClient.Ctx(), commitInfo.Commit)
 	}
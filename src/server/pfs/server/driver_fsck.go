@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
 )
 
@@ -220,6 +222,18 @@ func (e ErrProvenanceOfSubvenance) Error() string {
 	return msg.String()
 }
 
+// ErrCommitStorage The data of a commit is missing or corrupt in storage.
+// This struct contains all the information that was used to demonstrate that this invariant is not being satisfied.
+type ErrCommitStorage struct {
+	Commit *pfs.Commit
+	Err    error
+}
+
+func (e ErrCommitStorage) Error() string {
+	return fmt.Sprintf("consistency error: the data of commit %v in repo %v is missing or corrupt: %v",
+		e.Commit.ID, e.Commit.Repo.Name, e.Err)
+}
+
 // fsck verifies that pfs satisfies the following invariants:
 // 1. Branch provenance is transitive
 // 2. Head commit provenance has heads of branch's branch provenance
@@ -585,3 +599,83 @@ func (d *driver) fsck(pachClient *client.APIClient, fix bool, cb func(*pfs.FsckR
 	}
 	return nil
 }
+
+// fsckStorage verifies that the file sets of every commit exist, and that the
+// chunks they reference are present in object storage and match their IDs.
+// sampleRate is the fraction of chunks whose content is read, from 0 (none
+// of them) to 1 (all of them).
+// If fix is true it will mark the commits whose data is missing or corrupt with an error,
+// and clear the error of commits that were marked but whose data is now intact.
+func (d *driver) fsckStorage(pachClient *client.APIClient, fix bool, sampleRate float64, cb func(*pfs.FsckResponse) error) error {
+	if sampleRate < 0 || sampleRate > 1 {
+		return errors.Errorf("sample rate (%v) must be between 0 and 1", sampleRate)
+	}
+	ctx := pachClient.Ctx()
+	checker := d.storage.NewChecker(sampleRate)
+	// marks maps each commit whose error should change to its new error
+	marks := make(map[*pfs.Commit]string)
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions, func(repoName string) error {
+		commitInfo := &pfs.CommitInfo{}
+		return d.commits(repoName).ReadOnly(ctx).List(commitInfo, col.DefaultOptions, func(string) error {
+			err := d.checkCommitStorage(ctx, checker, commitInfo)
+			if err == nil {
+				if commitInfo.Error == "" {
+					return nil
+				}
+				if fix {
+					marks[proto.Clone(commitInfo.Commit).(*pfs.Commit)] = ""
+					return nil
+				}
+				return cb(&pfs.FsckResponse{Error: fmt.Sprintf("commit %v in repo %v is marked as broken (%s), but its data is intact", commitInfo.Commit.ID, repoName, commitInfo.Error)})
+			}
+			if fix && commitInfo.Error == "" {
+				marks[proto.Clone(commitInfo.Commit).(*pfs.Commit)] = err.Error()
+			}
+			return cb(&pfs.FsckResponse{Error: ErrCommitStorage{Commit: commitInfo.Commit, Err: err}.Error()})
+		})
+	}); err != nil {
+		return err
+	}
+	for commit, msg := range marks {
+		if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+			commitInfo := &pfs.CommitInfo{}
+			return d.commits(commit.Repo.Name).ReadWrite(stm).Update(commit.ID, commitInfo, func() error {
+				commitInfo.Error = msg
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+		applied := fmt.Sprintf("marked commit %v in repo %v as broken", commit.ID, commit.Repo.Name)
+		if msg == "" {
+			applied = fmt.Sprintf("cleared the broken mark of commit %v in repo %v", commit.ID, commit.Repo.Name)
+		}
+		if err := cb(&pfs.FsckResponse{Fix: applied}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCommitStorage checks the file sets recorded for a commit. A finished
+// commit must have a total file set, an open commit is checked through its
+// diff file sets.
+func (d *driver) checkCommitStorage(ctx context.Context, checker *fileset.Checker, commitInfo *pfs.CommitInfo) error {
+	total, diffs, err := d.commitStore.GetFilesetIDs(ctx, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished != nil {
+		if total == nil {
+			return errors.Errorf("finished commit has no total file set")
+		}
+		return checker.Check(ctx, *total)
+	}
+	for _, id := range diffs {
+		if err := checker.Check(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
+)
+
+// forkRepo creates repo with a single commit on branch whose contents are
+// those of source. The commit references source's fileset, so no data is
+// copied, and the histories of the two repos are independent afterwards.
+func (d *driver) forkRepo(txnCtx *txnenv.TransactionContext, source *pfs.Commit, repo *pfs.Repo, branch, description string) (*pfs.Commit, error) {
+	if source == nil || source.Repo == nil {
+		return nil, errors.New("source commit cannot be nil")
+	}
+	if repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	if branch == "" {
+		branch = "master"
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, source.Repo.Name, auth.Permission_REPO_READ); err != nil {
+		return nil, err
+	}
+	sourceInfo, err := d.resolveCommit(txnCtx.Stm, source)
+	if err != nil {
+		return nil, err
+	}
+	if sourceInfo.Finished == nil {
+		return nil, errors.Errorf("cannot fork from open commit %s@%s", sourceInfo.Commit.Repo.Name, sourceInfo.Commit.ID)
+	}
+	if err := d.createRepo(txnCtx, repo, description, false); err != nil {
+		return nil, err
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.Stm).Update(repo.Name, repoInfo, func() error {
+		repoInfo.ForkedFrom = sourceInfo.Commit
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	commit, err := d.startCommit(txnCtx, "", client.NewCommit(repo.Name, ""), branch, nil,
+		fmt.Sprintf("fork of %s@%s", sourceInfo.Commit.Repo.Name, sourceInfo.Commit.ID))
+	if err != nil {
+		return nil, err
+	}
+	ctx := txnCtx.Client.Ctx()
+	id, err := d.commitStore.GetTotalFileset(ctx, sourceInfo.Commit)
+	if err != nil {
+		return nil, err
+	}
+	// The source fileset is already compacted, so finishing the commit
+	// reuses it rather than rewriting it.
+	if err := d.commitStore.AddFileset(ctx, commit, *id); err != nil {
+		return nil, err
+	}
+	if err := d.finishCommit(txnCtx, commit, ""); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
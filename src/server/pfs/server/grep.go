@@ -0,0 +1,200 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+const (
+	// grepParallelism is the number of shards of a commit that are searched
+	// concurrently by grepFile.
+	grepParallelism = 8
+	// grepBufferSize is the number of matches that are buffered for each
+	// shard that is waiting for the shards before it to be sent.
+	grepBufferSize = 100
+)
+
+func grepRegexp(pattern string, literal, ignoreCase bool) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern cannot be empty")
+	}
+	if literal {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pattern")
+	}
+	return re, nil
+}
+
+// grepFile calls cb with the lines of the files matching glob in commit that
+// match pattern. The commit's file set is split into shards which are
+// searched in parallel, but matches are returned in path order.
+func (d *driver) grepFile(pachClient *client.APIClient, commit *pfs.Commit, glob, pattern string, literal, ignoreCase bool, cb func(*pfs.GrepFileResponse) error) error {
+	re, err := grepRegexp(pattern, literal, ignoreCase)
+	if err != nil {
+		return err
+	}
+	if glob == "" {
+		glob = "**"
+	}
+	glob = cleanPath(glob)
+	mf, err := globMatchFunction(glob)
+	if err != nil {
+		return err
+	}
+	commitInfo, err := d.inspectCommit(pachClient, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return err
+	}
+	commit = commitInfo.Commit
+	id, err := d.getFileset(pachClient, commit)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(pachClient.Ctx())
+	defer cancel()
+	var pathRanges []*index.PathRange
+	if err := d.storage.Shard(ctx, []fileset.ID{*id}, func(pathRange *index.PathRange) error {
+		pathRanges = append(pathRanges, pathRange)
+		return nil
+	}); err != nil {
+		return err
+	}
+	// Shards are started in order, so the shard whose matches are being sent
+	// is always running and the buffered shards after it can't deadlock it.
+	results := make([]chan *pfs.GrepFileResponse, len(pathRanges))
+	for i := range results {
+		results[i] = make(chan *pfs.GrepFileResponse, grepBufferSize)
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		sem := semaphore.NewWeighted(grepParallelism)
+		for i, pathRange := range pathRanges {
+			i, pathRange := i, pathRange
+			if err := sem.Acquire(ctx, 1); err != nil {
+				for _, result := range results[i:] {
+					close(result)
+				}
+				return err
+			}
+			eg.Go(func() error {
+				defer sem.Release(1)
+				defer close(results[i])
+				return d.grepShard(ctx, commit, *id, pathRange, mf, re, results[i])
+			})
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		for _, result := range results {
+			for resp := range result {
+				if err := cb(resp); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	return eg.Wait()
+}
+
+// grepShard searches the files of a file set in pathRange. Shards share
+// their boundary paths, so the upper bound is treated as exclusive.
+func (d *driver) grepShard(ctx context.Context, commit *pfs.Commit, id fileset.ID, pathRange *index.PathRange, mf func(string) bool, re *regexp.Regexp, out chan<- *pfs.GrepFileResponse) error {
+	fs, err := d.storage.Open(ctx, []fileset.ID{id}, index.WithRange(pathRange))
+	if err != nil {
+		return err
+	}
+	fs = fileset.NewIndexFilter(fs, func(idx *index.Index) bool {
+		if pathRange.Upper != "" && idx.Path >= pathRange.Upper {
+			return false
+		}
+		return !strings.HasSuffix(idx.Path, "/") && idx.File.GetSymlinkTarget() == "" && mf(idx.Path)
+	})
+	return fs.Iterate(ctx, func(f fileset.File) error {
+		file := client.NewFile(commit.Repo.Name, commit.ID, f.Index().Path)
+		pr, pw := io.Pipe()
+		eg, ctx := errgroup.WithContext(ctx)
+		eg.Go(func() error {
+			return pw.CloseWithError(f.Content(pw))
+		})
+		eg.Go(func() (retErr error) {
+			defer func() {
+				// Unblock the writer if the file isn't read to the end.
+				pr.CloseWithError(retErr)
+			}()
+			return grepLines(ctx, pr, re, func(lineNumber int64, line []byte) error {
+				select {
+				case out <- &pfs.GrepFileResponse{File: file, LineNumber: lineNumber, Line: string(line)}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+		})
+		return eg.Wait()
+	})
+}
+
+// maxGrepLineSize is the longest line that GrepFile matches against. Longer
+// lines are truncated to it.
+const maxGrepLineSize = 1024 * 1024
+
+// grepLines calls cb with each line of r that matches re, without its line
+// ending. Lines longer than maxGrepLineSize are truncated, so that a file
+// without line breaks isn't read into memory whole.
+func grepLines(ctx context.Context, r io.Reader, re *regexp.Regexp, cb func(int64, []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxGrepLineSize)
+	// discarding is true while the rest of a truncated line is skipped
+	var discarding bool
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			if discarding {
+				discarding = false
+				return i + 1, nil, nil
+			}
+			return i + 1, bytes.TrimSuffix(data[:i], []byte("\r")), nil
+		}
+		if discarding {
+			return len(data), nil, nil
+		}
+		if len(data) >= maxGrepLineSize {
+			discarding = true
+			return len(data), data[:maxGrepLineSize], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), bytes.TrimSuffix(data, []byte("\r")), nil
+		}
+		return 0, nil, nil
+	})
+	for lineNumber := int64(1); scanner.Scan(); lineNumber++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if re.Match(scanner.Bytes()) {
+			if err := cb(lineNumber, scanner.Bytes()); err != nil {
+				return err
+			}
+		}
+	}
+	return errors.EnsureStack(scanner.Err())
+}
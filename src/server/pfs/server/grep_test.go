@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+)
+
+func TestGrepLines(t *testing.T) {
+	grep := func(data, pattern string) map[int64]string {
+		result := make(map[int64]string)
+		require.NoError(t, grepLines(context.Background(), strings.NewReader(data), regexp.MustCompile(pattern), func(lineNumber int64, line []byte) error {
+			result[lineNumber] = string(line)
+			return nil
+		}))
+		return result
+	}
+	require.Equal(t, map[int64]string{1: "foo", 3: "food"}, grep("foo\r\nbar\nfood", "foo"))
+	require.Equal(t, map[int64]string{2: ""}, grep("a\n\nb\n", "^$"))
+	// Long lines are truncated, and the lines after them are still numbered
+	// correctly.
+	long := strings.Repeat("x", maxGrepLineSize+10)
+	result := grep(long+"y\nfoo\n", "x|foo")
+	require.Equal(t, 2, len(result))
+	require.Equal(t, maxGrepLineSize, len(result[1]))
+	require.Equal(t, "foo", result[2])
+	require.Equal(t, 0, len(grep(long+"y\nfoo\n", "y")))
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
+)
+
+func validateLabels(labels map[string]string) error {
+	for key := range labels {
+		if key == "" {
+			return errors.Errorf("label keys cannot be empty")
+		}
+		if strings.ContainsAny(key, "=,") {
+			return errors.Errorf("label key %q cannot contain '=' or ','", key)
+		}
+	}
+	return nil
+}
+
+// applyLabels merges labels into existing, removes the keys in remove, and
+// returns the result. A nil map is returned in place of an empty one so that
+// unlabelled objects don't carry an empty map around.
+func applyLabels(existing, labels map[string]string, remove []string) map[string]string {
+	result := make(map[string]string)
+	for key, value := range existing {
+		result[key] = value
+	}
+	for key, value := range labels {
+		result[key] = value
+	}
+	for _, key := range remove {
+		delete(result, key)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// matchLabels returns true if labels contains every label in selector.
+func matchLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// setLabels updates the labels of exactly one of repo, branch and commit.
+func (d *driver) setLabels(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, branch *pfs.Branch, commit *pfs.Commit, labels map[string]string, remove []string) error {
+	set := 0
+	var repoName string
+	if repo != nil {
+		set++
+		repoName = repo.Name
+	}
+	if branch != nil {
+		if branch.Repo == nil {
+			return errors.New("branch repo cannot be nil")
+		}
+		set++
+		repoName = branch.Repo.Name
+	}
+	if commit != nil {
+		if commit.Repo == nil {
+			return errors.New("commit repo cannot be nil")
+		}
+		set++
+		repoName = commit.Repo.Name
+	}
+	if set != 1 {
+		return errors.New("exactly one of repo, branch and commit must be set")
+	}
+	if err := validateLabels(labels); err != nil {
+		return err
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, repoName, auth.Permission_REPO_WRITE); err != nil {
+		return err
+	}
+	switch {
+	case branch != nil:
+		branchInfo := &pfs.BranchInfo{}
+		return d.branches(repoName).ReadWrite(txnCtx.Stm).Update(branch.Name, branchInfo, func() error {
+			branchInfo.Labels = applyLabels(branchInfo.Labels, labels, remove)
+			return nil
+		})
+	case commit != nil:
+		commitInfo, err := d.resolveCommit(txnCtx.Stm, commit)
+		if err != nil {
+			return err
+		}
+		return d.commits(repoName).ReadWrite(txnCtx.Stm).Update(commitInfo.Commit.ID, commitInfo, func() error {
+			commitInfo.Labels = applyLabels(commitInfo.Labels, labels, remove)
+			return nil
+		})
+	default:
+		repoInfo := &pfs.RepoInfo{}
+		return d.repos.ReadWrite(txnCtx.Stm).Update(repoName, repoInfo, func() error {
+			repoInfo.Labels = applyLabels(repoInfo.Labels, labels, remove)
+			return nil
+		})
+	}
+}
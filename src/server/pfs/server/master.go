@@ -35,6 +35,9 @@ func (d *driver) master(env serviceenv.ServiceEnv) {
 			gc := chunk.NewGC(d.storage.ChunkStorage())
 			return gc.RunForever(ctx)
 		})
+		eg.Go(func() error {
+			return d.retentionLoop(ctx)
+		})
 		return eg.Wait()
 	}, backoff.NewInfiniteBackOff(), func(err error, _ time.Duration) error {
 		log.Errorf("error in pfs master: %v", err)
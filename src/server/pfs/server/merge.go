@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/renew"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+)
+
+// mergeChange is a change to a single file on one side of a merge. new is nil
+// if the file was deleted.
+type mergeChange struct {
+	old, new *pfs.FileInfo
+}
+
+// mergeBranch applies the changes made on source since its common ancestor
+// with target onto target as a new commit. Paths changed differently on both
+// branches are conflicts, which are resolved according to prefer. If any
+// conflicts are left unresolved no commit is created.
+func (d *driver) mergeBranch(pachClient *client.APIClient, source, target *pfs.Branch, prefer pfs.MergePreference, description string, dryRun bool) (*pfs.MergeBranchResponse, error) {
+	if source == nil || source.Repo == nil || target == nil || target.Repo == nil {
+		return nil, errors.New("source and target branches must be set")
+	}
+	if source.Repo.Name != target.Repo.Name {
+		return nil, errors.Errorf("cannot merge branches from different repos (%s and %s)", source.Repo.Name, target.Repo.Name)
+	}
+	if source.Name == target.Name {
+		return nil, errors.Errorf("cannot merge branch %s into itself", source.Name)
+	}
+	repo := source.Repo.Name
+	sourceHead, err := d.inspectCommit(pachClient, client.NewCommit(repo, source.Name), pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	targetHead, err := d.inspectCommit(pachClient, client.NewCommit(repo, target.Name), pfs.CommitState_FINISHED)
+	if err != nil {
+		return nil, err
+	}
+	base, err := d.mergeBase(pachClient, sourceHead, targetHead)
+	if err != nil {
+		return nil, err
+	}
+	response := &pfs.MergeBranchResponse{}
+	if base != nil && base.ID == sourceHead.Commit.ID {
+		// target already contains every change on source
+		return response, nil
+	}
+	sourceChanges, err := d.mergeChanges(pachClient, base, sourceHead.Commit)
+	if err != nil {
+		return nil, err
+	}
+	targetChanges, err := d.mergeChanges(pachClient, base, targetHead.Commit)
+	if err != nil {
+		return nil, err
+	}
+	for p, sourceChange := range sourceChanges {
+		targetChange, ok := targetChanges[p]
+		if ok && !sameChange(sourceChange, targetChange) {
+			response.Conflicts = append(response.Conflicts, &pfs.MergeConflict{
+				Path:   p,
+				Source: sourceChange.new,
+				Target: targetChange.new,
+			})
+			if prefer != pfs.MergePreference_PREFER_SOURCE {
+				continue
+			}
+		} else if ok {
+			// Both branches made the same change.
+			continue
+		}
+		response.Merged = append(response.Merged, p)
+	}
+	sort.Strings(response.Merged)
+	sort.Slice(response.Conflicts, func(i, j int) bool { return response.Conflicts[i].Path < response.Conflicts[j].Path })
+	if dryRun || len(response.Merged) == 0 || (len(response.Conflicts) > 0 && prefer == pfs.MergePreference_PREFER_NEITHER) {
+		return response, nil
+	}
+	if description == "" {
+		description = fmt.Sprintf("merge %s@%s into %s", repo, source.Name, target.Name)
+	}
+	parentID, err := d.getFileset(pachClient, targetHead.Commit)
+	if err != nil {
+		return nil, err
+	}
+	// The merged fileset is written before the transaction, which may be
+	// retried, and is only attached to the new commit inside it.
+	if err := d.storage.WithRenewer(pachClient.Ctx(), defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
+		id, err := d.withUnorderedWriter(ctx, renewer, false, func(uw *fileset.UnorderedWriter) error {
+			for _, p := range response.Merged {
+				if sourceChanges[p].new == nil {
+					if err := uw.Delete(p); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := d.copyFile(pachClient, uw, p, &pfs.File{Commit: sourceHead.Commit, Path: p}, false, ""); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, fileset.WithParentID(parentID))
+		if err != nil {
+			return err
+		}
+		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+			commit, err := d.startCommit(txnCtx, "", client.NewCommit(repo, ""), target.Name, nil, description)
+			if err != nil {
+				return err
+			}
+			// Record the merged commit, so that the next merge from source only
+			// applies the changes made after it.
+			commitInfo := &pfs.CommitInfo{}
+			if err := d.commits(repo).ReadWrite(txnCtx.Stm).Update(commit.ID, commitInfo, func() error {
+				commitInfo.MergeParent = sourceHead.Commit
+				return nil
+			}); err != nil {
+				return err
+			}
+			// The merged fileset was computed against targetHead.
+			if commitInfo.ParentCommit == nil || commitInfo.ParentCommit.ID != targetHead.Commit.ID {
+				return errors.Errorf("branch %s moved during the merge, try again", target.Name)
+			}
+			if err := d.commitStore.AddFileset(ctx, commit, *id); err != nil {
+				return err
+			}
+			response.Commit = commit
+			return d.finishCommit(txnCtx, commit, "")
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// mergeBase returns the most recent commit which is an ancestor of (or equal
+// to) both source and target, or nil if they have no common ancestor. The
+// ancestors of a merge commit include the source commit that was merged.
+func (d *driver) mergeBase(pachClient *client.APIClient, source, target *pfs.CommitInfo) (*pfs.Commit, error) {
+	commits := d.commits(target.Commit.Repo.Name).ReadOnly(pachClient.Ctx())
+	targetAncestors := make(map[string]bool)
+	if err := walkMergeAncestors(commits, target.Commit, func(commit *pfs.Commit) (bool, error) {
+		targetAncestors[commit.ID] = true
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	var base *pfs.Commit
+	if err := walkMergeAncestors(commits, source.Commit, func(commit *pfs.Commit) (bool, error) {
+		if targetAncestors[commit.ID] {
+			base = commit
+			return true, nil
+		}
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	return base, nil
+}
+
+// walkMergeAncestors calls f with commit and each of its ancestors, following
+// both parent commits and merge parents, nearest first. It stops if f returns
+// true.
+func walkMergeAncestors(commits col.ReadonlyCollection, commit *pfs.Commit, f func(*pfs.Commit) (bool, error)) error {
+	visited := make(map[string]bool)
+	queue := []*pfs.Commit{commit}
+	for len(queue) > 0 {
+		commit := queue[0]
+		queue = queue[1:]
+		if visited[commit.ID] {
+			continue
+		}
+		visited[commit.ID] = true
+		if stop, err := f(commit); err != nil || stop {
+			return err
+		}
+		commitInfo := &pfs.CommitInfo{}
+		if err := commits.Get(commit.ID, commitInfo); err != nil {
+			return err
+		}
+		if commitInfo.ParentCommit != nil {
+			queue = append(queue, commitInfo.ParentCommit)
+		}
+		if commitInfo.MergeParent != nil {
+			queue = append(queue, commitInfo.MergeParent)
+		}
+	}
+	return nil
+}
+
+// mergeChanges returns the files which changed between base and head, keyed
+// by path. A nil base is treated as an empty commit.
+func (d *driver) mergeChanges(pachClient *client.APIClient, base, head *pfs.Commit) (map[string]*mergeChange, error) {
+	changes := make(map[string]*mergeChange)
+	if err := d.diffFile(pachClient, &pfs.File{Commit: base, Path: "/"}, &pfs.File{Commit: head, Path: "/"}, func(oldFi, newFi *pfs.FileInfo) error {
+		if oldFi != nil && oldFi.FileType == pfs.FileType_DIR || newFi != nil && newFi.FileType == pfs.FileType_DIR {
+			return nil
+		}
+		change := &mergeChange{old: oldFi, new: newFi}
+		if newFi != nil {
+			changes[newFi.File.Path] = change
+		} else {
+			changes[oldFi.File.Path] = change
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// sameChange returns true if both changes leave the file in the same state.
+func sameChange(a, b *mergeChange) bool {
+	if a.new == nil || b.new == nil {
+		return a.new == nil && b.new == nil
+	}
+	return equalFileInfos(a.new, b.new)
+}
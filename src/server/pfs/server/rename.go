@@ -0,0 +1,362 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+// renamer rewrites references to a renamed repo, or to a renamed branch if
+// oldBranch is set. References are rewritten in place.
+type renamer struct {
+	oldRepo, newRepo     string
+	oldBranch, newBranch string
+}
+
+func (r *renamer) repo(repo *pfs.Repo) {
+	if r.oldBranch == "" && repo != nil && repo.Name == r.oldRepo {
+		repo.Name = r.newRepo
+	}
+}
+
+func (r *renamer) branch(branch *pfs.Branch) {
+	if branch == nil || branch.Repo == nil {
+		return
+	}
+	if r.oldBranch == "" {
+		r.repo(branch.Repo)
+		return
+	}
+	if branch.Repo.Name == r.oldRepo && branch.Name == r.oldBranch {
+		branch.Name = r.newBranch
+	}
+}
+
+// branches rewrites bs, which is kept sorted (see branchSet).
+func (r *renamer) branches(bs []*pfs.Branch) {
+	for _, b := range bs {
+		r.branch(b)
+	}
+	sort.Slice(bs, func(i, j int) bool { return branchKey(bs[i]) < branchKey(bs[j]) })
+}
+
+func (r *renamer) commit(commit *pfs.Commit) {
+	if commit != nil {
+		r.repo(commit.Repo)
+	}
+}
+
+func (r *renamer) repoInfo(repoInfo *pfs.RepoInfo) {
+	r.repo(repoInfo.Repo)
+	r.branches(repoInfo.Branches)
+	r.commit(repoInfo.ForkedFrom)
+	for _, tag := range repoInfo.Tags {
+		r.repo(tag.Repo)
+	}
+}
+
+func (r *renamer) tagInfo(tagInfo *pfs.TagInfo) {
+	r.repo(tagInfo.Tag.Repo)
+	r.commit(tagInfo.Commit)
+}
+
+func (r *renamer) branchInfo(branchInfo *pfs.BranchInfo) {
+	r.branch(branchInfo.Branch)
+	if branchInfo.Branch != nil && branchInfo.Name != "" {
+		branchInfo.Name = branchInfo.Branch.Name
+	}
+	r.commit(branchInfo.Head)
+	r.branches(branchInfo.Provenance)
+	r.branches(branchInfo.Subvenance)
+	r.branches(branchInfo.DirectProvenance)
+	// Triggers always refer to a branch in the same repo.
+	if branchInfo.Trigger != nil && r.oldBranch != "" && branchInfo.Branch.Repo.Name == r.oldRepo && branchInfo.Trigger.Branch == r.oldBranch {
+		branchInfo.Trigger.Branch = r.newBranch
+	}
+}
+
+func (r *renamer) commitInfo(commitInfo *pfs.CommitInfo) {
+	r.commit(commitInfo.Commit)
+	r.branch(commitInfo.Branch)
+	r.commit(commitInfo.ParentCommit)
+	for _, child := range commitInfo.ChildCommits {
+		r.commit(child)
+	}
+	for _, prov := range commitInfo.Provenance {
+		r.commit(prov.Commit)
+		r.branch(prov.Branch)
+	}
+	for _, subv := range commitInfo.Subvenance {
+		r.commit(subv.Lower)
+		r.commit(subv.Upper)
+	}
+}
+
+// renameRepo renames repo to newName. Its branches and commits are moved to
+// the new name, and references to them in other repos are rewritten, so the
+// repo keeps its history and provenance. The repo's role binding is moved as
+// well.
+func (d *driver) renameRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, newName string) error {
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := ancestry.ValidateName(newName); err != nil {
+		return err
+	}
+	for _, name := range []string{repo.Name, newName} {
+		if name == ppsconsts.SpecRepo || name == fileSetsRepo {
+			return errors.Errorf("%s is a reserved name", name)
+		}
+	}
+	if repo.Name == newName {
+		return errors.Errorf("repo %s already has that name", repo.Name)
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, repo.Name, auth.Permission_REPO_DELETE); err != nil {
+		return err
+	}
+	repos := d.repos.ReadWrite(txnCtx.Stm)
+	repoInfo := &pfs.RepoInfo{}
+	if err := repos.Get(repo.Name, repoInfo); err != nil {
+		return err
+	}
+	if err := repos.Get(newName, &pfs.RepoInfo{}); err == nil {
+		return pfsserver.ErrRepoExists{Repo: &pfs.Repo{Name: newName}}
+	} else if !col.IsErrNotFound(err) {
+		return errors.Wrapf(err, "error checking whether \"%s\" exists", newName)
+	}
+	r := &renamer{oldRepo: repo.Name, newRepo: newName}
+	// related holds the other repos which may refer to this one
+	related := make(map[string]bool)
+	addRelated := func(branches []*pfs.Branch) {
+		for _, b := range branches {
+			if b.Repo.Name != repo.Name {
+				related[b.Repo.Name] = true
+			}
+		}
+	}
+
+	oldBranches := d.branches(repo.Name).ReadWrite(txnCtx.Stm)
+	newBranches := d.branches(newName).ReadWrite(txnCtx.Stm)
+	for _, branch := range repoInfo.Branches {
+		branchInfo := &pfs.BranchInfo{}
+		if err := oldBranches.Get(branch.Name, branchInfo); err != nil {
+			return errors.Wrapf(err, "error getting branch %s", branch.Name)
+		}
+		for _, prov := range branchInfo.Provenance {
+			if prov.Repo.Name == ppsconsts.SpecRepo {
+				return errors.Errorf("cannot rename %s, it is the output repo of a pipeline", repo.Name)
+			}
+		}
+		addRelated(branchInfo.Provenance)
+		addRelated(branchInfo.Subvenance)
+		r.branchInfo(branchInfo)
+		if err := newBranches.Put(branch.Name, branchInfo); err != nil {
+			return err
+		}
+	}
+
+	oldTags := d.tags(repo.Name).ReadWrite(txnCtx.Stm)
+	newTags := d.tags(newName).ReadWrite(txnCtx.Stm)
+	for _, tag := range repoInfo.Tags {
+		tagInfo := &pfs.TagInfo{}
+		if err := oldTags.Get(tag.Name, tagInfo); err != nil {
+			return errors.Wrapf(err, "error getting tag %s", tag.Name)
+		}
+		r.tagInfo(tagInfo)
+		if err := newTags.Put(tag.Name, tagInfo); err != nil {
+			return err
+		}
+	}
+
+	// The STM can't list keys, so the commits are listed outside of it. That's
+	// safe because starting a commit writes its RepoInfo, which the STM read
+	// above, so a commit created after that read makes the STM retry and list
+	// again. Even so, only the commits that were copied are deleted.
+	var commitIDs []string
+	if err := d.commits(repo.Name).ReadOnly(txnCtx.ClientContext).List(&pfs.CommitInfo{}, col.DefaultOptions, func(commitID string) error {
+		commitIDs = append(commitIDs, commitID)
+		return nil
+	}); err != nil {
+		return err
+	}
+	oldCommits := d.commits(repo.Name).ReadWrite(txnCtx.Stm)
+	newCommits := d.commits(newName).ReadWrite(txnCtx.Stm)
+	for _, commitID := range commitIDs {
+		commitInfo := &pfs.CommitInfo{}
+		if err := oldCommits.Get(commitID, commitInfo); err != nil {
+			return errors.Wrapf(err, "error getting commit %s", commitID)
+		}
+		for _, prov := range commitInfo.Provenance {
+			addRelated([]*pfs.Branch{prov.Branch})
+		}
+		for _, subv := range commitInfo.Subvenance {
+			if subv.Upper.Repo.Name != repo.Name {
+				related[subv.Upper.Repo.Name] = true
+			}
+		}
+		r.commitInfo(commitInfo)
+		if err := newCommits.Put(commitID, commitInfo); err != nil {
+			return err
+		}
+		if err := oldCommits.Delete(commitID); err != nil {
+			return errors.Wrapf(err, "error deleting commit %s", commitID)
+		}
+		if commitInfo.Finished == nil {
+			if err := d.openCommits.ReadWrite(txnCtx.Stm).Put(commitID, commitInfo.Commit); err != nil {
+				return err
+			}
+		}
+	}
+	oldBranches.DeleteAll()
+	oldTags.DeleteAll()
+	if err := repos.Delete(repo.Name); err != nil {
+		return errors.Wrapf(err, "repos.Delete")
+	}
+	r.repoInfo(repoInfo)
+	if err := repos.Create(newName, repoInfo); err != nil {
+		return errors.Wrapf(err, "repos.Create")
+	}
+
+	// Forks can refer to the repo without any provenance.
+	otherRepoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(txnCtx.ClientContext).List(otherRepoInfo, col.DefaultOptions, func(name string) error {
+		if otherRepoInfo.ForkedFrom != nil && otherRepoInfo.ForkedFrom.Repo.Name == repo.Name {
+			related[name] = true
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	delete(related, repo.Name)
+	for name := range related {
+		if err := d.rewriteRepoReferences(txnCtx, name, r); err != nil {
+			return err
+		}
+	}
+
+	if err := txnCtx.Auth().RenameRoleBindingInTransaction(txnCtx,
+		&auth.Resource{Type: auth.ResourceType_REPO, Name: repo.Name},
+		&auth.Resource{Type: auth.ResourceType_REPO, Name: newName},
+	); err != nil && !auth.IsErrNotActivated(err) {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return nil
+}
+
+// renameBranch renames branch to newName, and rewrites references to it in
+// its repo and in the branches and commits it's provenant on or subvenant to.
+func (d *driver) renameBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, newName string) error {
+	if branch == nil {
+		return errors.New("branch cannot be nil")
+	}
+	if branch.Repo == nil {
+		return errors.New("branch repo cannot be nil")
+	}
+	if err := ancestry.ValidateName(newName); err != nil {
+		return err
+	}
+	if branch.Name == newName {
+		return errors.Errorf("branch %s already has that name", branch.Name)
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.Name, auth.Permission_REPO_DELETE_BRANCH); err != nil {
+		return err
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, branch.Repo.Name, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+		return err
+	}
+	branches := d.branches(branch.Repo.Name).ReadWrite(txnCtx.Stm)
+	branchInfo := &pfs.BranchInfo{}
+	if err := branches.Get(branch.Name, branchInfo); err != nil {
+		return errors.Wrapf(err, "branches.Get")
+	}
+	if branchInfo.Protection != nil && branchInfo.Protection.NoDelete {
+		return pfsserver.ErrBranchProtected{Branch: branch, Reason: "it cannot be renamed"}
+	}
+	for _, prov := range branchInfo.Provenance {
+		if prov.Repo.Name == ppsconsts.SpecRepo {
+			return errors.Errorf("cannot rename %s@%s, it is written by a pipeline", branch.Repo.Name, branch.Name)
+		}
+	}
+	if err := branches.Get(newName, &pfs.BranchInfo{}); err == nil {
+		return errors.Errorf("branch %s already exists in repo %s", newName, branch.Repo.Name)
+	} else if !col.IsErrNotFound(err) {
+		return errors.Wrapf(err, "branches.Get")
+	}
+	if err := d.checkNotTagName(txnCtx.Stm, branch.Repo.Name, newName); err != nil {
+		return err
+	}
+	if err := branches.Delete(branch.Name); err != nil {
+		return errors.Wrapf(err, "branches.Delete")
+	}
+	if err := branches.Put(newName, branchInfo); err != nil {
+		return errors.Wrapf(err, "branches.Put")
+	}
+
+	r := &renamer{
+		oldRepo:   branch.Repo.Name,
+		newRepo:   branch.Repo.Name,
+		oldBranch: branch.Name,
+		newBranch: newName,
+	}
+	related := map[string]bool{branch.Repo.Name: true}
+	for _, b := range append(branchInfo.Provenance, branchInfo.Subvenance...) {
+		related[b.Repo.Name] = true
+	}
+	for name := range related {
+		if err := d.rewriteRepoReferences(txnCtx, name, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rewriteRepoReferences applies r to repo's RepoInfo and to all of its
+// branches and commits.
+func (d *driver) rewriteRepoReferences(txnCtx *txnenv.TransactionContext, repo string, r *renamer) error {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.Stm).Update(repo, repoInfo, func() error {
+		r.repoInfo(repoInfo)
+		return nil
+	}); err != nil {
+		return errors.Wrapf(err, "error updating repo %s", repo)
+	}
+	branches := d.branches(repo).ReadWrite(txnCtx.Stm)
+	for _, branch := range repoInfo.Branches {
+		branchInfo := &pfs.BranchInfo{}
+		if err := branches.Update(branch.Name, branchInfo, func() error {
+			r.branchInfo(branchInfo)
+			return nil
+		}); err != nil {
+			return errors.Wrapf(err, "error updating branch %s@%s", repo, branch.Name)
+		}
+	}
+	// As in renameRepo, the commits are listed outside of the STM, which
+	// retries if a commit is started after it read the RepoInfo above.
+	var commitIDs []string
+	if err := d.commits(repo).ReadOnly(txnCtx.ClientContext).List(&pfs.CommitInfo{}, col.DefaultOptions, func(commitID string) error {
+		commitIDs = append(commitIDs, commitID)
+		return nil
+	}); err != nil {
+		return err
+	}
+	commits := d.commits(repo).ReadWrite(txnCtx.Stm)
+	for _, commitID := range commitIDs {
+		commitInfo := &pfs.CommitInfo{}
+		if err := commits.Update(commitID, commitInfo, func() error {
+			r.commitInfo(commitInfo)
+			return nil
+		}); err != nil {
+			return errors.Wrapf(err, "error updating commit %s@%s", repo, commitID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+const (
+	// retentionInterval is how often the PFS master enforces retention
+	// policies.
+	retentionInterval = 10 * time.Minute
+)
+
+func validateRetentionPolicy(policy *pfs.RetentionPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.KeepCommits < 0 {
+		return errors.Errorf("retention policy cannot keep a negative number of commits")
+	}
+	if policy.KeepDuration != nil {
+		keepDuration, err := types.DurationFromProto(policy.KeepDuration)
+		if err != nil {
+			return errors.Wrapf(err, "invalid retention duration")
+		}
+		if keepDuration <= 0 {
+			return errors.Errorf("retention duration must be positive")
+		}
+	}
+	if policy.KeepCommits == 0 && policy.KeepDuration == nil {
+		return errors.Errorf("retention policy must keep either a number of commits or a duration")
+	}
+	return nil
+}
+
+// setRetentionPolicy sets the retention policy of repo, or of branch if it is
+// set.
+func (d *driver) setRetentionPolicy(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, branch *pfs.Branch, policy *pfs.RetentionPolicy) error {
+	if (repo == nil) == (branch == nil) {
+		return errors.New("exactly one of repo and branch must be set")
+	}
+	if branch != nil && branch.Repo == nil {
+		return errors.New("branch repo cannot be nil")
+	}
+	if err := validateRetentionPolicy(policy); err != nil {
+		return err
+	}
+	repoName := ""
+	if repo != nil {
+		repoName = repo.Name
+	} else {
+		repoName = branch.Repo.Name
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, repoName, auth.Permission_REPO_DELETE_COMMIT); err != nil {
+		return err
+	}
+	if branch != nil {
+		branchInfo := &pfs.BranchInfo{}
+		return d.branches(branch.Repo.Name).ReadWrite(txnCtx.Stm).Update(branch.Name, branchInfo, func() error {
+			branchInfo.Retention = policy
+			return nil
+		})
+	}
+	repoInfo := &pfs.RepoInfo{}
+	return d.repos.ReadWrite(txnCtx.Stm).Update(repo.Name, repoInfo, func() error {
+		repoInfo.Retention = policy
+		return nil
+	})
+}
+
+// enforceRetention squashes the commits in repo, or in every repo if repo is
+// nil, which are no longer kept by any retention policy. It returns the
+// commits that were squashed, or that would be squashed if dryRun is set.
+func (d *driver) enforceRetention(ctx context.Context, repo *pfs.Repo, dryRun bool) ([]*pfs.Commit, error) {
+	var repoNames []string
+	if repo != nil {
+		repoNames = append(repoNames, repo.Name)
+	} else {
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions, func(repoName string) error {
+			if repoName != ppsconsts.SpecRepo {
+				repoNames = append(repoNames, repoName)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	var result []*pfs.Commit
+	for _, repoName := range repoNames {
+		expired, err := d.expiredCommits(ctx, repoName, now)
+		if err != nil {
+			return result, errors.Wrapf(err, "error computing expired commits in repo %q", repoName)
+		}
+		for _, commit := range expired {
+			if !dryRun {
+				if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+					return d.squashCommit(txnCtx, commit)
+				}); err != nil {
+					// Commits downstream of an expired commit may be tagged.
+					if pfsserver.IsCommitTaggedErr(err) {
+						continue
+					}
+					return result, errors.Wrapf(err, "error squashing commit %s@%s", commit.Repo.Name, commit.ID)
+				}
+			}
+			result = append(result, commit)
+		}
+	}
+	return result, nil
+}
+
+// expiredCommits returns the commits in repo which are not kept by the
+// retention policy of any of the repo's branches. Commits on branches without
+// a policy and tagged commits are never returned.
+func (d *driver) expiredCommits(ctx context.Context, repoName string, now time.Time) ([]*pfs.Commit, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repoName, repoInfo); err != nil {
+		return nil, err
+	}
+	branches := d.branches(repoName).ReadOnly(ctx)
+	commits := d.commits(repoName).ReadOnly(ctx)
+	keep := make(map[string]bool)
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags(repoName).ReadOnly(ctx).List(tagInfo, col.DefaultOptions, func(string) error {
+		keep[tagInfo.Commit.ID] = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var expired []*pfs.Commit
+	for _, branch := range repoInfo.Branches {
+		branchInfo := &pfs.BranchInfo{}
+		if err := branches.Get(branch.Name, branchInfo); err != nil {
+			return nil, err
+		}
+		if branchInfo.Head == nil {
+			continue
+		}
+		keep[branchInfo.Head.ID] = true
+		if err := d.keepLiveProvenance(ctx, repoName, branchInfo, keep); err != nil {
+			return nil, err
+		}
+		policy := branchInfo.Retention
+		if policy == nil {
+			policy = repoInfo.Retention
+		}
+		if policy == nil {
+			continue
+		}
+		commit := branchInfo.Head
+		for i := int64(0); commit != nil; i++ {
+			commitInfo := &pfs.CommitInfo{}
+			if err := commits.Get(commit.ID, commitInfo); err != nil {
+				return nil, err
+			}
+			retained, err := isRetained(policy, commitInfo, i, now)
+			if err != nil {
+				return nil, err
+			}
+			if retained {
+				keep[commit.ID] = true
+			} else {
+				expired = append(expired, commitInfo.Commit)
+			}
+			commit = commitInfo.ParentCommit
+		}
+	}
+	// A commit may be expired according to one branch but kept by another.
+	var result []*pfs.Commit
+	seen := make(map[string]bool)
+	for _, commit := range expired {
+		if keep[commit.ID] || seen[commit.ID] {
+			continue
+		}
+		seen[commit.ID] = true
+		result = append(result, commit)
+	}
+	return result, nil
+}
+
+// keepLiveProvenance marks the commits in repoName that are provenance of the
+// heads of branchInfo's downstream branches, so that live output isn't
+// deleted along with its input.
+func (d *driver) keepLiveProvenance(ctx context.Context, repoName string, branchInfo *pfs.BranchInfo, keep map[string]bool) error {
+	for _, subvBranch := range branchInfo.Subvenance {
+		subvBranchInfo := &pfs.BranchInfo{}
+		if err := d.branches(subvBranch.Repo.Name).ReadOnly(ctx).Get(subvBranch.Name, subvBranchInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if subvBranchInfo.Head == nil {
+			continue
+		}
+		headInfo := &pfs.CommitInfo{}
+		if err := d.commits(subvBranch.Repo.Name).ReadOnly(ctx).Get(subvBranchInfo.Head.ID, headInfo); err != nil {
+			return err
+		}
+		for _, prov := range headInfo.Provenance {
+			if prov.Commit.Repo.Name == repoName {
+				keep[prov.Commit.ID] = true
+			}
+		}
+	}
+	return nil
+}
+
+// isRetained returns true if commitInfo, which is the i-th commit on a branch
+// counting back from its head, is kept by policy.
+func isRetained(policy *pfs.RetentionPolicy, commitInfo *pfs.CommitInfo, i int64, now time.Time) (bool, error) {
+	if commitInfo.Finished == nil || provenantOnInput(commitInfo.Provenance) {
+		return true, nil
+	}
+	if policy.KeepCommits > 0 && i < policy.KeepCommits {
+		return true, nil
+	}
+	if policy.KeepDuration != nil {
+		keepDuration, err := types.DurationFromProto(policy.KeepDuration)
+		if err != nil {
+			return false, err
+		}
+		finished, err := types.TimestampFromProto(commitInfo.Finished)
+		if err != nil {
+			return false, err
+		}
+		if now.Sub(finished) < keepDuration {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// retentionLoop periodically enforces the retention policies of all repos. It
+// is run by the PFS master.
+func (d *driver) retentionLoop(ctx context.Context) error {
+	ticker := time.NewTicker(retentionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		squashed, err := d.enforceRetention(ctx, nil, false)
+		if len(squashed) > 0 {
+			log.Infof("retention: squashed %d commits", len(squashed))
+		}
+		if err != nil {
+			log.Errorf("error enforcing retention policies: %v", err)
+		}
+	}
+}
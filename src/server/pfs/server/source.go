@@ -59,10 +59,16 @@ func (s *source) Iterate(ctx context.Context, cb func(*pfs.FileInfo, fileset.Fil
 			File:      client.NewFile(s.commitInfo.Commit.Repo.Name, s.commitInfo.Commit.ID, idx.Path),
 			FileType:  pfs.FileType_FILE,
 			Committed: s.commitInfo.Finished,
+			Metadata:  idx.File.GetMetadata(),
+			Mode:      idx.File.GetMode(),
 		}
 		if fileset.IsDir(idx.Path) {
 			fi.FileType = pfs.FileType_DIR
 		}
+		if target := idx.File.GetSymlinkTarget(); target != "" {
+			fi.FileType = pfs.FileType_SYMLINK
+			fi.SymlinkTarget = target
+		}
 		if s.full {
 			cachedFi, ok := checkFileInfoCache(cache, idx)
 			if ok {
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"io"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset"
+	"github.com/pachyderm/pachyderm/v2/src/internal/storage/fileset/index"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"golang.org/x/net/context"
+)
+
+// maxSymlinkDepth is the number of symlinks that will be followed when
+// resolving a symlink, which matches the Linux limit.
+const maxSymlinkDepth = 40
+
+var _ fileset.FileSet = &symlinkResolver{}
+
+// symlinkResolver replaces the symlinks in a file set with the files they
+// point to, keeping the path of the symlink.
+type symlinkResolver struct {
+	fs     fileset.FileSet
+	lookup func(context.Context, string) (fileset.File, error)
+}
+
+// newSymlinkResolver creates a symlink resolver which uses lookup to get the
+// file at a path in the commit being read.
+func newSymlinkResolver(fs fileset.FileSet, lookup func(context.Context, string) (fileset.File, error)) fileset.FileSet {
+	return &symlinkResolver{
+		fs:     fs,
+		lookup: lookup,
+	}
+}
+
+func (sr *symlinkResolver) Iterate(ctx context.Context, cb func(fileset.File) error, deletive ...bool) error {
+	if len(deletive) > 0 && deletive[0] {
+		return sr.fs.Iterate(ctx, cb, deletive...)
+	}
+	return sr.fs.Iterate(ctx, func(f fileset.File) error {
+		idx := f.Index()
+		if idx.File.GetSymlinkTarget() == "" {
+			return cb(f)
+		}
+		target, err := sr.follow(ctx, f)
+		if err != nil {
+			return err
+		}
+		return cb(&resolvedSymlink{
+			idx: &index.Index{
+				Path: idx.Path,
+				File: target.Index().File,
+			},
+			target: target,
+		})
+	})
+}
+
+func (sr *symlinkResolver) follow(ctx context.Context, f fileset.File) (fileset.File, error) {
+	link := f.Index().Path
+	for i := 0; i < maxSymlinkDepth; i++ {
+		idx := f.Index()
+		target := idx.File.GetSymlinkTarget()
+		if target == "" {
+			return f, nil
+		}
+		var err error
+		f, err = sr.lookup(ctx, pfs.ResolveSymlink(idx.Path, target))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, errors.Errorf("too many levels of symlinks when resolving %s", link)
+}
+
+type resolvedSymlink struct {
+	idx    *index.Index
+	target fileset.File
+}
+
+func (rs *resolvedSymlink) Index() *index.Index {
+	return rs.idx
+}
+
+func (rs *resolvedSymlink) Content(w io.Writer) error {
+	return rs.target.Content(w)
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/auth"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ancestry"
+	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	authserver "github.com/pachyderm/pachyderm/v2/src/server/auth/server"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+func validateTag(tag *pfs.Tag) error {
+	if tag == nil {
+		return errors.New("tag cannot be nil")
+	}
+	if tag.Repo == nil {
+		return errors.New("tag repo cannot be nil")
+	}
+	return nil
+}
+
+// createTag creates tag, which refers to the commit that commit currently
+// resolves to. Tags can't be moved once they're created, and they share a
+// namespace with the branches of their repo. Tags are authorized with the
+// same permissions as branches.
+func (d *driver) createTag(txnCtx *txnenv.TransactionContext, tag *pfs.Tag, commit *pfs.Commit, description string) error {
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+	if commit == nil {
+		return errors.New("commit cannot be nil")
+	}
+	if commit.Repo == nil {
+		commit = client.NewCommit(tag.Repo.Name, commit.ID)
+	}
+	if commit.Repo.Name != tag.Repo.Name {
+		return errors.Errorf("cannot tag commit in repo %s with a tag in repo %s", commit.Repo.Name, tag.Repo.Name)
+	}
+	if err := ancestry.ValidateName(tag.Name); err != nil {
+		return err
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo.Name, auth.Permission_REPO_CREATE_BRANCH); err != nil {
+		return err
+	}
+	if err := d.branches(tag.Repo.Name).ReadWrite(txnCtx.Stm).Get(tag.Name, &pfs.BranchInfo{}); err == nil {
+		return errors.Errorf("a branch named %s already exists in repo %s", tag.Name, tag.Repo.Name)
+	} else if !col.IsErrNotFound(err) {
+		return errors.Wrapf(err, "branches.Get")
+	}
+	commitInfo, err := d.resolveCommit(txnCtx.Stm, proto.Clone(commit).(*pfs.Commit))
+	if err != nil {
+		return err
+	}
+	tags := d.tags(tag.Repo.Name).ReadWrite(txnCtx.Stm)
+	if err := tags.Create(tag.Name, &pfs.TagInfo{
+		Tag:         tag,
+		Commit:      commitInfo.Commit,
+		Created:     types.TimestampNow(),
+		Description: description,
+	}); err != nil {
+		if col.IsErrExists(err) {
+			return errors.Errorf("tag %s already exists in repo %s, tags cannot be moved", tag.Name, tag.Repo.Name)
+		}
+		return err
+	}
+	repoInfo := &pfs.RepoInfo{}
+	return d.repos.ReadWrite(txnCtx.Stm).Update(tag.Repo.Name, repoInfo, func() error {
+		repoInfo.Tags = append(repoInfo.Tags, tag)
+		sort.Slice(repoInfo.Tags, func(i, j int) bool { return repoInfo.Tags[i].Name < repoInfo.Tags[j].Name })
+		return nil
+	})
+}
+
+func (d *driver) inspectTag(txnCtx *txnenv.TransactionContext, tag *pfs.Tag) (*pfs.TagInfo, error) {
+	if err := validateTag(tag); err != nil {
+		return nil, err
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo.Name, auth.Permission_REPO_LIST_BRANCH); err != nil {
+		return nil, err
+	}
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags(tag.Repo.Name).ReadWrite(txnCtx.Stm).Get(tag.Name, tagInfo); err != nil {
+		return nil, err
+	}
+	return tagInfo, nil
+}
+
+func (d *driver) listTag(pachClient *client.APIClient, repo *pfs.Repo) ([]*pfs.TagInfo, error) {
+	if repo == nil {
+		return nil, errors.New("repo cannot be nil")
+	}
+	if err := authserver.CheckRepoIsAuthorized(pachClient, repo.Name, auth.Permission_REPO_LIST_BRANCH); err != nil {
+		return nil, err
+	}
+	// Make sure that the repo exists
+	if err := d.txnEnv.WithReadContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		_, err := d.inspectRepo(txnCtx, repo, !includeAuth)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	var result []*pfs.TagInfo
+	tagInfo := &pfs.TagInfo{}
+	if err := d.tags(repo.Name).ReadOnly(pachClient.Ctx()).List(tagInfo, col.DefaultOptions, func(string) error {
+		result = append(result, proto.Clone(tagInfo).(*pfs.TagInfo))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deleteTag deletes tag. The commit it refers to is left intact.
+func (d *driver) deleteTag(txnCtx *txnenv.TransactionContext, tag *pfs.Tag) error {
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+	if err := authserver.CheckRepoIsAuthorizedInTransaction(txnCtx, tag.Repo.Name, auth.Permission_REPO_DELETE_BRANCH); err != nil {
+		return err
+	}
+	if err := d.tags(tag.Repo.Name).ReadWrite(txnCtx.Stm).Delete(tag.Name); err != nil {
+		return errors.Wrapf(err, "tags.Delete")
+	}
+	repoInfo := &pfs.RepoInfo{}
+	return d.repos.ReadWrite(txnCtx.Stm).Update(tag.Repo.Name, repoInfo, func() error {
+		for i, t := range repoInfo.Tags {
+			if t.Name == tag.Name {
+				repoInfo.Tags = append(repoInfo.Tags[:i], repoInfo.Tags[i+1:]...)
+				break
+			}
+		}
+		return nil
+	})
+}
+
+// checkNotTagName returns an error if name is the name of a tag in repo, so
+// that it can't be used as a branch name.
+func (d *driver) checkNotTagName(stm col.STM, repo, name string) error {
+	if err := d.tags(repo).ReadWrite(stm).Get(name, &pfs.TagInfo{}); err == nil {
+		return errors.Errorf("a tag named %s already exists in repo %s", name, repo)
+	} else if !col.IsErrNotFound(err) {
+		return errors.Wrapf(err, "tags.Get")
+	}
+	return nil
+}
+
+// taggedCommits returns the names of the tags in repo, keyed by the ID of the
+// commit they refer to.
+func (d *driver) taggedCommits(stm col.STM, repo string) (map[string][]string, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(stm).Get(repo, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	tags := d.tags(repo).ReadWrite(stm)
+	result := make(map[string][]string)
+	for _, tag := range repoInfo.Tags {
+		tagInfo := &pfs.TagInfo{}
+		if err := tags.Get(tag.Name, tagInfo); err != nil {
+			return nil, errors.Wrapf(err, "error getting tag %s", tag.Name)
+		}
+		result[tagInfo.Commit.ID] = append(result[tagInfo.Commit.ID], tag.Name)
+	}
+	return result, nil
+}
+
+// checkNotTagged returns an error if any of commitInfos is tagged.
+func (d *driver) checkNotTagged(stm col.STM, commitInfos map[string]*pfs.CommitInfo) error {
+	tagged := make(map[string]map[string][]string)
+	for _, commitInfo := range commitInfos {
+		repo := commitInfo.Commit.Repo.Name
+		if _, ok := tagged[repo]; !ok {
+			repoTagged, err := d.taggedCommits(stm, repo)
+			if err != nil {
+				return err
+			}
+			tagged[repo] = repoTagged
+		}
+		if tags := tagged[repo][commitInfo.Commit.ID]; len(tags) > 0 {
+			return pfsserver.ErrCommitTagged{Commit: commitInfo.Commit, Tags: tags}
+		}
+	}
+	return nil
+}
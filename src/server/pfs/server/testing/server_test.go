@@ -33,8 +33,10 @@ import (
 	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
 	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/testutil/random"
+	txnenv "github.com/pachyderm/pachyderm/v2/src/internal/transactionenv"
 	"github.com/pachyderm/pachyderm/v2/src/internal/uuid"
 	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
 	"github.com/pachyderm/pachyderm/v2/src/server/pfs/server/testing/load"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/sync/errgroup"
@@ -740,6 +742,612 @@ func TestPFS(suite *testing.T) {
 		require.Equal(t, 0, int(repoInfo.SizeBytes))
 	})
 
+	suite.Run("RetentionPolicy", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		var commits []*pfs.Commit
+		for i := 0; i < 5; i++ {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.PutFile(repo, commit.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo\n")))
+			require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+			commits = append(commits, commit)
+		}
+		// "other" points at the oldest commit, so it must survive
+		require.NoError(t, env.PachClient.CreateBranch(repo, "other", commits[0].ID, nil))
+
+		require.YesError(t, env.PachClient.SetRetentionPolicy(repo, "", &pfs.RetentionPolicy{}))
+		require.NoError(t, env.PachClient.SetRetentionPolicy(repo, "", &pfs.RetentionPolicy{KeepCommits: 2}))
+		repoInfo, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), repoInfo.Retention.KeepCommits)
+
+		// A dry run reports the expired commits without squashing them
+		expired, err := env.PachClient.EnforceRetention(repo, true)
+		require.NoError(t, err)
+		require.ElementsEqualUnderFn(t, []string{commits[1].ID, commits[2].ID}, expired, CommitToID)
+		_, err = env.PachClient.InspectCommit(repo, commits[1].ID)
+		require.NoError(t, err)
+
+		squashed, err := env.PachClient.EnforceRetention(repo, false)
+		require.NoError(t, err)
+		require.ElementsEqualUnderFn(t, []string{commits[1].ID, commits[2].ID}, squashed, CommitToID)
+		commitInfos, err := env.PachClient.ListCommit(repo, "master", "", 0)
+		require.NoError(t, err)
+		require.ElementsEqualUnderFn(t, []string{commits[4].ID, commits[3].ID, commits[0].ID}, commitInfos, CommitInfoToID)
+
+		// A branch policy overrides the repo policy
+		require.NoError(t, env.PachClient.SetRetentionPolicy(repo, "master", &pfs.RetentionPolicy{KeepDuration: types.DurationProto(time.Hour)}))
+		squashed, err = env.PachClient.EnforceRetention(repo, false)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(squashed))
+	})
+
+	suite.Run("Tags", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		var commits []*pfs.Commit
+		for i := 0; i < 3; i++ {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "file", strings.NewReader(fmt.Sprintf("%d", i))))
+			require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+			commits = append(commits, commit)
+		}
+		require.NoError(t, env.PachClient.CreateTag(repo, "v1", "master^", "first release"))
+		require.NoError(t, env.PachClient.CreateTag(repo, "v0", commits[0].ID, ""))
+
+		// Tags resolve to the commit they were created on, including with ancestry
+		commitInfo, err := env.PachClient.InspectCommit(repo, "v1")
+		require.NoError(t, err)
+		require.Equal(t, commits[1].ID, commitInfo.Commit.ID)
+		commitInfo, err = env.PachClient.InspectCommit(repo, "v1^")
+		require.NoError(t, err)
+		require.Equal(t, commits[0].ID, commitInfo.Commit.ID)
+		var buf bytes.Buffer
+		require.NoError(t, env.PachClient.GetFile(repo, "v1", "file", &buf))
+		require.Equal(t, "1", buf.String())
+
+		tagInfo, err := env.PachClient.InspectTag(repo, "v1")
+		require.NoError(t, err)
+		require.Equal(t, commits[1].ID, tagInfo.Commit.ID)
+		require.Equal(t, "first release", tagInfo.Description)
+		tagInfos, err := env.PachClient.ListTag(repo)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(tagInfos))
+		repoInfo, err := env.PachClient.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(repoInfo.Tags))
+
+		// Tags can't be moved, and share a namespace with branches
+		require.YesError(t, env.PachClient.CreateTag(repo, "v1", "master", ""))
+		require.YesError(t, env.PachClient.CreateTag(repo, "master", commits[0].ID, ""))
+		require.YesError(t, env.PachClient.CreateBranch(repo, "v1", "master", nil))
+		_, err = env.PachClient.StartCommit(repo, "v0")
+		require.YesError(t, err)
+
+		// Tagged commits can't be squashed, and are kept by retention policies
+		err = env.PachClient.SquashCommit(repo, commits[1].ID)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsCommitTaggedErr(err))
+		require.NoError(t, env.PachClient.SetRetentionPolicy(repo, "", &pfs.RetentionPolicy{KeepCommits: 1}))
+		squashed, err := env.PachClient.EnforceRetention(repo, true)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(squashed))
+
+		require.NoError(t, env.PachClient.DeleteTag(repo, "v1"))
+		_, err = env.PachClient.InspectCommit(repo, "v1")
+		require.YesError(t, err)
+		require.NoError(t, env.PachClient.SquashCommit(repo, commits[1].ID))
+		tagInfos, err = env.PachClient.ListTag(repo)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(tagInfos))
+		require.Equal(t, "v0", tagInfos[0].Tag.Name)
+	})
+
+	suite.Run("BranchProtection", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit1, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit1.ID))
+		commit2, err := env.PachClient.StartCommit(repo, "staging")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit2.ID))
+
+		require.NoError(t, env.PachClient.CreateBranchWithProtection(repo, "master", "master", nil, &pfs.BranchProtection{
+			NoDelete:        true,
+			NoDirectCommits: true,
+			FastForwardOnly: true,
+		}))
+		branchInfo, err := env.PachClient.InspectBranch(repo, "master")
+		require.NoError(t, err)
+		require.True(t, branchInfo.Protection.FastForwardOnly)
+
+		err = env.PachClient.DeleteBranch(repo, "master", false)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+		_, err = env.PachClient.StartCommit(repo, "master")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+		// commit2 doesn't descend from commit1
+		err = env.PachClient.CreateBranch(repo, "master", commit2.ID, nil)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsBranchProtectedErr(err))
+
+		// Fast-forwarding via another branch is allowed
+		commit3, err := env.PachClient.StartCommitParent(repo, "dev", commit1.ID)
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit3.ID))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "master", "dev", nil))
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master")
+		require.NoError(t, err)
+		require.Equal(t, commit3.ID, commitInfo.Commit.ID)
+
+		// Removing the protection allows the branch to be deleted, and
+		// deleting the repo ignores protection
+		require.NoError(t, env.PachClient.CreateBranchWithProtection(repo, "master", "master", nil, &pfs.BranchProtection{}))
+		require.NoError(t, env.PachClient.DeleteBranch(repo, "master", false))
+		require.NoError(t, env.PachClient.CreateBranchWithProtection(repo, "staging", "staging", nil, &pfs.BranchProtection{NoDelete: true}))
+		require.NoError(t, env.PachClient.DeleteRepo(repo, false))
+	})
+
+	suite.Run("Labels", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:   pclient.NewRepo("labelled"),
+			Labels: map[string]string{"team": "data"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.CreateRepo("unlabelled"))
+		repoInfos, err := env.PachClient.ListRepoByLabels(map[string]string{"team": "data"})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfos))
+		require.Equal(t, "labelled", repoInfos[0].Repo.Name)
+
+		commit1, err := env.PachClient.PfsAPIClient.StartCommit(env.PachClient.Ctx(), &pfs.StartCommitRequest{
+			Parent: pclient.NewCommit("labelled", ""),
+			Branch: "master",
+			Labels: map[string]string{"stage": "dev"},
+		})
+		require.NoError(t, err)
+		_, err = env.PachClient.PfsAPIClient.FinishCommit(env.PachClient.Ctx(), &pfs.FinishCommitRequest{
+			Commit: commit1,
+			Labels: map[string]string{"validated": "true"},
+		})
+		require.NoError(t, err)
+		commit2, err := env.PachClient.StartCommit("labelled", "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit("labelled", commit2.ID))
+
+		commitInfo, err := env.PachClient.InspectCommit("labelled", commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"stage": "dev", "validated": "true"}, commitInfo.Labels)
+
+		listBySelector := func(selector map[string]string) []string {
+			var IDs []string
+			require.NoError(t, env.PachClient.ListCommitByLabelsF("labelled", "", "", 0, false, selector, func(ci *pfs.CommitInfo) error {
+				IDs = append(IDs, ci.Commit.ID)
+				return nil
+			}))
+			return IDs
+		}
+		require.Equal(t, []string{commit1.ID}, listBySelector(map[string]string{"stage": "dev"}))
+		require.Equal(t, 0, len(listBySelector(map[string]string{"stage": "prod"})))
+		require.Equal(t, 2, len(listBySelector(nil)))
+
+		require.NoError(t, env.PachClient.SetCommitLabels("labelled", "master", map[string]string{"stage": "prod"}))
+		require.NoError(t, env.PachClient.SetCommitLabels("labelled", commit1.ID, nil, "stage"))
+		require.Equal(t, []string{commit2.ID}, listBySelector(map[string]string{"stage": "prod"}))
+		commitInfo, err = env.PachClient.InspectCommit("labelled", commit1.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"validated": "true"}, commitInfo.Labels)
+
+		require.NoError(t, env.PachClient.SetBranchLabels("labelled", "master", map[string]string{"owner": "alice"}))
+		branchInfo, err := env.PachClient.InspectBranch("labelled", "master")
+		require.NoError(t, err)
+		require.Equal(t, "alice", branchInfo.Labels["owner"])
+
+		require.YesError(t, env.PachClient.SetRepoLabels("labelled", map[string]string{"bad=key": "value"}))
+		require.NoError(t, env.PachClient.SetRepoLabels("labelled", nil, "team"))
+		repoInfos, err = env.PachClient.ListRepoByLabels(map[string]string{"team": "data"})
+		require.NoError(t, err)
+		require.Equal(t, 0, len(repoInfos))
+	})
+
+	suite.Run("ListCommitFilters", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		var commits []*pfs.Commit
+		for i := 0; i < 5; i++ {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+			commits = append(commits, commit)
+		}
+		middle, err := env.PachClient.InspectCommit(repo, commits[2].ID)
+		require.NoError(t, err)
+		open, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+
+		list := func(req *pfs.ListCommitRequest) []string {
+			req.Repo = pclient.NewRepo(repo)
+			var IDs []string
+			require.NoError(t, env.PachClient.ListCommitFilterF(req, func(ci *pfs.CommitInfo) error {
+				IDs = append(IDs, ci.Commit.ID)
+				return nil
+			}))
+			return IDs
+		}
+		require.Equal(t, []string{open.ID}, list(&pfs.ListCommitRequest{Status: pfs.CommitStatus_OPEN}))
+		require.Equal(t, 5, len(list(&pfs.ListCommitRequest{Status: pfs.CommitStatus_CLOSED})))
+		require.Equal(t, 0, len(list(&pfs.ListCommitRequest{Status: pfs.CommitStatus_FAILED})))
+		require.Equal(t, 6, len(list(&pfs.ListCommitRequest{OriginKinds: []pfs.OriginKind{pfs.OriginKind_USER}})))
+		require.Equal(t, 0, len(list(&pfs.ListCommitRequest{OriginKinds: []pfs.OriginKind{pfs.OriginKind_FSCK}})))
+
+		// The time range is inclusive at the start and exclusive at the end
+		require.Equal(t, []string{commits[1].ID, commits[0].ID}, list(&pfs.ListCommitRequest{
+			To:            pclient.NewCommit(repo, "master"),
+			StartedBefore: middle.Started,
+		}))
+		require.Equal(t, []string{commits[4].ID, commits[3].ID, commits[2].ID}, list(&pfs.ListCommitRequest{
+			To:             pclient.NewCommit(repo, "master"),
+			StartedAfter:   middle.Started,
+			FinishedBefore: types.TimestampNow(),
+		}))
+
+		// Page through the branch two commits at a time
+		var paged []string
+		startAfter := ""
+		for {
+			page := list(&pfs.ListCommitRequest{
+				To:         pclient.NewCommit(repo, "master"),
+				Number:     2,
+				StartAfter: startAfter,
+			})
+			if len(page) == 0 {
+				break
+			}
+			paged = append(paged, page...)
+			startAfter = page[len(page)-1]
+		}
+		require.Equal(t, []string{open.ID, commits[4].ID, commits[3].ID, commits[2].ID, commits[1].ID, commits[0].ID}, paged)
+
+		// Paging through the whole repo visits every commit once
+		paged = nil
+		startAfter = ""
+		for {
+			page := list(&pfs.ListCommitRequest{Number: 4, StartAfter: startAfter})
+			if len(page) == 0 {
+				break
+			}
+			paged = append(paged, page...)
+			startAfter = page[len(page)-1]
+		}
+		require.Equal(t, list(&pfs.ListCommitRequest{}), paged)
+	})
+
+	suite.Run("MergeBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		for _, p := range []string{"a", "b", "c"} {
+			require.NoError(t, env.PachClient.PutFile(repo, "master", p, strings.NewReader("1")))
+		}
+		require.NoError(t, env.PachClient.CreateBranch(repo, "feature", "master", nil))
+		require.NoError(t, env.PachClient.PutFile(repo, "feature", "a", strings.NewReader("2"), pclient.WithAppendPutFile()))
+		require.NoError(t, env.PachClient.DeleteFile(repo, "feature", "c"))
+		require.NoError(t, env.PachClient.PutFile(repo, "feature", "d", strings.NewReader("1")))
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "a", strings.NewReader("3"), pclient.WithAppendPutFile()))
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "b", strings.NewReader("2"), pclient.WithAppendPutFile()))
+
+		// "a" was changed on both branches
+		resp, err := env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergePreference_PREFER_NEITHER, false)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		require.Equal(t, 1, len(resp.Conflicts))
+		require.Equal(t, "/a", resp.Conflicts[0].Path)
+		require.Equal(t, []string{"/c", "/d"}, resp.Merged)
+
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergePreference_PREFER_SOURCE, false)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, []string{"/a", "/c", "/d"}, resp.Merged)
+		checkFile := func(p, expected string) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, env.PachClient.GetFile(repo, "master", p, buf))
+			require.Equal(t, expected, buf.String())
+		}
+		checkFile("a", "12")
+		checkFile("b", "12")
+		checkFile("d", "1")
+		_, err = env.PachClient.InspectFile(repo, "master", "c")
+		require.YesError(t, err)
+
+		// Everything on feature has already been merged
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergePreference_PREFER_NEITHER, false)
+		require.NoError(t, err)
+		require.Nil(t, resp.Commit)
+		require.Equal(t, 0, len(resp.Merged))
+		require.Equal(t, 0, len(resp.Conflicts))
+	})
+
+	suite.Run("MergeBranchTwice", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "a", strings.NewReader("1")))
+		require.NoError(t, env.PachClient.CreateBranch(repo, "feature", "master", nil))
+		require.NoError(t, env.PachClient.PutFile(repo, "feature", "a", strings.NewReader("2"), pclient.WithAppendPutFile()))
+		resp, err := env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergePreference_PREFER_NEITHER, false)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, []string{"/a"}, resp.Merged)
+		ci, err := env.PachClient.InspectCommit(repo, resp.Commit.ID)
+		require.NoError(t, err)
+		featureHead, err := env.PachClient.InspectCommit(repo, "feature")
+		require.NoError(t, err)
+		require.Equal(t, featureHead.Commit.ID, ci.MergeParent.ID)
+
+		// Changing a file on master after it was merged doesn't conflict with
+		// the next merge, which only applies the changes made on feature since
+		// the last one.
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "a", strings.NewReader("3"), pclient.WithAppendPutFile()))
+		require.NoError(t, env.PachClient.PutFile(repo, "feature", "b", strings.NewReader("1")))
+		resp, err = env.PachClient.MergeBranch(repo, "feature", "master", pfs.MergePreference_PREFER_NEITHER, false)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Commit)
+		require.Equal(t, 0, len(resp.Conflicts))
+		require.Equal(t, []string{"/b"}, resp.Merged)
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile(repo, "master", "a", buf))
+		require.Equal(t, "123", buf.String())
+	})
+
+	suite.Run("ForkRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		require.NoError(t, env.PachClient.CreateRepo("source"))
+		require.NoError(t, env.PachClient.PutFile("source", "master", "a", strings.NewReader("a")))
+		require.NoError(t, env.PachClient.PutFile("source", "master", "dir/b", strings.NewReader("b")))
+		sourceInfo, err := env.PachClient.InspectCommit("source", "master")
+		require.NoError(t, err)
+
+		commit, err := env.PachClient.ForkRepo("source", "master", "fork")
+		require.NoError(t, err)
+		repoInfo, err := env.PachClient.InspectRepo("fork")
+		require.NoError(t, err)
+		require.Equal(t, sourceInfo.Commit.ID, repoInfo.ForkedFrom.ID)
+		commitInfo, err := env.PachClient.InspectCommit("fork", "master")
+		require.NoError(t, err)
+		require.Equal(t, commit.ID, commitInfo.Commit.ID)
+		require.NotNil(t, commitInfo.Finished)
+		require.Equal(t, sourceInfo.SizeBytes, commitInfo.SizeBytes)
+
+		// The histories are independent
+		require.NoError(t, env.PachClient.PutFile("fork", "master", "c", strings.NewReader("c")))
+		require.NoError(t, env.PachClient.DeleteFile("source", "master", "a"))
+		var files []string
+		require.NoError(t, env.PachClient.WalkFile("fork", "master", "/", func(fi *pfs.FileInfo) error {
+			if fi.FileType == pfs.FileType_FILE {
+				files = append(files, fi.File.Path)
+			}
+			return nil
+		}))
+		require.Equal(t, []string{"/a", "/c", "/dir/b"}, files)
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile("fork", "master", "dir/b", buf))
+		require.Equal(t, "b", buf.String())
+
+		_, err = env.PachClient.ForkRepo("source", "master", "fork")
+		require.YesError(t, err)
+	})
+
+	suite.Run("RenameRepo", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+		env.MockPPSTransactionServer.RenameInputInTransaction.Use(func(*txnenv.TransactionContext, *pfs.Branch, *pfs.Branch, map[string]*pfs.Commit) error {
+			return nil
+		})
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		require.NoError(t, env.PachClient.CreateRepo("out"))
+		require.NoError(t, env.PachClient.CreateBranch("out", "master", "", []*pfs.Branch{pclient.NewBranch("in", "master")}))
+		require.NoError(t, env.PachClient.PutFile("in", "master", "foo", strings.NewReader("foo")))
+		require.NoError(t, env.PachClient.PutFile("in", "master", "bar", strings.NewReader("bar")))
+
+		require.NoError(t, env.PachClient.RenameRepo("in", "renamed"))
+		_, err := env.PachClient.InspectRepo("in")
+		require.YesError(t, err)
+		commits, err := env.PachClient.ListCommit("renamed", "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commits))
+		buf := &bytes.Buffer{}
+		require.NoError(t, env.PachClient.GetFile("renamed", "master", "foo", buf))
+		require.Equal(t, "foo", buf.String())
+
+		branchInfo, err := env.PachClient.InspectBranch("out", "master")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(branchInfo.Provenance))
+		require.Equal(t, "renamed", branchInfo.Provenance[0].Repo.Name)
+		branchInfo, err = env.PachClient.InspectBranch("renamed", "master")
+		require.NoError(t, err)
+		require.Equal(t, "out", branchInfo.Subvenance[0].Repo.Name)
+		commitInfo, err := env.PachClient.InspectCommit("out", "master")
+		require.NoError(t, err)
+		require.Equal(t, "renamed", commitInfo.Provenance[0].Commit.Repo.Name)
+		require.Equal(t, commits[0].Commit.ID, commitInfo.Provenance[0].Commit.ID)
+
+		// New commits still propagate downstream
+		require.NoError(t, env.PachClient.PutFile("renamed", "master", "baz", strings.NewReader("baz")))
+		commitInfo, err = env.PachClient.InspectCommit("out", "master")
+		require.NoError(t, err)
+		require.NotEqual(t, commits[0].Commit.ID, commitInfo.Provenance[0].Commit.ID)
+
+		require.NoError(t, env.PachClient.CreateRepo("other"))
+		require.YesError(t, env.PachClient.RenameRepo("renamed", "other"))
+	})
+
+	suite.Run("RenameBranch", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+		env.MockPPSTransactionServer.RenameInputInTransaction.Use(func(*txnenv.TransactionContext, *pfs.Branch, *pfs.Branch, map[string]*pfs.Commit) error {
+			return nil
+		})
+
+		require.NoError(t, env.PachClient.CreateRepo("in"))
+		require.NoError(t, env.PachClient.CreateRepo("out"))
+		require.NoError(t, env.PachClient.CreateBranch("out", "master", "", []*pfs.Branch{pclient.NewBranch("in", "master")}))
+		require.NoError(t, env.PachClient.PutFile("in", "master", "foo", strings.NewReader("foo")))
+		head, err := env.PachClient.InspectCommit("in", "master")
+		require.NoError(t, err)
+
+		require.NoError(t, env.PachClient.RenameBranch("in", "master", "main"))
+		_, err = env.PachClient.InspectBranch("in", "master")
+		require.YesError(t, err)
+		branchInfo, err := env.PachClient.InspectBranch("in", "main")
+		require.NoError(t, err)
+		require.Equal(t, head.Commit.ID, branchInfo.Head.ID)
+		require.Equal(t, "out", branchInfo.Subvenance[0].Repo.Name)
+		branchInfo, err = env.PachClient.InspectBranch("out", "master")
+		require.NoError(t, err)
+		require.Equal(t, "main", branchInfo.Provenance[0].Name)
+		repoInfo, err := env.PachClient.InspectRepo("in")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repoInfo.Branches))
+		require.Equal(t, "main", repoInfo.Branches[0].Name)
+
+		require.NoError(t, env.PachClient.CreateBranch("in", "dev", "main", nil))
+		require.YesError(t, env.PachClient.RenameBranch("in", "main", "dev"))
+	})
+
+	suite.Run("FileMetadata", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		metadata := map[string]string{pfs.ContentTypeMetadataKey: "text/plain", "owner": "alice"}
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "foo", strings.NewReader("foo"), pclient.WithMetadataPutFile(metadata)))
+		fileInfo, err := env.PachClient.InspectFile(repo, "master", "foo")
+		require.NoError(t, err)
+		require.Equal(t, metadata, fileInfo.Metadata)
+		fileInfos, err := env.PachClient.ListFileAll(repo, "master", "/")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		require.Equal(t, metadata, fileInfos[0].Metadata)
+
+		// Appending without metadata keeps the existing metadata
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "foo", strings.NewReader("bar"), pclient.WithAppendPutFile()))
+		fileInfo, err = env.PachClient.InspectFile(repo, "master", "foo")
+		require.NoError(t, err)
+		require.Equal(t, metadata, fileInfo.Metadata)
+
+		// Copies keep the metadata of the source
+		require.NoError(t, env.PachClient.CopyFile(repo, "master", "copy", repo, "master", "foo"))
+		fileInfo, err = env.PachClient.InspectFile(repo, "master", "copy")
+		require.NoError(t, err)
+		require.Equal(t, metadata, fileInfo.Metadata)
+
+		// Overwriting without metadata clears it
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "foo", strings.NewReader("baz")))
+		fileInfo, err = env.PachClient.InspectFile(repo, "master", "foo")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fileInfo.Metadata))
+	})
+
+	suite.Run("SymlinksAndModes", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		buf := &bytes.Buffer{}
+		require.NoError(t, tarutil.WithWriter(buf, func(tw *tar.Writer) error {
+			if err := tw.WriteHeader(&tar.Header{Name: "bin/run.sh", Mode: 0755, Size: 4}); err != nil {
+				return err
+			}
+			if _, err := tw.Write([]byte("echo")); err != nil {
+				return err
+			}
+			for name, target := range map[string]string{
+				"link":     "bin/run.sh",
+				"abs":      "/bin/run.sh",
+				"chain":    "link",
+				"bin/up":   "../link",
+				"dangling": "missing",
+			} {
+				if err := tw.WriteHeader(&tar.Header{Typeflag: tar.TypeSymlink, Name: name, Linkname: target}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+		require.NoError(t, env.PachClient.PutFileTar(repo, "master", buf))
+
+		fileInfo, err := env.PachClient.InspectFile(repo, "master", "bin/run.sh")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_FILE, fileInfo.FileType)
+		require.Equal(t, uint32(0755), fileInfo.Mode)
+		fileInfo, err = env.PachClient.InspectFile(repo, "master", "link")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_SYMLINK, fileInfo.FileType)
+		require.Equal(t, "bin/run.sh", fileInfo.SymlinkTarget)
+
+		// Reading a symlink returns its target's content
+		for _, p := range []string{"link", "abs", "chain", "bin/up"} {
+			buf.Reset()
+			require.NoError(t, env.PachClient.GetFile(repo, "master", p, buf))
+			require.Equal(t, "echo", buf.String())
+		}
+		require.YesError(t, env.PachClient.GetFile(repo, "master", "dangling", buf))
+
+		// Tar downloads keep symlinks and modes
+		r, err := env.PachClient.GetFileTar(repo, "master", "/")
+		require.NoError(t, err)
+		hdrs := make(map[string]*tar.Header)
+		require.NoError(t, tarutil.Iterate(r, func(f tarutil.File) error {
+			hdr, err := f.Header()
+			if err != nil {
+				return err
+			}
+			hdrs[hdr.Name] = hdr
+			return nil
+		}))
+		require.Equal(t, int64(0755), hdrs["/bin/run.sh"].Mode)
+		require.Equal(t, byte(tar.TypeSymlink), hdrs["/link"].Typeflag)
+		require.Equal(t, "bin/run.sh", hdrs["/link"].Linkname)
+
+		// Appending without a mode keeps the existing mode
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "bin/run.sh", strings.NewReader("\n"), pclient.WithAppendPutFile()))
+		fileInfo, err = env.PachClient.InspectFile(repo, "master", "bin/run.sh")
+		require.NoError(t, err)
+		require.Equal(t, uint32(0755), fileInfo.Mode)
+		require.NoError(t, env.PachClient.PutFile(repo, "master", "bin/run.sh", strings.NewReader("echo"), pclient.WithModePutFile(0700)))
+		fileInfo, err = env.PachClient.InspectFile(repo, "master", "bin/run.sh")
+		require.NoError(t, err)
+		require.Equal(t, uint32(0700), fileInfo.Mode)
+	})
+
 	suite.Run("SquashCommitFinished", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -3274,6 +3882,42 @@ func TestPFS(suite *testing.T) {
 		checks()
 	})
 
+	suite.Run("GrepFile", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		for i := 0; i < 20; i++ {
+			data := fmt.Sprintf("ok\nid=%d\nERROR %d\n", i, i)
+			require.NoError(t, env.PachClient.PutFile(repo, commit.ID, fmt.Sprintf("logs/%02d.log", i), strings.NewReader(data)))
+		}
+		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "other/a.txt", strings.NewReader("error id=3.0")))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+
+		grep := func(glob, pattern string, literal, ignoreCase bool) []string {
+			var matches []string
+			require.NoError(t, env.PachClient.GrepFile(repo, "master", glob, pattern, literal, ignoreCase, func(resp *pfs.GrepFileResponse) error {
+				require.Equal(t, commit.ID, resp.File.Commit.ID)
+				matches = append(matches, fmt.Sprintf("%s:%d:%s", resp.File.Path, resp.LineNumber, resp.Line))
+				return nil
+			}))
+			return matches
+		}
+		require.Equal(t, []string{"/logs/03.log:2:id=3", "/other/a.txt:1:error id=3.0"}, grep("", `id=3\b`, false, false))
+		require.Equal(t, []string{"/other/a.txt:1:error id=3.0"}, grep("", "id=3.0", true, false))
+		require.Equal(t, 20, len(grep("logs/*", "^ERROR", false, false)))
+		require.Equal(t, 21, len(grep("", "^error", false, true)))
+		// Matches are returned in path order
+		matches := grep("logs/*", "ERROR", false, false)
+		require.Equal(t, "/logs/00.log:3:ERROR 0", matches[0])
+		require.Equal(t, "/logs/19.log:3:ERROR 19", matches[19])
+
+		require.YesError(t, env.PachClient.GrepFile(repo, "master", "", "(", false, false, func(*pfs.GrepFileResponse) error { return nil }))
+	})
+
 	suite.Run("GlobFile2", func(t *testing.T) {
 		t.Parallel()
 		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
@@ -5322,6 +5966,91 @@ func TestPFS(suite *testing.T) {
 		require.NoError(t, env.PachClient.DeleteRepo(output1, false))
 	})
 
+	suite.Run("FsckStorage", func(t *testing.T) {
+		t.Parallel()
+		env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+
+		repo := "repo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		for i := 0; i < 5; i++ {
+			require.NoError(t, env.PachClient.PutFile(repo, "master", fmt.Sprintf("file%d", i), strings.NewReader(fmt.Sprintf("data%d", i))))
+		}
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutFile(repo, commit.ID, "open", strings.NewReader("open")))
+		for _, sampleRate := range []float64{0, 0.5, 1} {
+			var errs []string
+			require.NoError(t, env.PachClient.FsckStorage(false, sampleRate, func(resp *pfs.FsckResponse) error {
+				if resp.Error != "" {
+					errs = append(errs, resp.Error)
+				}
+				return nil
+			}))
+			require.Equal(t, 0, len(errs), "%v", errs)
+		}
+		require.YesError(t, env.PachClient.FsckStorage(false, 2, func(*pfs.FsckResponse) error { return nil }))
+		require.YesError(t, env.PachClient.FsckStorage(false, -1, func(*pfs.FsckResponse) error { return nil }))
+		fsck := func(fix bool) (errs, fixes []string) {
+			require.NoError(t, env.PachClient.FsckStorage(fix, 1, func(resp *pfs.FsckResponse) error {
+				if resp.Error != "" {
+					errs = append(errs, resp.Error)
+				}
+				if resp.Fix != "" {
+					fixes = append(fixes, resp.Fix)
+				}
+				return nil
+			}))
+			return errs, fixes
+		}
+
+		// A finished commit without a total file set is broken.
+		ci, err := env.PachClient.InspectCommit(repo, "master^")
+		require.NoError(t, err)
+		_, err = env.ServiceEnv.GetDBClient().Exec(`DELETE FROM pfs.commit_totals WHERE commit_id = $1`, ci.Commit.ID)
+		require.NoError(t, err)
+		errs, _ := fsck(false)
+		require.Equal(t, 1, len(errs), "%v", errs)
+		require.Matches(t, ci.Commit.ID, errs[0])
+		require.Matches(t, "no total file set", errs[0])
+		// Fixing it marks the commit as broken, which later runs report.
+		_, fixes := fsck(true)
+		require.Equal(t, 1, len(fixes), "%v", fixes)
+		require.Matches(t, ci.Commit.ID, fixes[0])
+		ci, err = env.PachClient.InspectCommit(repo, ci.Commit.ID)
+		require.NoError(t, err)
+		require.Matches(t, "no total file set", ci.Error)
+		// A broken commit can't be read.
+		err = env.PachClient.GetFile(repo, ci.Commit.ID, "file0", &bytes.Buffer{})
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsCommitBrokenErr(err), "%v", err)
+		errs, fixes = fsck(true)
+		require.Equal(t, 1, len(errs), "%v", errs)
+		require.Equal(t, 0, len(fixes), "%v", fixes)
+
+		// Corrupt and missing chunks are reported for every commit that
+		// references them.
+		chunkDir := path.Join(env.ServiceEnv.Config().StorageRoot, "chunk")
+		chunks, err := ioutil.ReadDir(chunkDir)
+		require.NoError(t, err)
+		require.True(t, len(chunks) > 0)
+		for _, chunk := range chunks {
+			require.NoError(t, ioutil.WriteFile(path.Join(chunkDir, chunk.Name()), []byte("garbage"), 0644))
+		}
+		errs, _ = fsck(false)
+		require.True(t, len(errs) > 1, "%v", errs)
+		for _, chunk := range chunks {
+			require.NoError(t, os.Remove(path.Join(chunkDir, chunk.Name())))
+		}
+		errs, fixes = fsck(true)
+		require.True(t, len(errs) > 1, "%v", errs)
+		require.True(t, len(fixes) > 0, "%v", fixes)
+		for _, err := range errs {
+			if !strings.Contains(err, ci.Commit.ID) {
+				require.Matches(t, "missing", err)
+			}
+		}
+	})
+
 	// TODO: Make work with V2?
 	//suite.Run("PutFileAtomic", func(t *testing.T) {
 	//	t.Parallel()
@@ -82,6 +82,8 @@ func (f *PipelineFinisher) Run() error {
 			nil,   // from
 			0,     // number
 			false, // reverse
+			"",    // startAfter
+			nil,   // filter
 			func(commitInfo *pfs.CommitInfo) error {
 				return f.txnCtx.Client.StopJobOutputCommit(commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
 			}); err != nil && !isNotFoundErr(err) {
@@ -72,6 +72,32 @@ func (a *validatedAPIServer) SquashCommitInTransaction(txnCtx *txnenv.Transactio
 	return a.APIServer.SquashCommitInTransaction(txnCtx, request)
 }
 
+// EnforceRetention implements the protobuf pfs.EnforceRetention RPC
+func (a *validatedAPIServer) EnforceRetention(ctx context.Context, request *pfs.EnforceRetentionRequest) (*pfs.EnforceRetentionResponse, error) {
+	permission := auth.Permission_REPO_DELETE_COMMIT
+	if request.DryRun {
+		permission = auth.Permission_REPO_LIST_COMMIT
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	if request.Repo != nil {
+		if err := authserver.CheckRepoIsAuthorized(pachClient, request.Repo.Name, permission); err != nil {
+			return nil, err
+		}
+		return a.APIServer.EnforceRetention(ctx, request)
+	}
+	// Enforcing retention on every repo requires access to every repo.
+	repos, err := a.APIServer.ListRepo(ctx, &pfs.ListRepoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, repoInfo := range repos.RepoInfo {
+		if err := authserver.CheckRepoIsAuthorized(pachClient, repoInfo.Repo.Name, permission); err != nil {
+			return nil, err
+		}
+	}
+	return a.APIServer.EnforceRetention(ctx, request)
+}
+
 // InspectFile implements the protobuf pfs.InspectFile RPC
 func (a *validatedAPIServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (response *pfs.FileInfo, retErr error) {
 	if err := validateFile(request.File); err != nil {
@@ -129,6 +155,22 @@ func (a *validatedAPIServer) GlobFile(request *pfs.GlobFileRequest, server pfs.A
 	return a.APIServer.GlobFile(request, server)
 }
 
+// GrepFile implements the protobuf pfs.GrepFile RPC
+func (a *validatedAPIServer) GrepFile(request *pfs.GrepFileRequest, server pfs.API_GrepFileServer) (retErr error) {
+	commit := request.Commit
+	// Validate arguments
+	if commit == nil {
+		return errors.New("commit cannot be nil")
+	}
+	if commit.Repo == nil {
+		return errors.New("commit repo cannot be nil")
+	}
+	if err := authserver.CheckRepoIsAuthorized(a.env.GetPachClient(server.Context()), commit.Repo.Name, auth.Permission_REPO_READ); err != nil {
+		return err
+	}
+	return a.APIServer.GrepFile(request, server)
+}
+
 func (a *validatedAPIServer) ClearCommit(ctx context.Context, req *pfs.ClearCommitRequest) (*types.Empty, error) {
 	if req.Commit == nil {
 		return nil, errors.Errorf("commit cannot be nil")
@@ -0,0 +1,466 @@
+package cmds
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/cmdutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	ppsclient "github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+// defaultedPipelineFields are the pipeline spec fields that pachd fills in
+// when they aren't set, keyed by the name of the field that contains them
+// ("" for top-level fields). They're only compared when the manifest sets
+// them, so that the defaults aren't reported as changes.
+var defaultedPipelineFields = map[string][]string{
+	"":          {"salt", "output_branch", "cache_size", "max_queue_size", "datum_tries", "reprocess_spec", "enable_stats"},
+	"transform": {"image"},
+	"pfs":       {"branch", "name"},
+	"cron":      {"start", "repo"},
+	"sql":       {"repo", "format"},
+	"bucket":    {"repo", "interval", "glob"},
+	"git":       {"branch", "name"},
+	"trigger":   {"branch"},
+	"service":   {"type"},
+}
+
+// dagDiff is the set of changes needed to make a cluster match a DAG
+// manifest.
+type dagDiff struct {
+	// changes describes each change, for display.
+	changes   []string
+	repos     []*pfs.CreateRepoRequest
+	branches  []*pfs.CreateBranchRequest
+	pipelines []*ppsclient.CreatePipelineRequest
+	// deletes are the pipelines to delete, downstream pipelines first.
+	deletes []string
+}
+
+func (d *dagDiff) empty() bool {
+	return len(d.repos) == 0 && len(d.branches) == 0 && len(d.pipelines) == 0 && len(d.deletes) == 0
+}
+
+func applyCmd() *cobra.Command {
+	var manifestPath string
+	var templateArgs []string
+	var dryRun bool
+	var prune bool
+	var force bool
+	apply := &cobra.Command{
+		Short: "Make the repos, branches and pipelines of a DAG match a manifest.",
+		Long: `Make the repos, branches and pipelines of a DAG match a manifest.
+
+The manifest is a JSON or YAML object with "repos", "branches" and "pipelines"
+fields, which list CreateRepoRequests, CreateBranchRequests and pipeline specs.
+The changes needed to make the cluster match the manifest are shown, and then
+applied in a single transaction. Repos and branches that aren't in the
+manifest are left alone. Pipelines that aren't in the manifest are only deleted
+with --prune, one at a time after the transaction, since deleting a pipeline
+can't be part of a transaction. Applying with --prune is therefore not atomic:
+if a delete fails, the transaction's changes and the earlier deletes are kept,
+and apply stops with an error that lists the pipelines it didn't delete.`,
+		Example: `
+# Show the changes that applying a manifest would make
+$ {{alias}} -f dag.yaml --dry-run
+
+# Apply a manifest, deleting pipelines that aren't in it
+$ {{alias}} -f dag.yaml --prune`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			tmplArgs, err := parseTemplateArgs(templateArgs)
+			if err != nil {
+				return err
+			}
+			manifest, err := ppsutil.ReadDAGManifest(manifestPath, tmplArgs)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "error connecting to pachd")
+			}
+			defer c.Close()
+			diff, err := diffDAG(c, manifest, prune)
+			if err != nil {
+				return err
+			}
+			if diff.empty() {
+				fmt.Println("No changes.")
+				return nil
+			}
+			for _, change := range diff.changes {
+				fmt.Println(change)
+			}
+			if dryRun {
+				return nil
+			}
+			if !force {
+				if ok, err := cmdutil.InteractiveConfirm(); err != nil {
+					return err
+				} else if !ok {
+					return nil
+				}
+			}
+			return applyDAGDiff(c, diff)
+		}),
+	}
+	apply.Flags().StringVarP(&manifestPath, "file", "f", "-", "The manifest of the DAG, it can be a url or local file. - reads from stdin.")
+	apply.Flags().StringArrayVar(&templateArgs, "arg", nil, "An argument to render the manifest with as a Go template, in the form 'key=value'. May be given multiple times.")
+	apply.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes, don't apply them.")
+	apply.Flags().BoolVar(&prune, "prune", false, "Delete pipelines that aren't in the manifest.")
+	apply.Flags().BoolVar(&force, "force", false, "Apply the changes without asking for confirmation.")
+	return cmdutil.CreateAlias(apply, "apply")
+}
+
+// diffDAG computes the changes needed to make the cluster that c is connected
+// to match manifest. If prune is true, pipelines that aren't in manifest are
+// deleted.
+func diffDAG(c *client.APIClient, manifest *ppsutil.DAGManifest, prune bool) (*dagDiff, error) {
+	repoInfos, err := c.ListRepo()
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	repos := make(map[string]*pfs.RepoInfo)
+	for _, repoInfo := range repoInfos {
+		repos[repoInfo.Repo.Name] = repoInfo
+	}
+	branches := make(map[string]*pfs.BranchInfo)
+	for _, req := range manifest.Branches {
+		if _, ok := repos[req.Branch.Repo.Name]; !ok {
+			continue
+		}
+		branchInfo, err := c.InspectBranch(req.Branch.Repo.Name, req.Branch.Name)
+		if err != nil {
+			if errutil.IsNotFoundError(err) {
+				continue
+			}
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		branches[pfsBranchKey(req.Branch)] = branchInfo
+	}
+	pipelineInfos, err := c.ListPipeline()
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return computeDAGDiff(manifest, repos, branches, pipelineInfos, prune)
+}
+
+// applyDAGDiff applies diff to the cluster that c is connected to, printing
+// each pipeline that it deletes. The creates and updates are atomic, but the
+// deletes aren't, as they run one by one after the transaction.
+func applyDAGDiff(c *client.APIClient, diff *dagDiff) error {
+	if len(diff.repos) > 0 || len(diff.branches) > 0 || len(diff.pipelines) > 0 {
+		if _, err := c.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+			for _, req := range diff.repos {
+				if _, err := builder.PfsAPIClient.CreateRepo(builder.Ctx(), req); err != nil {
+					return err
+				}
+			}
+			for _, req := range diff.branches {
+				if _, err := builder.PfsAPIClient.CreateBranch(builder.Ctx(), req); err != nil {
+					return err
+				}
+			}
+			for _, req := range diff.pipelines {
+				if _, err := builder.PpsAPIClient.CreatePipeline(builder.Ctx(), req); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+	// Each pipeline is deleted on its own, so stop at the first failure and
+	// say how far the apply got.
+	for i, pipeline := range diff.deletes {
+		if err := c.DeletePipeline(pipeline, false); err != nil {
+			return errors.Wrapf(grpcutil.ScrubGRPC(err),
+				"the manifest was only partially applied: the repos, branches and pipelines were updated and %d of %d pipelines were deleted, but deleting pipeline %s failed, and pipelines %s weren't deleted",
+				i, len(diff.deletes), pipeline, strings.Join(diff.deletes[i:], ", "))
+		}
+		fmt.Printf("Deleted pipeline %s\n", pipeline)
+	}
+	return nil
+}
+
+// computeDAGDiff computes the changes needed to make the current repos,
+// branches (keyed by pfsBranchKey) and pipelines match manifest.
+func computeDAGDiff(manifest *ppsutil.DAGManifest, repos map[string]*pfs.RepoInfo, branches map[string]*pfs.BranchInfo, pipelineInfos []*ppsclient.PipelineInfo, prune bool) (*dagDiff, error) {
+	diff := &dagDiff{}
+	for _, req := range manifest.Repos {
+		repoInfo, ok := repos[req.Repo.Name]
+		if !ok {
+			diff.changes = append(diff.changes, fmt.Sprintf("+ repo %s", req.Repo.Name))
+			diff.repos = append(diff.repos, req)
+			continue
+		}
+		var fields []string
+		if req.Description != repoInfo.Description {
+			fields = append(fields, "description")
+		}
+		if !labelsContained(req.Labels, repoInfo.Labels) {
+			fields = append(fields, "labels")
+		}
+		if len(fields) > 0 {
+			diff.changes = append(diff.changes, fmt.Sprintf("~ repo %s: %s", req.Repo.Name, strings.Join(fields, ", ")))
+			req = proto.Clone(req).(*pfs.CreateRepoRequest)
+			req.Update = true
+			diff.repos = append(diff.repos, req)
+		}
+	}
+	for _, req := range manifest.Branches {
+		key := pfsBranchKey(req.Branch)
+		branchInfo, ok := branches[key]
+		if !ok {
+			diff.changes = append(diff.changes, fmt.Sprintf("+ branch %s", key))
+			diff.branches = append(diff.branches, req)
+			continue
+		}
+		var fields []string
+		if !branchSetsEqual(req.Provenance, branchInfo.DirectProvenance) {
+			fields = append(fields, "provenance")
+		}
+		if req.Trigger != nil && !proto.Equal(req.Trigger, branchInfo.Trigger) {
+			fields = append(fields, "trigger")
+		}
+		if req.Protection != nil && !proto.Equal(req.Protection, branchInfo.Protection) {
+			fields = append(fields, "protection")
+		}
+		if !labelsContained(req.Labels, branchInfo.Labels) {
+			fields = append(fields, "labels")
+		}
+		if len(fields) > 0 {
+			diff.changes = append(diff.changes, fmt.Sprintf("~ branch %s: %s", key, strings.Join(fields, ", ")))
+			// The head is only set when a branch is created, so keep the
+			// branch's current head.
+			req = proto.Clone(req).(*pfs.CreateBranchRequest)
+			req.Head = branchInfo.Head
+			diff.branches = append(diff.branches, req)
+		}
+	}
+	current := make(map[string]*ppsclient.PipelineInfo)
+	for _, pipelineInfo := range pipelineInfos {
+		current[pipelineInfo.Pipeline.Name] = pipelineInfo
+	}
+	changed := make(map[string]*ppsclient.CreatePipelineRequest)
+	var order []string
+	manifestPipelines := make(map[string]bool)
+	for _, req := range manifest.Pipelines {
+		name := req.Pipeline.Name
+		if manifestPipelines[name] {
+			return nil, errors.Errorf("pipeline %s is in the manifest more than once", name)
+		}
+		manifestPipelines[name] = true
+		pipelineInfo, ok := current[name]
+		if !ok {
+			diff.changes = append(diff.changes, fmt.Sprintf("+ pipeline %s", name))
+			changed[name] = req
+			order = append(order, name)
+			continue
+		}
+		fields, err := diffPipelineRequests(req, ppsutil.PipelineReqFromInfo(pipelineInfo))
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 {
+			diff.changes = append(diff.changes, fmt.Sprintf("~ pipeline %s: %s", name, strings.Join(fields, ", ")))
+			req = proto.Clone(req).(*ppsclient.CreatePipelineRequest)
+			req.Update = true
+			changed[name] = req
+			order = append(order, name)
+		}
+	}
+	// Pipelines are created in a transaction, so each one must come after the
+	// pipelines that it takes as input.
+	sorted, err := sortPipelines(order, func(name string) []string {
+		return pipelineInputRepos(changed[name].Input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sorted {
+		diff.pipelines = append(diff.pipelines, changed[name])
+	}
+	if prune {
+		var deletes []string
+		for _, pipelineInfo := range pipelineInfos {
+			if !manifestPipelines[pipelineInfo.Pipeline.Name] {
+				deletes = append(deletes, pipelineInfo.Pipeline.Name)
+			}
+		}
+		sort.Strings(deletes)
+		// Downstream pipelines are deleted first, so reverse the order in
+		// which they'd be created.
+		sorted, err := sortPipelines(deletes, func(name string) []string {
+			return pipelineInputRepos(current[name].Input)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := len(sorted) - 1; i >= 0; i-- {
+			diff.changes = append(diff.changes, fmt.Sprintf("- pipeline %s", sorted[i]))
+			diff.deletes = append(diff.deletes, sorted[i])
+		}
+	}
+	return diff, nil
+}
+
+func pfsBranchKey(branch *pfs.Branch) string {
+	return fmt.Sprintf("%s@%s", branch.Repo.Name, branch.Name)
+}
+
+// labelsContained returns true if all of labels are set in current. Labels
+// are merged into the existing ones, so extra labels aren't a change.
+func labelsContained(labels, current map[string]string) bool {
+	for k, v := range labels {
+		if cur, ok := current[k]; !ok || cur != v {
+			return false
+		}
+	}
+	return true
+}
+
+func branchSetsEqual(a, b []*pfs.Branch) bool {
+	keys := func(branches []*pfs.Branch) []string {
+		var result []string
+		for _, branch := range branches {
+			result = append(result, pfsBranchKey(branch))
+		}
+		sort.Strings(result)
+		return result
+	}
+	return reflect.DeepEqual(keys(a), keys(b))
+}
+
+func pipelineInputRepos(input *ppsclient.Input) []string {
+	var repos []string
+	ppsclient.VisitInput(input, func(input *ppsclient.Input) {
+		if input.Pfs != nil {
+			repos = append(repos, input.Pfs.Repo)
+		}
+	})
+	return repos
+}
+
+// sortPipelines sorts names so that each pipeline comes after the pipelines
+// in names that it takes as input. Pipelines that don't depend on each other
+// stay in the order they're given in.
+func sortPipelines(names []string, inputs func(string) []string) ([]string, error) {
+	pending := make(map[string]bool)
+	for _, name := range names {
+		pending[name] = true
+	}
+	visiting := make(map[string]bool)
+	var result []string
+	var visit func(string) error
+	visit = func(name string) error {
+		if !pending[name] {
+			return nil
+		}
+		if visiting[name] {
+			return errors.Errorf("pipeline %s is its own input", name)
+		}
+		visiting[name] = true
+		for _, input := range inputs(name) {
+			if err := visit(input); err != nil {
+				return err
+			}
+		}
+		delete(pending, name)
+		result = append(result, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// diffPipelineRequests returns the paths of the fields that differ between
+// the manifest's pipeline spec and the current one.
+func diffPipelineRequests(manifest, current *ppsclient.CreatePipelineRequest) ([]string, error) {
+	toMap := func(req *ppsclient.CreatePipelineRequest) (map[string]interface{}, error) {
+		var buf bytes.Buffer
+		if err := (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, req); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		result := make(map[string]interface{})
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		return result, nil
+	}
+	m, err := toMap(manifest)
+	if err != nil {
+		return nil, err
+	}
+	c, err := toMap(current)
+	if err != nil {
+		return nil, err
+	}
+	delete(m, "update")
+	delete(m, "reprocess")
+	var fields []string
+	diffFields("", "", m, c, &fields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// diffFields appends the paths of the fields that differ between the values
+// m and c of the field named key at path to fields.
+func diffFields(path, key string, m, c interface{}, fields *[]string) {
+	if ml, ok := m.([]interface{}); ok && len(ml) > 0 {
+		// Lists of objects may hold defaulted fields (e.g. cross inputs), so
+		// they're compared element by element.
+		_, isObjects := ml[0].(map[string]interface{})
+		if cl, ok := c.([]interface{}); ok && isObjects && len(ml) == len(cl) {
+			for i := range ml {
+				diffFields(fmt.Sprintf("%s[%d]", path, i), key, ml[i], cl[i], fields)
+			}
+			return
+		}
+	}
+	mm, mok := m.(map[string]interface{})
+	cm, cok := c.(map[string]interface{})
+	if !mok || !cok {
+		if !reflect.DeepEqual(m, c) {
+			*fields = append(*fields, path)
+		}
+		return
+	}
+	defaulted := make(map[string]bool)
+	for _, field := range defaultedPipelineFields[key] {
+		defaulted[field] = true
+	}
+	for k, mv := range mm {
+		diffFields(joinFieldPath(path, k), k, mv, cm[k], fields)
+	}
+	for k := range cm {
+		if _, ok := mm[k]; !ok && !defaulted[k] {
+			*fields = append(*fields, joinFieldPath(path, k))
+		}
+	}
+}
+
+func joinFieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
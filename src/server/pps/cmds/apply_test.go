@@ -0,0 +1,113 @@
+package cmds
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+const dagManifest = `
+repos:
+- repo:
+    name: images
+  description: Raw images
+branches:
+- branch:
+    repo:
+      name: images
+    name: staging
+pipelines:
+- pipeline:
+    name: montage
+  transform:
+    cmd: [montage]
+  input:
+    cross:
+    - pfs:
+        repo: edges
+        glob: /
+    - pfs:
+        repo: images
+        glob: /
+- pipeline:
+    name: edges
+  transform:
+    cmd: [edges]
+  input:
+    pfs:
+      repo: images
+      glob: /*
+`
+
+func TestDAGDiff(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dag.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(dagManifest), 0600))
+	manifest, err := ppsutil.ReadDAGManifest(path, nil)
+	require.NoError(t, err)
+
+	// Against an empty cluster, everything is created, and edges comes first
+	// since montage takes it as input.
+	diff, err := computeDAGDiff(manifest, nil, nil, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"+ repo images", "+ branch images@staging", "+ pipeline montage", "+ pipeline edges"}, diff.changes)
+	require.Equal(t, 2, len(diff.pipelines))
+	require.Equal(t, "edges", diff.pipelines[0].Pipeline.Name)
+	require.Equal(t, "montage", diff.pipelines[1].Pipeline.Name)
+
+	// Once the DAG exists (with defaults filled in), nothing changes.
+	repos := map[string]*pfs.RepoInfo{
+		"images": {Repo: client.NewRepo("images"), Description: "Raw images"},
+	}
+	branches := map[string]*pfs.BranchInfo{
+		"images@staging": {Branch: client.NewBranch("images", "staging"), Head: client.NewCommit("images", "abc")},
+	}
+	var pipelineInfos []*pps.PipelineInfo
+	for _, req := range manifest.Pipelines {
+		pipelineInfo := &pps.PipelineInfo{
+			Pipeline:      req.Pipeline,
+			Transform:     &pps.Transform{Cmd: req.Transform.Cmd, Image: "ubuntu:16.04"},
+			Input:         proto.Clone(req.Input).(*pps.Input),
+			OutputBranch:  "master",
+			Salt:          "salt",
+			DatumTries:    3,
+			MaxQueueSize:  1,
+			CacheSize:     "64M",
+			ReprocessSpec: client.ReprocessSpecUntilSuccess,
+			EnableStats:   true,
+		}
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if input.Pfs != nil {
+				input.Pfs.Branch = "master"
+				input.Pfs.Name = input.Pfs.Repo
+			}
+		})
+		pipelineInfos = append(pipelineInfos, pipelineInfo)
+	}
+	diff, err = computeDAGDiff(manifest, repos, branches, pipelineInfos, true)
+	require.NoError(t, err)
+	require.True(t, diff.empty())
+
+	// Changed and removed fields are reported, and pipelines that aren't in the
+	// manifest are only deleted with prune.
+	repos["images"].Description = "Old description"
+	pipelineInfos[1].Transform.Cmd = []string{"old-edges"}
+	pipelineInfos[1].Transform.Env = map[string]string{"FOO": "bar"}
+	pipelineInfos = append(pipelineInfos, &pps.PipelineInfo{Pipeline: client.NewPipeline("old")})
+	diff, err = computeDAGDiff(manifest, repos, branches, pipelineInfos, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"~ repo images: description", "~ pipeline edges: transform.cmd, transform.env"}, diff.changes)
+	require.True(t, diff.repos[0].Update)
+	require.True(t, diff.pipelines[0].Update)
+	require.Equal(t, 0, len(diff.deletes))
+	diff, err = computeDAGDiff(manifest, repos, branches, pipelineInfos, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"old"}, diff.deletes)
+}
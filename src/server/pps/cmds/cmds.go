@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -588,14 +589,22 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	var registry string
 	var username string
 	var pipelinePath string
+	var templateArgs []string
 	createPipeline := &cobra.Command{
 		Short: "Create a new pipeline.",
 		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
+		Example: `
+# Create a pipeline from a spec
+$ {{alias}} -f pipeline.json
+
+# Create a pipeline from a templated spec, which is rendered with the args
+$ {{alias}} -f pipeline.json.tmpl --arg region=us-east-1 --arg shards=4`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, false)
+			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, templateArgs, false)
 		}),
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
+	createPipeline.Flags().StringArrayVar(&templateArgs, "arg", nil, "An argument to render the pipeline spec with as a Go template, in the form 'key=value'. May be given multiple times.")
 	createPipeline.Flags().BoolVarP(&build, "build", "b", false, "If true, build and push local docker images into the docker registry.")
 	createPipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the docker registry.")
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
@@ -607,10 +616,11 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		Short: "Update an existing Pachyderm pipeline.",
 		Long:  "Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, true)
+			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, templateArgs, true)
 		}),
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
+	updatePipeline.Flags().StringArrayVar(&templateArgs, "arg", nil, "An argument to render the pipeline spec with as a Go template, in the form 'key=value'. May be given multiple times.")
 	updatePipeline.Flags().BoolVarP(&build, "build", "b", false, "If true, build and push local docker images into the docker registry.")
 	updatePipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the docker registry.")
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
@@ -618,6 +628,8 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
+	commands = append(commands, applyCmd())
+
 	runPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline> [<repo>@[<branch>|<commit>|<branch>=<commit>]...]",
 		Short: "Run an existing Pachyderm pipeline on the specified commits-branch pairs.",
@@ -661,7 +673,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	runCron := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Run an existing Pachyderm cron pipeline now",
-		Long:  "Run an existing Pachyderm cron pipeline now",
+		Long:  "Run an existing Pachyderm cron pipeline now. This makes a tick on each of the pipeline's cron inputs, and runs the query of each of its SQL inputs.",
 		Example: `
 		# Run a cron pipeline "clock" now
 		$ {{alias}} clock`,
@@ -868,6 +880,33 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(stopPipeline, "stop pipeline"))
 
+	var rollbackReprocess bool
+	rollbackPipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline> <version>",
+		Short: "Roll a pipeline back to an earlier version.",
+		Long:  "Roll a pipeline back to an earlier version. The spec of the earlier version is applied as a new version of the pipeline. Datums that the pipeline's last job processed successfully with the earlier version's salt are skipped, unless --reprocess is given.",
+		Example: `
+# Roll the "edges" pipeline back to its version 2
+$ {{alias}} edges 2
+
+# List the versions of the "edges" pipeline
+$ pachctl list pipeline edges --history all`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			version, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid version %q", args[1])
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.RollbackPipeline(args[0], version, rollbackReprocess)
+		}),
+	}
+	rollbackPipeline.Flags().BoolVar(&rollbackReprocess, "reprocess", false, "If true, reprocess all datums with the rolled back version, rather than skipping datums that were already processed with its salt.")
+	commands = append(commands, cmdutil.CreateAlias(rollbackPipeline, "rollback pipeline"))
+
 	var file string
 	createSecret := &cobra.Command{
 		Short: "Create a secret on the cluster.",
@@ -982,12 +1021,16 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	return commands
 }
 
-func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, update bool) error {
+func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, templateArgs []string, update bool) error {
 	if build && pushImages {
 		logrus.Warning("`--push-images` is redundant, as it's already enabled with `--build`")
 	}
 
-	pipelineReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
+	args, err := parseTemplateArgs(templateArgs)
+	if err != nil {
+		return err
+	}
+	pipelineReader, err := ppsutil.NewPipelineManifestReader(pipelinePath, args)
 	if err != nil {
 		return err
 	}
@@ -1412,3 +1455,20 @@ func ParsePipelineStates(stateStrs []string) (string, error) {
 	}
 	return validateJQConditionString(strings.Join(conditions, " or "))
 }
+
+// parseTemplateArgs parses pipeline template args of the form 'key=value'
+// into a map. No args returns nil, which means the spec isn't a template.
+func parseTemplateArgs(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid template arg %q, args must be of the form 'key=value'", arg)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
+}
@@ -1031,6 +1031,63 @@ func TestPipelineBuildRunCron(t *testing.T) {
 	`).Run())
 }
 
+// TestApplyDAG tests that applying a manifest that was already applied
+// doesn't report the fields that pachd defaults as changes, and that --prune
+// deletes the pipelines that aren't in the manifest.
+func TestApplyDAG(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	require.NoError(t, tu.BashCmd(`
+		yes | pachctl delete all
+	`).Run())
+	manifest := `
+	{
+	  "repos": [{"repo": {"name": "images"}, "description": "Raw images"}],
+	  "branches": [{"branch": {"repo": {"name": "images"}, "name": "staging"}}],
+	  "pipelines": [
+	    {
+	      "pipeline": {"name": "edges"},
+	      "transform": {"cmd": ["cp", "-r", "/pfs/images", "/pfs/out"]},
+	      "input": {"pfs": {"repo": "images", "glob": "/*"}}
+	    },
+	    {
+	      "pipeline": {"name": "montage"},
+	      "transform": {"cmd": ["cp", "-r", "/pfs/edges", "/pfs/out"]},
+	      "input": {
+	        "cross": [
+	          {"pfs": {"repo": "edges", "glob": "/"}},
+	          {"cron": {"name": "tick", "spec": "@every 1h"}}
+	        ]
+	      }
+	    }
+	  ]
+	}`
+	require.NoError(t, tu.BashCmd(`
+		echo '{{.manifest}}' | pachctl apply -f - --force \
+		  | match "pipeline montage"
+		pachctl list pipeline | match montage
+
+		echo '{{.manifest}}' | pachctl apply -f - --dry-run \
+		  | match "No changes."
+
+		pachctl create pipeline <<EOF
+		{
+		  "pipeline": {"name": "old"},
+		  "transform": {"cmd": ["cp", "-r", "/pfs/images", "/pfs/out"]},
+		  "input": {"pfs": {"repo": "images", "glob": "/"}}
+		}
+		EOF
+		echo '{{.manifest}}' | pachctl apply -f - --dry-run \
+		  | match "No changes."
+		echo '{{.manifest}}' | pachctl apply -f - --prune --force \
+		  | match "Deleted pipeline old"
+		pachctl list pipeline | match -v old
+		`,
+		"manifest", manifest,
+	).Run())
+}
+
 func TestWarningLatestTag(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
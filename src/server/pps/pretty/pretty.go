@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -28,6 +29,8 @@ const (
 	DatumHeader = "ID\tFILES\tSTATUS\tTIME\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
+	// FileLineageHeader is the header for file lineage edges
+	FileLineageHeader = "DEPTH\tINPUT\tOUTPUT\tJOB\tDATUM\t\n"
 	// jobReasonLen is the amount of the job reason that we print
 	jobReasonLen = 25
 )
@@ -94,21 +97,28 @@ func PrintWorkerStatusHeader(w io.Writer) {
 	fmt.Fprint(w, "WORKER\tJOB\tDATUM\tSTARTED\tQUEUE\t\n")
 }
 
-// PrintWorkerStatus pretty prints a worker status.
+// PrintWorkerStatus pretty prints a worker status, with a row for each datum
+// the worker is processing.
 func PrintWorkerStatus(w io.Writer, workerStatus *ppsclient.WorkerStatus, fullTimestamps bool) {
-	fmt.Fprintf(w, "%s\t", workerStatus.WorkerID)
-	fmt.Fprintf(w, "%s\t", workerStatus.JobID)
-	for _, datum := range workerStatus.Data {
-		fmt.Fprintf(w, datum.Path)
+	datums := workerStatus.Datums
+	if len(datums) == 0 {
+		datums = []*ppsclient.DatumStatus{{Data: workerStatus.Data, Started: workerStatus.Started}}
 	}
-	fmt.Fprintf(w, "\t")
-	if fullTimestamps {
-		fmt.Fprintf(w, "%s\t", workerStatus.Started.String())
-	} else {
-		fmt.Fprintf(w, "%s\t", pretty.Ago(workerStatus.Started))
+	for _, datumStatus := range datums {
+		fmt.Fprintf(w, "%s\t", workerStatus.WorkerID)
+		fmt.Fprintf(w, "%s\t", workerStatus.JobID)
+		for _, datum := range datumStatus.Data {
+			fmt.Fprintf(w, datum.Path)
+		}
+		fmt.Fprintf(w, "\t")
+		if fullTimestamps {
+			fmt.Fprintf(w, "%s\t", datumStatus.Started.String())
+		} else {
+			fmt.Fprintf(w, "%s\t", pretty.Ago(datumStatus.Started))
+		}
+		fmt.Fprintf(w, "%d\t", workerStatus.QueueSize)
+		fmt.Fprintln(w)
 	}
-	fmt.Fprintf(w, "%d\t", workerStatus.QueueSize)
-	fmt.Fprintln(w)
 }
 
 // PrintableJobInfo is a wrapper around JobInfo containing any formatting options
@@ -197,7 +207,8 @@ func NewPrintablePipelineInfo(pi *ppsclient.PipelineInfo) *PrintablePipelineInfo
 func PrintDetailedPipelineInfo(w io.Writer, pipelineInfo *PrintablePipelineInfo) error {
 	template, err := template.New("PipelineInfo").Funcs(funcMap).Parse(
 		`Name: {{.Pipeline.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .FullTimestamps }}
+Description: {{.Description}}{{end}}{{if .Template}}
+Template: {{pipelineTemplate .Template}}{{end}}{{if .FullTimestamps }}
 Created: {{.CreatedAt}}{{ else }}
 Created: {{prettyAgo .CreatedAt}} {{end}}
 State: {{pipelineState .State}}
@@ -215,7 +226,8 @@ Parallelism Spec: {{.ParallelismSpec}}
     Type: {{ .ResourceLimits.Gpu.Type }} 
     Number: {{ .ResourceLimits.Gpu.Number }} {{end}} {{end}}
 Datum Timeout: {{.DatumTimeout}}
-Job Timeout: {{.JobTimeout}}
+Job Timeout: {{.JobTimeout}}{{if .JobRetries}}
+Job Retries: {{.JobRetries.Count}}{{end}}
 Input:
 {{pipelineInput .PipelineInfo}}
 {{ if .GithookURL }}Githook URL: {{.GithookURL}} {{end}}
@@ -330,6 +342,15 @@ func PrintFile(w io.Writer, file *pfsclient.File) {
 	fmt.Fprintf(w, "  %s\t%s\t%s\t\n", file.Commit.Repo.Name, file.Commit.ID, file.Path)
 }
 
+// PrintFileLineageInfo prints a single edge of a file's lineage.
+func PrintFileLineageInfo(w io.Writer, fileLineageInfo *ppsclient.FileLineageInfo) {
+	fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t\n", fileLineageInfo.Depth, lineageFile(fileLineageInfo.Input), lineageFile(fileLineageInfo.Output), fileLineageInfo.Datum.Job.ID, fileLineageInfo.Datum.ID)
+}
+
+func lineageFile(file *pfsclient.File) string {
+	return fmt.Sprintf("%s@%s:%s", file.Commit.Repo.Name, file.Commit.ID, file.Path)
+}
+
 func datumState(datumState ppsclient.DatumState) string {
 	switch datumState {
 	case ppsclient.DatumState_SKIPPED:
@@ -426,6 +447,15 @@ func pipelineInput(pipelineInfo *ppsclient.PipelineInfo) string {
 	return string(input) + "\n"
 }
 
+func pipelineTemplate(tmpl *ppsclient.PipelineTemplate) string {
+	var args []string
+	for k, v := range tmpl.Args {
+		args = append(args, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(args)
+	return strings.TrimSpace(fmt.Sprintf("%s %s", tmpl.Source, strings.Join(args, " ")))
+}
+
 func jobCounts(counts map[int32]int32) string {
 	var buffer bytes.Buffer
 	for i := int32(ppsclient.JobState_JOB_STARTING); i <= int32(ppsclient.JobState_JOB_SUCCESS); i++ {
@@ -475,6 +505,10 @@ func ShorthandInput(input *ppsclient.Input) string {
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
 	case input.Cron != nil:
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
+	case input.SQL != nil:
+		return fmt.Sprintf("%s:sql", input.SQL.Name)
+	case input.Bucket != nil:
+		return fmt.Sprintf("%s:%s", input.Bucket.Name, input.Bucket.URL)
 	}
 	return ""
 }
@@ -492,4 +526,5 @@ var funcMap = template.FuncMap{
 	"prettySize":           pretty.Size,
 	"jobCounts":            jobCounts,
 	"prettyTransform":      prettyTransform,
+	"pipelineTemplate":     pipelineTemplate,
 }
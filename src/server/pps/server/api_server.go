@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"path"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -144,6 +145,16 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 			return errors.Errorf(`name "%s" was used more than once`, input.Cron.Name)
 		}
 		names[input.Cron.Name] = true
+	case input.SQL != nil:
+		if names[input.SQL.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.SQL.Name)
+		}
+		names[input.SQL.Name] = true
+	case input.Bucket != nil:
+		if names[input.Bucket.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.Bucket.Name)
+		}
+		names[input.Bucket.Name] = true
 	case input.Union != nil:
 		for _, input := range input.Union {
 			namesCopy := make(map[string]bool)
@@ -280,6 +291,24 @@ func (a *apiServer) validateInputInTransaction(txnCtx *txnenv.TransactionContext
 					return errors.Wrapf(err, "error parsing cron-spec")
 				}
 			}
+			if input.SQL != nil {
+				if set {
+					return errors.Errorf("multiple input types set")
+				}
+				set = true
+				if err := validateSQLInput(input.SQL); err != nil {
+					return err
+				}
+			}
+			if input.Bucket != nil {
+				if set {
+					return errors.Errorf("multiple input types set")
+				}
+				set = true
+				if err := validateBucketInput(input.Bucket); err != nil {
+					return err
+				}
+			}
 			if input.Git != nil {
 				if set {
 					return errors.Errorf("multiple input types set")
@@ -308,6 +337,9 @@ func validateTransform(transform *pps.Transform) error {
 	if transform.Image == "" {
 		return errors.Errorf("pipeline transform must contain an image")
 	}
+	if transform.Persistent && len(transform.Stdin) > 0 {
+		return errors.Errorf("persistent transforms receive datums on stdin, so they cannot set stdin")
+	}
 	return nil
 }
 
@@ -1434,20 +1466,22 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 		return errors.Errorf("invalid pipeline spec: ReprocessSpec must be one of '%s' or '%s'",
 			client.ReprocessSpecUntilSuccess, client.ReprocessSpecEveryJob)
 	}
+	for _, key := range request.PropagateLabels {
+		if key == "" {
+			return errors.Errorf("invalid pipeline spec: propagate_labels cannot contain an empty key")
+		}
+	}
+	if request.DatumConcurrency < 0 {
+		return errors.Errorf("invalid pipeline spec: datum_concurrency cannot be negative")
+	}
 	return nil
 }
 
 // TODO: Implement the appropriate features.
 func (a *apiServer) validateV2Features(request *pps.CreatePipelineRequest) (*pps.CreatePipelineRequest, error) {
-	if request.CacheSize != "" {
-		return nil, errors.Errorf("CacheSize not implemented")
-	}
 	if request.Service == nil && request.Spout == nil {
 		request.EnableStats = true
 	}
-	if request.MaxQueueSize != 0 {
-		return nil, errors.Errorf("MaxQueueSize not implemented")
-	}
 	return request, nil
 }
 
@@ -1506,6 +1540,25 @@ func (a *apiServer) validatePipelineInTransaction(txnCtx *txnenv.TransactionCont
 			return err
 		}
 	}
+	if pipelineInfo.JobRetries != nil {
+		if pipelineInfo.JobRetries.Count < 0 {
+			return errors.New("JobRetries.Count cannot be negative")
+		}
+		if pipelineInfo.JobRetries.Backoff != nil {
+			backoff, err := types.DurationFromProto(pipelineInfo.JobRetries.Backoff)
+			if err != nil {
+				return err
+			}
+			if backoff < 0 {
+				return errors.New("JobRetries.Backoff cannot be negative")
+			}
+		}
+		for _, reason := range pipelineInfo.JobRetries.RetryableReasons {
+			if _, err := regexp.Compile(reason); err != nil {
+				return errors.Wrapf(err, "invalid retryable reason %q", reason)
+			}
+		}
+	}
 	if pipelineInfo.PodSpec != "" && !json.Valid([]byte(pipelineInfo.PodSpec)) {
 		return errors.Errorf("malformed PodSpec")
 	}
@@ -1523,6 +1576,17 @@ func (a *apiServer) validatePipelineInTransaction(txnCtx *txnenv.TransactionCont
 			return errors.Errorf("the following service type %s is not allowed", pipelineInfo.Service.Type)
 		}
 	}
+	if pipelineInfo.Transform.Persistent && (pipelineInfo.Service != nil || pipelineInfo.Spout != nil) {
+		return errors.Errorf("services and spouts cannot have a persistent transform")
+	}
+	if pipelineInfo.DatumConcurrency > 1 {
+		if pipelineInfo.Service != nil || pipelineInfo.Spout != nil {
+			return errors.Errorf("services and spouts cannot set datum_concurrency")
+		}
+		if pipelineInfo.Transform.Persistent {
+			return errors.Errorf("persistent transforms process one datum at a time, so they cannot set datum_concurrency")
+		}
+	}
 	if pipelineInfo.Spout != nil {
 		if pipelineInfo.EnableStats {
 			return errors.Errorf("spouts are not allowed to have a stats branch")
@@ -1543,6 +1607,12 @@ func branchProvenance(input *pps.Input) []*pfs.Branch {
 		if input.Cron != nil {
 			result = append(result, client.NewBranch(input.Cron.Repo, "master"))
 		}
+		if input.SQL != nil {
+			result = append(result, client.NewBranch(input.SQL.Repo, "master"))
+		}
+		if input.Bucket != nil {
+			result = append(result, client.NewBranch(input.Bucket.Repo, "master"))
+		}
 		if input.Git != nil {
 			result = append(result, client.NewBranch(input.Git.Name, input.Git.Branch))
 		}
@@ -1657,6 +1727,10 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txnenv.Transac
 				repo = input.Pfs.Repo
 			case input.Cron != nil:
 				repo = input.Cron.Repo
+			case input.SQL != nil:
+				repo = input.SQL.Repo
+			case input.Bucket != nil:
+				repo = input.Bucket.Repo
 			case input.Git != nil:
 				repo = input.Git.Name
 			default:
@@ -1686,6 +1760,10 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txnenv.Transac
 				repo = input.Pfs.Repo
 			case input.Cron != nil:
 				repo = input.Cron.Repo
+			case input.SQL != nil:
+				repo = input.SQL.Repo
+			case input.Bucket != nil:
+				repo = input.Bucket.Repo
 			case input.Git != nil:
 				repo = input.Git.Name
 			default:
@@ -1811,6 +1889,18 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	}()
 	extended.PersistAny(ctx, a.env.GetEtcdClient(), request.Pipeline.Name)
 
+	// New pipelines are checked against the license's usage limits before
+	// the transaction (or the append to an active transaction), as the
+	// check calls out to the enterprise server
+	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, &pps.EtcdPipelineInfo{}); err != nil {
+		if !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		if err := a.checkUsageLimits(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	var specCommit *pfs.Commit
 	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
 		return txn.CreatePipeline(request, &specCommit)
@@ -1826,12 +1916,32 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	return &types.Empty{}, nil
 }
 
+// checkUsageLimits returns an error if the license server has reported that
+// the clusters sharing this cluster's license are over its usage limits.
+func (a *apiServer) checkUsageLimits(ctx context.Context) error {
+	pachClient := a.env.GetPachClient(ctx)
+	resp, err := pachClient.Enterprise.GetState(pachClient.Ctx(), &enterpriseclient.GetStateRequest{})
+	if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not get enterprise status")
+	}
+	if len(resp.LimitsExceeded) > 0 {
+		return errors.Errorf("cannot create new pipelines while the enterprise license's usage limits are exceeded (%s)", strings.Join(resp.LimitsExceeded, "; "))
+	}
+	return nil
+}
+
 func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContext, request *pps.CreatePipelineRequest, prevSpecCommit **pfs.Commit) error {
+	return a.createPipelineInTransaction(txnCtx, request, prevSpecCommit, false)
+}
+
+// createPipelineInTransaction implements CreatePipelineInTransaction. If
+// keepSalt is true, an updated pipeline gets the salt from the request rather
+// than keeping the salt of its current version.
+func (a *apiServer) createPipelineInTransaction(txnCtx *txnenv.TransactionContext, request *pps.CreatePipelineRequest, prevSpecCommit **pfs.Commit, keepSalt bool) error {
 	// Validate request
 	if err := a.validatePipelineRequest(request); err != nil {
 		return err
 	}
-
 	// Reprocess overrides the salt in the request
 	if request.Salt == "" || request.Reprocess {
 		request.Salt = uuid.NewWithoutDashes()
@@ -1867,6 +1977,11 @@ func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContex
 		S3Out:                 request.S3Out,
 		Metadata:              request.Metadata,
 		ReprocessSpec:         request.ReprocessSpec,
+		PropagateLabels:       request.PropagateLabels,
+		DatumConcurrency:      request.DatumConcurrency,
+		PrioritizeNewestJobs:  request.PrioritizeNewestJobs,
+		Template:              request.Template,
+		JobRetries:            request.JobRetries,
 	}
 	if err := setPipelineDefaults(pipelineInfo); err != nil {
 		return err
@@ -1887,6 +2002,24 @@ func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContex
 				visitErr = err
 			}
 		}
+		if input.SQL != nil {
+			if err := txnCtx.Pfs().CreateRepoInTransaction(txnCtx,
+				&pfs.CreateRepoRequest{
+					Repo:        client.NewRepo(input.SQL.Repo),
+					Description: fmt.Sprintf("SQL input repo for pipeline %s.", request.Pipeline.Name),
+				}); err != nil && !isAlreadyExistsErr(err) {
+				visitErr = err
+			}
+		}
+		if input.Bucket != nil {
+			if err := txnCtx.Pfs().CreateRepoInTransaction(txnCtx,
+				&pfs.CreateRepoRequest{
+					Repo:        client.NewRepo(input.Bucket.Repo),
+					Description: fmt.Sprintf("Bucket input repo for pipeline %s.", request.Pipeline.Name),
+				}); err != nil && !isAlreadyExistsErr(err) {
+				visitErr = err
+			}
+		}
 		if input.Git != nil {
 			if err := txnCtx.Pfs().CreateRepoInTransaction(txnCtx,
 				&pfs.CreateRepoRequest{
@@ -2069,7 +2202,7 @@ func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContex
 				provenance = nil // CreateBranch() below shouldn't create new output
 				pipelineInfo.Stopped = true
 			}
-			if !request.Reprocess {
+			if !request.Reprocess && !keepSalt {
 				pipelineInfo.Salt = oldPipelineInfo.Salt
 			}
 			specCommit, err := createOrValidateSpecCommit()
@@ -2298,6 +2431,93 @@ func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContex
 	return nil
 }
 
+// RenameInputInTransaction updates every pipeline which reads from the 'from'
+// branch (or any branch of from's repo, if from.Name is empty) to read from
+// 'to' instead. The names of the inputs are left unchanged, so the pipelines'
+// code sees its data at the same paths. specCommits holds the spec commit
+// created for each pipeline, see CreatePipelineInTransaction.
+func (a *apiServer) RenameInputInTransaction(txnCtx *txnenv.TransactionContext, from, to *pfs.Branch, specCommits map[string]*pfs.Commit) error {
+	var pipelineNames []string
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadOnly(txnCtx.ClientContext).List(pipelinePtr, col.DefaultOptions, func(name string) error {
+		pipelineNames = append(pipelineNames, name)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, name := range pipelineNames {
+		if err := a.pipelines.ReadWrite(txnCtx.Stm).Get(name, pipelinePtr); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return err
+		}
+		pipelineInfo, err := ppsutil.GetPipelineInfo(txnCtx.Client, name, pipelinePtr)
+		if err != nil {
+			return err
+		}
+		renamed := false
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			switch {
+			case input.Pfs != nil:
+				if renameInputBranch(&input.Pfs.Repo, &input.Pfs.Branch, from, to) {
+					renamed = true
+				}
+				if input.Pfs.Trigger != nil && input.Pfs.Repo == to.Repo.Name && from.Name != "" && input.Pfs.Trigger.Branch == from.Name {
+					input.Pfs.Trigger.Branch = to.Name
+					renamed = true
+				}
+			case input.Cron != nil:
+				branch := "master"
+				if renameInputBranch(&input.Cron.Repo, &branch, from, to) {
+					renamed = true
+				}
+			case input.SQL != nil:
+				branch := "master"
+				if renameInputBranch(&input.SQL.Repo, &branch, from, to) {
+					renamed = true
+				}
+			case input.Bucket != nil:
+				branch := "master"
+				if renameInputBranch(&input.Bucket.Repo, &branch, from, to) {
+					renamed = true
+				}
+			case input.Git != nil:
+				if renameInputBranch(&input.Git.Name, &input.Git.Branch, from, to) {
+					renamed = true
+				}
+			}
+		})
+		if !renamed {
+			continue
+		}
+		request := ppsutil.PipelineReqFromInfo(pipelineInfo)
+		request.Update = true
+		specCommit := specCommits[name]
+		err = a.CreatePipelineInTransaction(txnCtx, request, &specCommit)
+		if specCommit != nil {
+			specCommits[name] = specCommit
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not update inputs of pipeline %q", name)
+		}
+	}
+	return nil
+}
+
+// renameInputBranch rewrites repo and branch if they refer to 'from', and
+// returns whether it did.
+func renameInputBranch(repo, branch *string, from, to *pfs.Branch) bool {
+	if *repo != from.Repo.Name || (from.Name != "" && *branch != from.Name) {
+		return false
+	}
+	*repo = to.Repo.Name
+	if from.Name != "" {
+		*branch = to.Name
+	}
+	return true
+}
+
 // setPipelineDefaults sets the default values for a pipeline info
 func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 	if pipelineInfo.Transform.Image == "" {
@@ -2361,6 +2581,25 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
 		}
+		if input.SQL != nil {
+			if input.SQL.Repo == "" {
+				input.SQL.Repo = fmt.Sprintf("%s_%s", pipelineName, input.SQL.Name)
+			}
+			if input.SQL.Format == "" {
+				input.SQL.Format = sqlFormatJSONL
+			}
+		}
+		if input.Bucket != nil {
+			if input.Bucket.Repo == "" {
+				input.Bucket.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Bucket.Name)
+			}
+			if input.Bucket.Interval == nil {
+				input.Bucket.Interval = types.DurationProto(defaultBucketInterval)
+			}
+			if input.Bucket.Glob == "" {
+				input.Bucket.Glob = "/*"
+			}
+		}
 		if input.Git != nil {
 			if input.Git.Branch == "" {
 				input.Git.Branch = "master"
@@ -2791,7 +3030,7 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 			return grpcutil.ScrubGRPC(superUserClient.DeleteBranch(ppsconsts.SpecRepo, request.Pipeline.Name, request.Force))
 		})
 	})
-	// Delete cron input repos
+	// Delete cron, SQL and bucket input repos
 	if !request.KeepRepo {
 		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
 			if input.Cron != nil {
@@ -2799,6 +3038,16 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 					return pachClient.DeleteRepo(input.Cron.Repo, request.Force)
 				})
 			}
+			if input.SQL != nil {
+				eg.Go(func() error {
+					return pachClient.DeleteRepo(input.SQL.Repo, request.Force)
+				})
+			}
+			if input.Bucket != nil {
+				eg.Go(func() error {
+					return pachClient.DeleteRepo(input.Bucket.Repo, request.Force)
+				})
+			}
 		})
 	}
 	// Delete EtcdPipelineInfo
@@ -2897,6 +3146,60 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	return &types.Empty{}, nil
 }
 
+// RollbackPipeline implements the protobuf pps.RollbackPipeline RPC
+func (a *apiServer) RollbackPipeline(ctx context.Context, request *pps.RollbackPipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+	pachClient := a.env.GetPachClient(ctx)
+
+	// Find the version to roll back to in the pipeline's history
+	var pipelineInfo *pps.PipelineInfo
+	var currentVersion uint64
+	if err := a.listPipeline(pachClient, &pps.ListPipelineRequest{
+		Pipeline: request.Pipeline,
+		History:  -1,
+	}, func(pi *pps.PipelineInfo) error {
+		if pi.Version > currentVersion {
+			currentVersion = pi.Version
+		}
+		if pi.Version == request.Version {
+			pipelineInfo = pi
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if pipelineInfo == nil {
+		return nil, errors.Errorf("pipeline %q has no version %d", request.Pipeline.Name, request.Version)
+	}
+	if pipelineInfo.Version == currentVersion {
+		return nil, errors.Errorf("version %d is already the current version of pipeline %q", request.Version, request.Pipeline.Name)
+	}
+
+	// Re-apply the old version's spec as an update, which creates a new
+	// version (and checks that the caller is authorized to update the
+	// pipeline). The old version's salt is kept unless reprocessing.
+	createRequest := ppsutil.PipelineReqFromInfo(pipelineInfo)
+	createRequest.Update = true
+	createRequest.Reprocess = request.Reprocess
+	var specCommit *pfs.Commit
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return a.createPipelineInTransaction(txnCtx, createRequest, &specCommit, !request.Reprocess)
+	}); err != nil {
+		// attempt to clean up any commit we created
+		if specCommit != nil {
+			a.sudo(pachClient, func(superClient *client.APIClient) error {
+				return superClient.SquashCommit(ppsconsts.SpecRepo, specCommit.ID)
+			})
+		}
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -3082,53 +3385,66 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 	}
 
 	if pipelineInfo.Input == nil {
-		return nil, errors.Errorf("pipeline must have a cron input")
+		return nil, errors.Errorf("pipeline must have a cron or SQL input")
 	}
 
-	// find any cron inputs
+	// find any cron and SQL inputs
 	var crons []*pps.CronInput
+	var sqls []*pps.SQLInput
 	pps.VisitInput(pipelineInfo.Input, func(in *pps.Input) {
 		if in.Cron != nil {
 			crons = append(crons, in.Cron)
 		}
+		if in.SQL != nil {
+			sqls = append(sqls, in.SQL)
+		}
 	})
 
-	if len(crons) < 1 {
-		return nil, errors.Errorf("pipeline must have a cron input")
+	if len(crons) < 1 && len(sqls) < 1 {
+		return nil, errors.Errorf("pipeline must have a cron or SQL input")
 	}
 
-	txn, err := pachClient.StartTransaction()
-	if err != nil {
-		return nil, err
-	}
+	if len(crons) > 0 {
+		txn, err := pachClient.StartTransaction()
+		if err != nil {
+			return nil, err
+		}
 
-	// We need all the DeleteFile and the PutFile requests to happen atomicly
-	txnClient := pachClient.WithTransaction(txn)
+		// We need all the DeleteFile and the PutFile requests to happen atomicly
+		txnClient := pachClient.WithTransaction(txn)
 
-	// make a tick on each cron input
-	for _, cron := range crons {
-		// TODO: This isn't transactional, we could support a transactional modify file through the fileset API though.
-		if err := txnClient.WithModifyFileClient(cron.Repo, "master", func(mf client.ModifyFile) error {
-			if cron.Overwrite {
-				// get rid of any files, so the new file "overwrites" previous runs
-				err = mf.DeleteFile("/")
-				if err != nil && !isNotFoundErr(err) && !pfsServer.IsNoHeadErr(err) {
-					return errors.Wrapf(err, "delete error")
+		// make a tick on each cron input
+		for _, cron := range crons {
+			// TODO: This isn't transactional, we could support a transactional modify file through the fileset API though.
+			if err := txnClient.WithModifyFileClient(cron.Repo, "master", func(mf client.ModifyFile) error {
+				if cron.Overwrite {
+					// get rid of any files, so the new file "overwrites" previous runs
+					err = mf.DeleteFile("/")
+					if err != nil && !isNotFoundErr(err) && !pfsServer.IsNoHeadErr(err) {
+						return errors.Wrapf(err, "delete error")
+					}
 				}
+				// Put in an empty file named by the timestamp
+				if err := mf.PutFile(time.Now().Format(time.RFC3339), strings.NewReader("")); err != nil {
+					return errors.Wrapf(err, "put error")
+				}
+				return nil
+			}); err != nil {
+				return nil, err
 			}
-			// Put in an empty file named by the timestamp
-			if err := mf.PutFile(time.Now().Format(time.RFC3339), strings.NewReader("")); err != nil {
-				return errors.Wrapf(err, "put error")
-			}
-			return nil
-		}); err != nil {
+		}
+
+		_, err = txnClient.FinishTransaction(txn)
+		if err != nil {
 			return nil, err
 		}
 	}
 
-	_, err = txnClient.FinishTransaction(txn)
-	if err != nil {
-		return nil, err
+	// run the query of each SQL input, which can't be done in a transaction
+	for _, in := range sqls {
+		if err := a.makeSQLCommit(pachClient, in); err != nil {
+			return nil, err
+		}
 	}
 	return &types.Empty{}, nil
 }
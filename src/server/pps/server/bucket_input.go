@@ -0,0 +1,308 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+const (
+	defaultBucketInterval = time.Minute
+	// bucketStateFile is the file on a bucket input's state branch that holds
+	// its bucketState.
+	bucketStateFile = "state"
+)
+
+// bucketObject is the version of an object in a bucket input's prefix.
+type bucketObject struct {
+	ETag string `json:"etag"`
+	Size int64  `json:"size"`
+}
+
+// bucketState maps the path of each file in a bucket input's repo to the
+// version of the object it was committed from.
+type bucketState map[string]bucketObject
+
+func validateBucketInput(input *pps.BucketInput) error {
+	if len(input.Name) == 0 {
+		return errors.Errorf("input must specify a name")
+	}
+	url, err := obj.ParseURL(input.URL)
+	if err != nil {
+		return err
+	}
+	if url.Store != "local" && input.CredentialsSecret == "" {
+		return errors.Errorf("bucket input %q must specify a credentials secret", input.Name)
+	}
+	if input.Interval != nil {
+		interval, err := types.DurationFromProto(input.Interval)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		if interval <= 0 {
+			return errors.Errorf("bucket input %q must have a positive interval", input.Name)
+		}
+	}
+	return nil
+}
+
+// newBucketClient returns a client for the bucket of a bucket input, using the
+// credentials in its credentials secret. pachd's own storage bucket is
+// refused, as pipelines mustn't be able to read pachd's chunks.
+func (a *apiServer) newBucketClient(in *pps.BucketInput, url *obj.ObjectStoreURL) (obj.Client, error) {
+	isStorage, err := a.isStorageBucket(url)
+	if err != nil {
+		return nil, err
+	}
+	if isStorage {
+		return nil, errors.Errorf("bucket input %q can't watch pachd's storage bucket", in.Name)
+	}
+	creds := make(map[string]string)
+	if in.CredentialsSecret != "" {
+		secret, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Get(in.CredentialsSecret, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get credentials secret %q for bucket input %q", in.CredentialsSecret, in.Name)
+		}
+		for k, v := range secret.Data {
+			creds[k] = strings.TrimSpace(string(v))
+		}
+	}
+	return obj.NewClientFromURLAndCreds(url, creds)
+}
+
+// isStorageBucket returns true if url is in pachd's storage bucket or, for
+// local URLs, overlaps pachd's storage root.
+func (a *apiServer) isStorageBucket(url *obj.ObjectStoreURL) (bool, error) {
+	config := a.env.Config()
+	if url.Store == "local" {
+		dir := path.Join("/", strings.ReplaceAll(url.Bucket, ".", "/"), url.Object)
+		root := path.Clean(config.StorageRoot)
+		return isSubdir(dir, root) || isSubdir(root, dir), nil
+	}
+	var backends []string
+	switch url.Store {
+	case "s3":
+		backends = []string{obj.Amazon, obj.Minio}
+	case "gs", "gcs":
+		backends = []string{obj.Google}
+	case "as", "wasb":
+		backends = []string{obj.Microsoft}
+	}
+	for _, backend := range backends {
+		if backend != config.StorageBackend {
+			continue
+		}
+		bucket, err := obj.SecretBucket(backend)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return false, nil
+			}
+			return false, err
+		}
+		return bucket == url.Bucket, nil
+	}
+	return false, nil
+}
+
+// isSubdir returns true if dir is parent or one of its subdirectories.
+func isSubdir(dir, parent string) bool {
+	rel, err := filepath.Rel(parent, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, "../")
+}
+
+// bucketPrefix returns the prefix that a bucket input's objects are under,
+// which always ends in a slash unless it's the whole bucket.
+func bucketPrefix(url *obj.ObjectStoreURL) string {
+	if url.Object == "" {
+		return ""
+	}
+	return url.Object + "/"
+}
+
+// listBucket returns the version of every object under prefix, keyed by its
+// path relative to prefix.
+func listBucket(ctx context.Context, objClient obj.Client, prefix string) (bucketState, error) {
+	state := make(bucketState)
+	if err := obj.WalkInfo(ctx, objClient, prefix, func(name string, info *obj.ObjectInfo) error {
+		if !strings.HasPrefix(name, prefix) || strings.HasSuffix(name, "/") {
+			// skip directory markers, and objects that only share a prefix
+			// with the input's directory
+			return nil
+		}
+		state[path.Join("/", strings.TrimPrefix(name, prefix))] = bucketObject{
+			ETag: info.ETag,
+			Size: info.Size,
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// diffBucket returns the paths of objects that were added or changed between
+// prev and cur, and the paths of objects that were removed.
+func diffBucket(prev, cur bucketState) (put, del []string) {
+	for p, o := range cur {
+		if prevObject, ok := prev[p]; !ok || prevObject != o {
+			put = append(put, p)
+		}
+	}
+	for p := range prev {
+		if _, ok := cur[p]; !ok {
+			del = append(del, p)
+		}
+	}
+	sort.Strings(put)
+	sort.Strings(del)
+	return put, del
+}
+
+// readBucketState returns the state that was written by the last sync of a
+// bucket input, or an empty state if it has never been synced.
+func readBucketState(pachClient *client.APIClient, repo string) (bucketState, error) {
+	var buf bytes.Buffer
+	if err := pachClient.GetFile(repo, ppsconsts.BucketStateBranch, bucketStateFile, &buf); err != nil {
+		if isNotFoundErr(err) || pfsserver.IsNoHeadErr(err) {
+			return make(bucketState), nil
+		}
+		return nil, err
+	}
+	state := make(bucketState)
+	if err := json.Unmarshal(buf.Bytes(), &state); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return state, nil
+}
+
+func writeBucketState(pachClient *client.APIClient, repo string, state bucketState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	commit, err := pachClient.StartCommit(repo, ppsconsts.BucketStateBranch)
+	if err != nil {
+		return err
+	}
+	if err := pachClient.PutFile(repo, commit.ID, bucketStateFile, bytes.NewReader(data)); err != nil {
+		return err
+	}
+	return pachClient.FinishCommit(repo, commit.ID)
+}
+
+// syncBucket commits the objects under a bucket input's prefix that were
+// added or changed since the last sync to the input's repo, and deletes the
+// files of removed objects if the input asks for it. The state of the bucket
+// is saved on the repo's BucketStateBranch after each sync, so that a
+// restarted pachd doesn't recommit every object.
+func syncBucket(pachClient *client.APIClient, objClient obj.Client, prefix string, in *pps.BucketInput) (retErr error) {
+	prev, err := readBucketState(pachClient, in.Repo)
+	if err != nil {
+		return err
+	}
+	cur, err := listBucket(pachClient.Ctx(), objClient, prefix)
+	if err != nil {
+		return errors.Wrapf(err, "could not list bucket for input %q", in.Name)
+	}
+	put, del := diffBucket(prev, cur)
+	if !in.Delete {
+		del = nil
+	}
+	if len(put) > 0 || len(del) > 0 {
+		commit, err := pachClient.StartCommit(in.Repo, "master")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if retErr != nil {
+				// Don't leave an unfinished commit behind, which would block
+				// the next sync.
+				pachClient.SquashCommit(in.Repo, commit.ID)
+			}
+		}()
+		if err := pachClient.WithModifyFileClient(in.Repo, commit.ID, func(mf client.ModifyFile) error {
+			for _, p := range del {
+				if err := mf.DeleteFile(p); err != nil && !isNotFoundErr(err) {
+					return errors.Wrapf(err, "delete error")
+				}
+			}
+			for _, p := range put {
+				pr, pw := io.Pipe()
+				go func(name string) {
+					pw.CloseWithError(objClient.Get(pachClient.Ctx(), name, pw))
+				}(prefix + strings.TrimPrefix(p, "/"))
+				if err := mf.PutFile(p, pr); err != nil {
+					pr.CloseWithError(err)
+					return errors.Wrapf(err, "put error")
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := pachClient.FinishCommit(in.Repo, commit.ID); err != nil {
+			return err
+		}
+	} else if len(prev) == len(cur) {
+		// nothing changed
+		return nil
+	}
+	return writeBucketState(pachClient, in.Repo, cur)
+}
+
+// watchBucket syncs a single bucket input on its interval. It's a helper
+// function called by monitorPipeline.
+func (m *ppsMaster) watchBucket(pachClient *client.APIClient, in *pps.Input) error {
+	interval := defaultBucketInterval
+	if in.Bucket.Interval != nil {
+		var err error
+		if interval, err = types.DurationFromProto(in.Bucket.Interval); err != nil {
+			return errors.EnsureStack(err) // Shouldn't happen, as the input is validated in CreatePipeline
+		}
+	}
+	url, err := obj.ParseURL(in.Bucket.URL)
+	if err != nil {
+		return err
+	}
+	objClient, err := m.a.newBucketClient(in.Bucket, url)
+	if err != nil {
+		return err
+	}
+	// make sure there isn't an unfinished commit on the branch
+	commitInfo, err := pachClient.InspectCommit(in.Bucket.Repo, "master")
+	if err != nil && !pfsserver.IsNoHeadErr(err) {
+		return err
+	} else if commitInfo != nil && commitInfo.Finished == nil {
+		// and if there is, delete it
+		if err = pachClient.SquashCommit(in.Bucket.Repo, commitInfo.Commit.ID); err != nil {
+			return err
+		}
+	}
+	for {
+		if err := syncBucket(pachClient, objClient, bucketPrefix(url), in.Bucket); err != nil {
+			return err
+		}
+		select {
+		case <-time.After(interval):
+		case <-pachClient.Ctx().Done():
+			return pachClient.Ctx().Err()
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/obj"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/internal/testpachd"
+	tu "github.com/pachyderm/pachyderm/v2/src/internal/testutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+)
+
+func TestListBucket(t *testing.T) {
+	ctx := context.Background()
+	c, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	for _, name := range []string{"data/a", "data/sub/b", "database/c"} {
+		require.NoError(t, c.Put(ctx, name, strings.NewReader(name)))
+	}
+	prev, err := listBucket(ctx, c, "data/")
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"/a", "/sub/b"}, bucketPaths(prev))
+	require.Equal(t, int64(len("data/a")), prev["/a"].Size)
+
+	// Nothing changed.
+	cur, err := listBucket(ctx, c, "data/")
+	require.NoError(t, err)
+	put, del := diffBucket(prev, cur)
+	require.Equal(t, 0, len(put))
+	require.Equal(t, 0, len(del))
+
+	require.NoError(t, c.Put(ctx, "data/a", strings.NewReader("changed")))
+	require.NoError(t, c.Put(ctx, "data/d", strings.NewReader("new")))
+	require.NoError(t, c.Delete(ctx, "data/sub/b"))
+	cur, err = listBucket(ctx, c, "data/")
+	require.NoError(t, err)
+	put, del = diffBucket(prev, cur)
+	require.Equal(t, []string{"/a", "/d"}, put)
+	require.Equal(t, []string{"/sub/b"}, del)
+
+	// The whole bucket.
+	cur, err = listBucket(ctx, c, "")
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"/data/a", "/data/d", "/database/c"}, bucketPaths(cur))
+}
+
+func TestSyncBucket(t *testing.T) {
+	env := testpachd.NewRealEnv(t, tu.NewTestDBConfig(t))
+	c := env.PachClient
+	ctx := context.Background()
+	objClient, err := obj.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+	for _, name := range []string{"data/a", "data/sub/b"} {
+		require.NoError(t, objClient.Put(ctx, name, strings.NewReader(name)))
+	}
+	in := &pps.BucketInput{Name: "in", Repo: "in", Delete: true}
+	require.NoError(t, c.CreateRepo(in.Repo))
+
+	// checkFiles checks the files on master and the number of commits to it.
+	checkFiles := func(numCommits int, files map[string]string) {
+		commitInfos, err := c.ListCommit(in.Repo, "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, numCommits, len(commitInfos))
+		var paths []string
+		require.NoError(t, c.WalkFile(in.Repo, "master", "/", func(fileInfo *pfs.FileInfo) error {
+			if fileInfo.FileType == pfs.FileType_FILE {
+				paths = append(paths, fileInfo.File.Path)
+			}
+			return nil
+		}))
+		require.Equal(t, len(files), len(paths), "%v", paths)
+		for p, content := range files {
+			var buf bytes.Buffer
+			require.NoError(t, c.GetFile(in.Repo, "master", p, &buf))
+			require.Equal(t, content, buf.String())
+		}
+	}
+	require.NoError(t, syncBucket(c, objClient, "data/", in))
+	checkFiles(1, map[string]string{"/a": "data/a", "/sub/b": "data/sub/b"})
+
+	// Nothing changed, so nothing is committed.
+	require.NoError(t, syncBucket(c, objClient, "data/", in))
+	checkFiles(1, map[string]string{"/a": "data/a", "/sub/b": "data/sub/b"})
+
+	require.NoError(t, objClient.Put(ctx, "data/a", strings.NewReader("changed")))
+	require.NoError(t, objClient.Put(ctx, "data/d", strings.NewReader("new")))
+	require.NoError(t, objClient.Delete(ctx, "data/sub/b"))
+	require.NoError(t, syncBucket(c, objClient, "data/", in))
+	checkFiles(2, map[string]string{"/a": "changed", "/d": "new"})
+
+	// Without Delete, the files of removed objects are kept, but the removal
+	// is still recorded so that it isn't seen again.
+	in.Delete = false
+	require.NoError(t, objClient.Delete(ctx, "data/d"))
+	require.NoError(t, syncBucket(c, objClient, "data/", in))
+	checkFiles(2, map[string]string{"/a": "changed", "/d": "new"})
+	state, err := readBucketState(c, in.Repo)
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"/a"}, bucketPaths(state))
+}
+
+func bucketPaths(state bucketState) []string {
+	var result []string
+	for p := range state {
+		result = append(result, p)
+	}
+	return result
+}
+
+func TestValidateBucketInput(t *testing.T) {
+	require.NoError(t, validateBucketInput(&pps.BucketInput{Name: "in", URL: "s3://bucket/prefix", CredentialsSecret: "creds"}))
+	require.NoError(t, validateBucketInput(&pps.BucketInput{Name: "in", URL: "local://tmp/prefix"}))
+	require.YesError(t, validateBucketInput(&pps.BucketInput{Name: "in", URL: "s3://bucket/prefix"}))
+	require.YesError(t, validateBucketInput(&pps.BucketInput{URL: "s3://bucket/prefix", CredentialsSecret: "creds"}))
+	require.YesError(t, validateBucketInput(&pps.BucketInput{Name: "in", URL: "ftp://bucket/prefix", CredentialsSecret: "creds"}))
+	require.YesError(t, validateBucketInput(&pps.BucketInput{Name: "in", URL: "s3://bucket", CredentialsSecret: "creds", Interval: types.DurationProto(0)}))
+}
+
+func TestIsSubdir(t *testing.T) {
+	require.True(t, isSubdir("/pach", "/pach"))
+	require.True(t, isSubdir("/pach/chunk", "/pach"))
+	require.True(t, isSubdir("/pach", "/"))
+	require.False(t, isSubdir("/pachyderm", "/pach"))
+	require.False(t, isSubdir("/", "/pach"))
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/datum"
+)
+
+// FileLineage implements the protobuf pps.FileLineage RPC. Lineage is
+// reconstructed from the datum metadata stored in the meta commit of each
+// output commit, which records the input files of every datum along with the
+// output the datum wrote.
+func (a *apiServer) FileLineage(request *pps.FileLineageRequest, server pps.API_FileLineageServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	if request.File == nil || request.File.Commit == nil || request.File.Commit.Repo == nil {
+		return errors.Errorf("must specify a file")
+	}
+	lw := &lineageWalker{
+		pachClient: a.env.GetPachClient(server.Context()),
+		maxDepth:   request.Depth,
+		visited:    make(map[string]bool),
+		cb:         server.Send,
+	}
+	file := &pfs.File{
+		Commit: request.File.Commit,
+		Path:   cleanLineagePath(request.File.Path),
+	}
+	if request.Downstream {
+		return lw.downstream(file, 1)
+	}
+	return lw.upstream(file, 1)
+}
+
+type lineageWalker struct {
+	pachClient *client.APIClient
+	maxDepth   int64
+	// visited records the files that have already been traced so that files
+	// reachable through multiple datums are only traced once.
+	visited map[string]bool
+	cb      func(*pps.FileLineageInfo) error
+}
+
+func (lw *lineageWalker) visit(file *pfs.File) bool {
+	key := path.Join(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	if lw.visited[key] {
+		return false
+	}
+	lw.visited[key] = true
+	return true
+}
+
+func (lw *lineageWalker) done(depth int64) bool {
+	return lw.maxDepth > 0 && depth > lw.maxDepth
+}
+
+// upstream sends the input files of the datums that wrote file, and then
+// recurses on those input files.
+func (lw *lineageWalker) upstream(file *pfs.File, depth int64) error {
+	if lw.done(depth) {
+		return nil
+	}
+	commitInfo, err := lw.pachClient.InspectCommit(file.Commit.Repo.Name, file.Commit.ID)
+	if err != nil {
+		return err
+	}
+	output := &pfs.File{Commit: commitInfo.Commit, Path: file.Path}
+	if !lw.visit(output) {
+		return nil
+	}
+	metaCommit := ppsutil.GetStatsCommit(commitInfo)
+	if metaCommit == nil {
+		// Files in commits without datum metadata were not written by a
+		// pipeline, so they are the sources of the lineage.
+		return nil
+	}
+	pattern := "/" + path.Join(datum.PFSPrefix, "*", datum.OutputPrefix, output.Path)
+	fileInfos, err := lw.pachClient.GlobFileAll(metaCommit.Repo.Name, metaCommit.ID, pattern)
+	if err != nil {
+		return err
+	}
+	var inputs []*pfs.File
+	for _, fi := range fileInfos {
+		// The datum ID is the path component directly below the pfs prefix.
+		ID := strings.Split(strings.TrimPrefix(fi.File.Path, "/"), "/")[1]
+		meta, err := lw.readMeta(metaCommit, ID)
+		if err != nil {
+			return err
+		}
+		for _, input := range meta.Inputs {
+			if input.FileInfo == nil {
+				continue
+			}
+			if err := lw.cb(&pps.FileLineageInfo{
+				Input:  input.FileInfo.File,
+				Output: output,
+				Datum:  lineageDatum(meta),
+				Depth:  depth,
+			}); err != nil {
+				return err
+			}
+			inputs = append(inputs, input.FileInfo.File)
+		}
+	}
+	for _, input := range inputs {
+		if err := lw.upstream(input, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// downstream sends the output files written by the datums that consumed file,
+// and then recurses on those output files.
+func (lw *lineageWalker) downstream(file *pfs.File, depth int64) error {
+	if lw.done(depth) {
+		return nil
+	}
+	commitInfo, err := lw.pachClient.InspectCommit(file.Commit.Repo.Name, file.Commit.ID)
+	if err != nil {
+		return err
+	}
+	input := &pfs.File{Commit: commitInfo.Commit, Path: file.Path}
+	if !lw.visit(input) {
+		return nil
+	}
+	var outputs []*pfs.File
+	for _, commitRange := range commitInfo.Subvenance {
+		if commitRange.Upper.Repo.Name == commitInfo.Commit.Repo.Name {
+			// The meta commit of commitInfo, not a downstream commit.
+			continue
+		}
+		if err := lw.walkCommitRange(commitRange, func(subvCommitInfo *pfs.CommitInfo) error {
+			metaCommit := ppsutil.GetStatsCommit(subvCommitInfo)
+			if metaCommit == nil {
+				return nil
+			}
+			return datum.NewCommitIterator(lw.pachClient, metaCommit.Repo.Name, metaCommit.ID).Iterate(func(meta *datum.Meta) error {
+				if !consumes(meta, input) {
+					return nil
+				}
+				ID := common.DatumID(meta.Inputs)
+				outputDir := "/" + path.Join(datum.PFSPrefix, ID, datum.OutputPrefix)
+				if err := lw.pachClient.WalkFile(metaCommit.Repo.Name, metaCommit.ID, outputDir, func(fi *pfs.FileInfo) error {
+					if fi.FileType != pfs.FileType_FILE {
+						return nil
+					}
+					output := &pfs.File{
+						Commit: subvCommitInfo.Commit,
+						Path:   cleanLineagePath(strings.TrimPrefix(fi.File.Path, outputDir)),
+					}
+					outputs = append(outputs, output)
+					return lw.cb(&pps.FileLineageInfo{
+						Input:  input,
+						Output: output,
+						Datum:  lineageDatum(meta),
+						Depth:  depth,
+					})
+				}); err != nil && !isNotFoundErr(err) {
+					return err
+				}
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+	}
+	for _, output := range outputs {
+		if err := lw.downstream(output, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkCommitRange calls cb with every commit in commitRange, starting from the
+// upper end of the range and following parents down to the lower end.
+func (lw *lineageWalker) walkCommitRange(commitRange *pfs.CommitRange, cb func(*pfs.CommitInfo) error) error {
+	commit := commitRange.Upper
+	for commit != nil {
+		commitInfo, err := lw.pachClient.InspectCommit(commit.Repo.Name, commit.ID)
+		if err != nil {
+			return err
+		}
+		if err := cb(commitInfo); err != nil {
+			return err
+		}
+		if commit.ID == commitRange.Lower.ID {
+			return nil
+		}
+		commit = commitInfo.ParentCommit
+	}
+	return nil
+}
+
+func (lw *lineageWalker) readMeta(metaCommit *pfs.Commit, ID string) (*datum.Meta, error) {
+	buf := &bytes.Buffer{}
+	if err := lw.pachClient.GetFile(metaCommit.Repo.Name, metaCommit.ID, path.Join(datum.MetaPrefix, ID, datum.MetaFileName), buf); err != nil {
+		return nil, err
+	}
+	meta := &datum.Meta{}
+	if err := jsonpb.Unmarshal(buf, meta); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal meta for datum %s", ID)
+	}
+	return meta, nil
+}
+
+// consumes returns true if one of the datum's inputs is file, or a directory
+// containing file, or a file contained in file.
+func consumes(meta *datum.Meta, file *pfs.File) bool {
+	for _, input := range meta.Inputs {
+		if input.FileInfo == nil {
+			continue
+		}
+		inputFile := input.FileInfo.File
+		if inputFile.Commit.Repo.Name != file.Commit.Repo.Name || inputFile.Commit.ID != file.Commit.ID {
+			continue
+		}
+		p := cleanLineagePath(inputFile.Path)
+		if p == file.Path || strings.HasPrefix(file.Path, strings.TrimSuffix(p, "/")+"/") || strings.HasPrefix(p, strings.TrimSuffix(file.Path, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func lineageDatum(meta *datum.Meta) *pps.Datum {
+	return &pps.Datum{
+		ID:  common.DatumID(meta.Inputs),
+		Job: &pps.Job{ID: meta.JobID},
+	}
+}
+
+func cleanLineagePath(p string) string {
+	return path.Clean("/" + p)
+}
@@ -187,6 +187,22 @@ func (m *ppsMaster) monitorPipeline(pachClient *client.APIClient, pipelineInfo *
 					backoff.NotifyCtx(pachClient.Ctx(), "cron for "+in.Cron.Name))
 			})
 		}
+		if in.SQL != nil && in.SQL.Spec != "" {
+			eg.Go(func() error {
+				return backoff.RetryNotify(func() error {
+					return m.makeSQLCommits(pachClient, in)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(pachClient.Ctx(), "sql for "+in.SQL.Name))
+			})
+		}
+		if in.Bucket != nil {
+			eg.Go(func() error {
+				return backoff.RetryNotify(func() error {
+					return m.watchBucket(pachClient, in)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(pachClient.Ctx(), "bucket for "+in.Bucket.Name))
+			})
+		}
 	})
 	if pipelineInfo.Standby {
 		// Capacity 1 gives us a bit of buffer so we don't needlessly go into
@@ -0,0 +1,338 @@
+package server
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	// Register the postgres driver with database/sql.
+	_ "github.com/lib/pq"
+	"github.com/robfig/cron"
+	logrus "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/grpcutil"
+	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	pfsserver "github.com/pachyderm/pachyderm/v2/src/server/pfs"
+)
+
+const (
+	sqlFormatJSONL = "jsonl"
+	sqlFormatCSV   = "csv"
+)
+
+// sqlDriverDSN returns the database/sql driver name and data source name for
+// the URL of a SQL input. If password is set, it replaces the password in the
+// URL.
+func sqlDriverDSN(rawURL, password string) (string, string, error) {
+	parts := strings.SplitN(rawURL, "://", 2)
+	if len(parts) != 2 {
+		return "", "", errors.Errorf("SQL input URL %q must start with postgres:// or mysql://", rawURL)
+	}
+	switch parts[0] {
+	case "postgres", "postgresql":
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "invalid postgres URL")
+		}
+		if password != "" {
+			u.User = url.UserPassword(u.User.Username(), password)
+		}
+		return "postgres", u.String(), nil
+	case "mysql":
+		cfg, err := mysql.ParseDSN(parts[1])
+		if err != nil {
+			return "", "", errors.Wrapf(err, "invalid mysql URL")
+		}
+		if password != "" {
+			cfg.Passwd = password
+		}
+		// Scan DATE and DATETIME columns as times, rather than bytes.
+		cfg.ParseTime = true
+		return "mysql", cfg.FormatDSN(), nil
+	}
+	return "", "", errors.Errorf("unsupported SQL input URL scheme %q, must be postgres or mysql", parts[0])
+}
+
+func validateSQLInput(input *pps.SQLInput) error {
+	if len(input.Name) == 0 {
+		return errors.Errorf("input must specify a name")
+	}
+	if _, _, err := sqlDriverDSN(input.URL, ""); err != nil {
+		return err
+	}
+	if input.Query == "" {
+		return errors.Errorf("SQL input %q must specify a query", input.Name)
+	}
+	switch input.Format {
+	case "", sqlFormatJSONL, sqlFormatCSV:
+	default:
+		return errors.Errorf("SQL input %q has unsupported format %q, must be %s or %s", input.Name, input.Format, sqlFormatJSONL, sqlFormatCSV)
+	}
+	if input.Spec != "" {
+		if _, err := cron.ParseStandard(input.Spec); err != nil {
+			return errors.Wrapf(err, "error parsing cron-spec")
+		}
+	}
+	if input.RowsPerFile < 0 {
+		return errors.Errorf("SQL input %q cannot have a negative rows_per_file", input.Name)
+	}
+	return nil
+}
+
+// sqlRows is the subset of *sql.Rows used by writeSQLResults.
+type sqlRows interface {
+	Columns() ([]string, error)
+	Next() bool
+	Scan(dest ...interface{}) error
+	Err() error
+}
+
+// writeSQLResults writes rows to files named by their index, such as
+// 00000000.jsonl, in the given format. If rowsPerFile is nonzero, each file
+// holds at most that many rows. At least one file is always written, so an
+// empty result is still visible. Rows are streamed to put as they're read,
+// so a file is never held in memory.
+func writeSQLResults(rows sqlRows, format string, rowsPerFile int64, put func(path string, r io.Reader) error) (retErr error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	var pw *io.PipeWriter
+	var w *bufio.Writer
+	var csvWriter *csv.Writer
+	var putErr chan error
+	var numFiles, numRows int64
+	defer func() {
+		// stop the put of an unfinished file
+		if pw != nil {
+			pw.CloseWithError(retErr)
+			if err := <-putErr; err != nil {
+				retErr = err
+			}
+		}
+	}()
+	startFile := func() error {
+		var pr *io.PipeReader
+		pr, pw = io.Pipe()
+		putErr = make(chan error, 1)
+		path := fmt.Sprintf("%08d.%s", numFiles, format)
+		go func() {
+			err := put(path, pr)
+			// unblock the writer if put returned early
+			pr.Close()
+			putErr <- err
+		}()
+		w = bufio.NewWriter(pw)
+		csvWriter = csv.NewWriter(w)
+		numRows = 0
+		if format == sqlFormatCSV {
+			return csvWriter.Write(columns)
+		}
+		return nil
+	}
+	finishFile := func() error {
+		csvWriter.Flush()
+		err := csvWriter.Error()
+		if err == nil {
+			err = w.Flush()
+		}
+		pw.CloseWithError(err)
+		pw = nil
+		if err := <-putErr; err != nil {
+			return err
+		}
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		numFiles++
+		return nil
+	}
+	if err := startFile(); err != nil {
+		return err
+	}
+	for rows.Next() {
+		if rowsPerFile > 0 && numRows == rowsPerFile {
+			if err := finishFile(); err != nil {
+				return err
+			}
+			if err := startFile(); err != nil {
+				return err
+			}
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if format == sqlFormatCSV {
+			record := make([]string, len(values))
+			for i, value := range values {
+				if value != nil {
+					record[i] = fmt.Sprint(sqlValue(value))
+				}
+			}
+			if err := csvWriter.Write(record); err != nil {
+				return err
+			}
+		} else {
+			// The object is built by hand to keep the columns in order.
+			w.WriteString("{")
+			for i, value := range values {
+				if i > 0 {
+					w.WriteString(",")
+				}
+				key, err := json.Marshal(columns[i])
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				data, err := json.Marshal(sqlValue(value))
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				w.Write(key)
+				w.WriteString(":")
+				w.Write(data)
+			}
+			// bufio.Writer's errors are sticky, so this reports any failed
+			// write of the row
+			if _, err := w.WriteString("}\n"); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		numRows++
+	}
+	if err := rows.Err(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return finishFile()
+}
+
+// sqlValue converts a value scanned from a SQL row into one that's written
+// as text, rather than base64 or a Go specific format.
+func sqlValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case []byte:
+		return string(value)
+	case time.Time:
+		return value.Format(time.RFC3339Nano)
+	}
+	return value
+}
+
+// makeSQLCommit runs the query of a SQL input and commits its results to the
+// input's repo, replacing the results of the previous run. The query and the
+// time it was run are set as labels on the commit.
+func (a *apiServer) makeSQLCommit(pachClient *client.APIClient, in *pps.SQLInput) (retErr error) {
+	var password string
+	if in.PasswordSecret != "" {
+		secret, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Get(in.PasswordSecret, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "could not get password secret %q for SQL input %q", in.PasswordSecret, in.Name)
+		}
+		password = string(secret.Data["password"])
+	}
+	driver, dsn, err := sqlDriverDSN(in.URL, password)
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer db.Close()
+	snapshotTime := time.Now()
+	rows, err := db.QueryContext(pachClient.Ctx(), in.Query)
+	if err != nil {
+		return errors.Wrapf(err, "error running query for SQL input %q", in.Name)
+	}
+	defer rows.Close()
+	commit, err := pachClient.StartCommit(in.Repo, "master")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			// Don't leave an unfinished commit behind, which would block the
+			// next run.
+			if err := pachClient.SquashCommit(in.Repo, commit.ID); err != nil {
+				logrus.Errorf("could not squash unfinished commit %s of SQL input %q: %v", commit.ID, in.Name, err)
+			}
+		}
+	}()
+	if err := pachClient.WithModifyFileClient(in.Repo, commit.ID, func(mf client.ModifyFile) error {
+		// get rid of any files, so the new results replace the previous run's
+		if err := mf.DeleteFile("/"); err != nil && !isNotFoundErr(err) && !pfsserver.IsNoHeadErr(err) {
+			return errors.Wrapf(err, "delete error")
+		}
+		return writeSQLResults(rows, in.Format, in.RowsPerFile, func(path string, r io.Reader) error {
+			return mf.PutFile(path, r)
+		})
+	}); err != nil {
+		return err
+	}
+	_, err = pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+		Commit: commit,
+		Labels: map[string]string{
+			ppsconsts.SQLQueryLabel:        in.Query,
+			ppsconsts.SQLSnapshotTimeLabel: snapshotTime.Format(time.RFC3339Nano),
+		},
+	})
+	return grpcutil.ScrubGRPC(err)
+}
+
+// makeSQLCommits runs the query of a single SQL input on its schedule. It's a
+// helper function called by monitorPipeline.
+func (m *ppsMaster) makeSQLCommits(pachClient *client.APIClient, in *pps.Input) error {
+	schedule, err := cron.ParseStandard(in.SQL.Spec)
+	if err != nil {
+		return err // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	// make sure there isn't an unfinished commit on the branch
+	commitInfo, err := pachClient.InspectCommit(in.SQL.Repo, "master")
+	if err != nil && !pfsserver.IsNoHeadErr(err) {
+		return err
+	} else if commitInfo != nil && commitInfo.Finished == nil {
+		// and if there is, delete it
+		if err = pachClient.SquashCommit(in.SQL.Repo, commitInfo.Commit.ID); err != nil {
+			return err
+		}
+		commitInfo, err = pachClient.InspectCommit(in.SQL.Repo, "master")
+		if err != nil && !pfsserver.IsNoHeadErr(err) {
+			return err
+		}
+	}
+	// Pick up the schedule from the last run, if there was one.
+	latestTime := time.Now()
+	if commitInfo != nil {
+		if t, err := time.Parse(time.RFC3339Nano, commitInfo.Labels[ppsconsts.SQLSnapshotTimeLabel]); err == nil {
+			latestTime = t
+		}
+	}
+	for {
+		next := schedule.Next(latestTime)
+		select {
+		case <-time.After(time.Until(next)):
+		case <-pachClient.Ctx().Done():
+			return pachClient.Ctx().Err()
+		}
+		if err := m.a.makeSQLCommit(pachClient, in.SQL); err != nil {
+			return err
+		}
+		latestTime = next
+	}
+}
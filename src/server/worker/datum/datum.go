@@ -86,6 +86,10 @@ type Set struct {
 	storageRoot                       string
 	metaOutputClient, pfsOutputClient client.ModifyFile
 	stats                             *Stats
+	inputCache                        *pfssync.Cache
+	// mu serializes the uploads and stats updates of datums that are
+	// processed concurrently.
+	mu sync.Mutex
 }
 
 // WithSet provides a scoped environment for a datum set.
@@ -116,7 +120,8 @@ func (s *Set) UploadMeta(meta *Meta, opts ...Option) error {
 }
 
 // WithDatum provides a scoped environment for a datum within the datum set.
-// TODO: Handle datum concurrency here, and potentially move symlinking here.
+// It can be called concurrently for different datums.
+// TODO: Potentially move symlinking here.
 func (s *Set) WithDatum(ctx context.Context, meta *Meta, cb func(*Datum) error, opts ...Option) error {
 	d := newDatum(s, meta, opts...)
 	cancelCtx, cancel := context.WithCancel(ctx)
@@ -177,6 +182,8 @@ func (d *Datum) MetaStorageRoot() string {
 }
 
 func (d *Datum) finish(err error) (retErr error) {
+	d.set.mu.Lock()
+	defer d.set.mu.Unlock()
 	defer func() {
 		if err := MergeProcessStats(d.set.stats.ProcessStats, d.meta.Stats); retErr == nil {
 			retErr = err
@@ -246,6 +253,9 @@ func (d *Datum) downloadData(downloader pfssync.Downloader) error {
 		if input.EmptyFiles {
 			opts = append(opts, pfssync.WithEmpty())
 		}
+		if d.set.inputCache != nil {
+			opts = append(opts, pfssync.WithCache(d.set.inputCache, input.FileInfo))
+		}
 		if err := downloader.Download(path.Join(d.PFSStorageRoot(), input.Name), input.FileInfo.File, opts...); err != nil {
 			return err
 		}
@@ -360,6 +370,10 @@ func (d *Datum) handleSymlink(mf client.ModifyFile, dst, src string, copyFunc fu
 			input = i
 		}
 	}
+	// Symlinks to other output files are kept as symlinks.
+	if input == nil {
+		return copyFunc()
+	}
 	srcFile := input.FileInfo.File
 	srcFile.Path = path.Join(pathSplit[1:]...)
 	return mf.CopyFile(dst, srcFile, client.WithTagCopyFile(d.ID))
@@ -138,6 +138,26 @@ func newCronIterator(pachClient *client.APIClient, input *pps.CronInput) Iterato
 	})
 }
 
+func newSQLIterator(pachClient *client.APIClient, input *pps.SQLInput) Iterator {
+	return newPFSIterator(pachClient, &pps.PFSInput{
+		Name:   input.Name,
+		Repo:   input.Repo,
+		Branch: "master",
+		Commit: input.Commit,
+		Glob:   "/*",
+	})
+}
+
+func newBucketIterator(pachClient *client.APIClient, input *pps.BucketInput) Iterator {
+	return newPFSIterator(pachClient, &pps.PFSInput{
+		Name:   input.Name,
+		Repo:   input.Repo,
+		Branch: "master",
+		Commit: input.Commit,
+		Glob:   input.Glob,
+	})
+}
+
 // Hasher is the standard interface for a datum hasher.
 type Hasher interface {
 	// Hash computes the datum hash based on the inputs.
@@ -214,6 +234,26 @@ func (fsi *fileSetIterator) Iterate(cb func(*Meta) error) error {
 	}
 }
 
+type metaIterator struct {
+	metas []*Meta
+}
+
+// NewMetaIterator creates an iterator for the passed in metas.
+func NewMetaIterator(metas []*Meta) Iterator {
+	return &metaIterator{
+		metas: metas,
+	}
+}
+
+func (mi *metaIterator) Iterate(cb func(*Meta) error) error {
+	for _, meta := range mi.metas {
+		if err := cb(meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TODO: Improve the scalability (in-memory operation for now).
 // Probably should take advantage of PFS filesets for this.
 type joinIterator struct {
@@ -483,6 +523,10 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 		}
 	case input.Cron != nil:
 		iterator = newCronIterator(pachClient, input.Cron)
+	case input.SQL != nil:
+		iterator = newSQLIterator(pachClient, input.SQL)
+	case input.Bucket != nil:
+		iterator = newBucketIterator(pachClient, input.Bucket)
 	default:
 		return nil, errors.Errorf("unrecognized input type: %v", input)
 	}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 )
 
 // SetOption configures a set.
@@ -33,6 +34,13 @@ func WithStats(stats *Stats) SetOption {
 	}
 }
 
+// WithInputCache sets the cache used when downloading the inputs of datums.
+func WithInputCache(cache *pfssync.Cache) SetOption {
+	return func(s *Set) {
+		s.inputCache = cache
+	}
+}
+
 // Option configures a datum.
 type Option func(*Datum)
 
@@ -16,11 +16,13 @@ import (
 	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
 	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
 	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsdb"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
 	"github.com/pachyderm/pachyderm/v2/src/internal/work"
@@ -66,6 +68,10 @@ type Driver interface {
 	// Returns the pachd API client for the driver
 	PachClient() *client.APIClient
 
+	// Returns the cache used when downloading datum inputs, or nil if the
+	// pipeline has no cache
+	InputCache() *pfssync.Cache
+
 	// Returns the number of workers to be used
 	ExpectedNumWorkers() (int64, error)
 
@@ -76,17 +82,26 @@ type Driver interface {
 
 	// WithActiveData swaps the given scratch directory into the 'active' input
 	// directory used when running user code. This also locks a mutex so that no
-	// two datums can be active concurrently.
+	// two datums can be active concurrently. If the pipeline's datum
+	// concurrency is greater than one, the user code uses the scratch directory
+	// directly instead, and datums aren't serialized.
 	WithActiveData([]*common.Input, string, func() error) error
 
 	// UserCodeEnv returns the set of environment variables to construct when
-	// launching the configured user process.
-	UserCodeEnv(string, *pfs.Commit, []*common.Input) []string
+	// launching the configured user process. The scratch directory is the one
+	// that will be passed to WithActiveData, it may be empty if the datum has
+	// none.
+	UserCodeEnv(string, *pfs.Commit, []*common.Input, string) []string
 
 	RunUserCode(context.Context, logs.TaggedLogger, []string) error
 
 	RunUserErrorHandlingCode(context.Context, logs.TaggedLogger, []string) error
 
+	// RunPersistentUserCode sends a datum's inputs to the persistent user
+	// process, starting it if it isn't running, and waits for the process to
+	// finish processing them.
+	RunPersistentUserCode(context.Context, logs.TaggedLogger, []string, []*common.Input) error
+
 	// TODO: provide a more generic interface for modifying jobs, and
 	// some quality-of-life functions for common operations.
 	DeleteJob(col.STM, *pps.EtcdJobInfo) error
@@ -121,6 +136,14 @@ type driver struct {
 	// The directory to store input data - this is typically static but can be
 	// overridden by tests.
 	inputDir string
+
+	// The cache of downloaded input files, bounded by the pipeline's cache
+	// size.
+	inputCache *pfssync.Cache
+
+	// The user process for transforms with persistent set, shared by all
+	// copies of the driver.
+	persistent *persistentProcess
 }
 
 // NewDriver constructs a Driver object using the given clients and pipeline
@@ -150,6 +173,19 @@ func NewDriver(
 		rootDir:         rootPath,
 		inputDir:        pfsPath,
 		namespace:       namespace,
+		persistent:      newPersistentProcess(),
+	}
+	if pipelineInfo.CacheSize != "" {
+		cacheSize, err := resource.ParseQuantity(pipelineInfo.CacheSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse cache size %q", pipelineInfo.CacheSize)
+		}
+		// The cache is in the scratch space, next to the datums' scratch
+		// directories that its files are copied into.
+		result.inputCache, err = pfssync.NewCache(filepath.Join(pfsPath, client.PPSScratchSpace, "cache"), cacheSize.Value())
+		if err != nil {
+			return nil, err
+		}
 	}
 	if pipelineInfo.Transform.User != "" {
 		user, err := lookupDockerUser(pipelineInfo.Transform.User)
@@ -303,6 +339,10 @@ func (d *driver) PachClient() *client.APIClient {
 	return d.pachClient
 }
 
+func (d *driver) InputCache() *pfssync.Cache {
+	return d.inputCache
+}
+
 func (d *driver) NewSTM(cb func(col.STM) error) (*etcd.TxnResponse, error) {
 	return col.NewSTM(d.pachClient.Ctx(), d.etcdClient, cb)
 }
@@ -492,17 +532,37 @@ func (d *driver) unlinkData(inputs []*common.Input) error {
 	return nil
 }
 
+// concurrentData returns true if datums use their scratch directories
+// directly, rather than having them swapped into the input directory.
+func (d *driver) concurrentData() bool {
+	return d.pipelineInfo.DatumConcurrency > 1
+}
+
+// dataDir returns the directory that user code finds the inputs and output of
+// the datum whose scratch directory is dir in.
+func (d *driver) dataDir(dir string) string {
+	if dir != "" && d.concurrentData() {
+		return dir
+	}
+	return d.InputDir()
+}
+
 func (d *driver) UserCodeEnv(
 	jobID string,
 	outputCommit *pfs.Commit,
 	inputs []*common.Input,
+	dir string,
 ) []string {
 	result := os.Environ()
 
+	dataDir := d.dataDir(dir)
 	for _, input := range inputs {
-		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(d.InputDir(), input.Name, input.FileInfo.File.Path)))
+		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(dataDir, input.Name, input.FileInfo.File.Path)))
 		result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.ID))
 	}
+	if len(inputs) > 0 && !d.PipelineInfo().S3Out {
+		result = append(result, fmt.Sprintf("%s=%s", client.OutputDirEnv, filepath.Join(dataDir, "out")))
+	}
 
 	if jobID != "" {
 		result = append(result, fmt.Sprintf("%s=%s", client.JobIDEnv, jobID))
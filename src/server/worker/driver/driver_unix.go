@@ -26,6 +26,9 @@ func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 // symlinks work on windows. Here, we create symlinks to the scratch space
 // directory, then clean up before returning.
 func (d *driver) WithActiveData(inputs []*common.Input, dir string, cb func() error) (retErr error) {
+	if d.concurrentData() {
+		return cb()
+	}
 	d.activeDataMutex.Lock()
 	defer d.activeDataMutex.Unlock()
 
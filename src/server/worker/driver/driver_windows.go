@@ -23,6 +23,9 @@ func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 // symlinks work on windows. Here, we move inputs into place before the
 // callback, then move them back to the scratch space before returning.
 func (d *driver) WithActiveData(inputs []*common.Input, dir string, cb func() error) (retErr error) {
+	if d.concurrentData() {
+		return cb()
+	}
 	d.activeDataMutex.Lock()
 	defer d.activeDataMutex.Unlock()
 
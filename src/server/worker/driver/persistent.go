@@ -0,0 +1,275 @@
+package driver
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/internal/errors"
+	"github.com/pachyderm/pachyderm/v2/src/internal/exec"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+// persistentRequest is written as a single line of JSON to the stdin of a
+// persistent user process for each datum that it should process.
+type persistentRequest struct {
+	JobID  string            `json:"job_id"`
+	Inputs []persistentInput `json:"inputs"`
+	Output string            `json:"output"`
+	// Env holds the datum's environment variables that differ from the
+	// environment the process was started with.
+	Env map[string]string `json:"env"`
+}
+
+// persistentInput describes one of the inputs of a persistentRequest.
+type persistentInput struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Commit string `json:"commit"`
+}
+
+// persistentResponse is written as a single line of JSON by a persistent user
+// process to file descriptor 3 when it has finished processing a datum. An
+// empty Error means the datum succeeded.
+type persistentResponse struct {
+	Error string `json:"error"`
+}
+
+// persistentDrainTimeout is how long the output of a persistent user process
+// is read for after it exits.
+const persistentDrainTimeout = time.Second
+
+// persistentProcess is a user process that is started once per worker and
+// then processes datums one at a time.
+type persistentProcess struct {
+	mu sync.Mutex
+	// The following fields are only set while the process is running.
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	env       map[string]bool
+	responses chan *persistentResponse
+	done      chan struct{}
+	err       error
+	// out forwards the process's stdout and stderr to the logger of the
+	// datum being processed.
+	out *switchWriter
+}
+
+func newPersistentProcess() *persistentProcess {
+	return &persistentProcess{out: &switchWriter{}}
+}
+
+type switchWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (sw *switchWriter) set(w io.Writer) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	sw.w = w
+}
+
+func (sw *switchWriter) Write(p []byte) (int, error) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	if sw.w == nil {
+		return len(p), nil
+	}
+	return sw.w.Write(p)
+}
+
+func (d *driver) RunPersistentUserCode(
+	ctx context.Context,
+	logger logs.TaggedLogger,
+	environ []string,
+	inputs []*common.Input,
+) (retErr error) {
+	logger.Logf("beginning to run persistent user code")
+	defer func(start time.Time) {
+		if retErr != nil {
+			logger.Logf("errored running persistent user code after %v: %v", time.Since(start), retErr)
+		} else {
+			logger.Logf("finished running persistent user code after %v", time.Since(start))
+		}
+	}(time.Now())
+	p := d.persistent
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.out.set(logger.WithUserCode())
+	if p.cmd != nil {
+		select {
+		case <-p.done:
+			logger.Logf("persistent user code exited between datums: %v", p.err)
+			p.stop()
+		default:
+		}
+	}
+	if p.cmd == nil {
+		if err := d.startPersistent(logger); err != nil {
+			return err
+		}
+	}
+	req := &persistentRequest{
+		JobID:  logger.JobID(),
+		Output: filepath.Join(d.InputDir(), "out"),
+		Env:    make(map[string]string),
+	}
+	for _, input := range inputs {
+		req.Inputs = append(req.Inputs, persistentInput{
+			Name:   input.Name,
+			Path:   filepath.Join(d.InputDir(), input.Name, input.FileInfo.File.Path),
+			Commit: input.FileInfo.File.Commit.ID,
+		})
+	}
+	for _, kv := range environ {
+		if p.env[kv] {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			req.Env[parts[0]] = parts[1]
+		}
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if _, err := p.stdin.Write(append(data, '\n')); err != nil {
+		p.stop()
+		return errors.Wrap(err, "error sending datum to persistent user code")
+	}
+	select {
+	case resp, ok := <-p.responses:
+		if !ok {
+			p.stop()
+			return errors.Errorf("persistent user code exited without responding: %v", p.err)
+		}
+		if resp.Error != "" {
+			return errors.Errorf("persistent user code failed: %s", resp.Error)
+		}
+		return nil
+	case <-ctx.Done():
+		// The process may be in any state, so it's restarted for the next
+		// datum.
+		p.stop()
+		return errors.EnsureStack(ctx.Err())
+	}
+}
+
+// startPersistent starts the persistent user process. It must be called with
+// d.persistent.mu held.
+func (d *driver) startPersistent(logger logs.TaggedLogger) error {
+	if len(d.pipelineInfo.Transform.Cmd) == 0 {
+		return errors.New("invalid pipeline transform, no command specified")
+	}
+	logger.Logf("starting persistent user code")
+	p := d.persistent
+	cmd := exec.Command(d.pipelineInfo.Transform.Cmd[0], d.pipelineInfo.Transform.Cmd[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	respR, respW, err := os.Pipe()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	// The output goes through a pipe that's owned here, rather than one
+	// that exec copies from, so that it can be closed after the process
+	// exits even if its children still hold the write end.
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		respR.Close()
+		respW.Close()
+		return errors.EnsureStack(err)
+	}
+	cmd.ExtraFiles = []*os.File{respW}
+	cmd.Stdout = outW
+	cmd.Stderr = outW
+	cmd.Env = d.UserCodeEnv("", nil, nil, "")
+	if d.uid != nil && d.gid != nil {
+		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
+	}
+	cmd.Dir = filepath.Join(d.rootDir, d.pipelineInfo.Transform.WorkingDir)
+	if err := cmd.Start(); err != nil {
+		respR.Close()
+		respW.Close()
+		outR.Close()
+		outW.Close()
+		return errors.EnsureStack(err)
+	}
+	// The child has its own copies of the write ends, so closing ours lets
+	// the readers see EOF when the child exits.
+	respW.Close()
+	outW.Close()
+	copied := make(chan struct{})
+	go func() {
+		defer close(copied)
+		defer outR.Close()
+		io.Copy(p.out, outR)
+	}()
+	p.cmd = cmd
+	p.stdin = stdin
+	p.env = make(map[string]bool)
+	for _, kv := range cmd.Env {
+		p.env[kv] = true
+	}
+	p.err = nil
+	responses := make(chan *persistentResponse)
+	done := make(chan struct{})
+	p.responses = responses
+	p.done = done
+	go func() {
+		defer close(responses)
+		defer respR.Close()
+		scanner := bufio.NewScanner(respR)
+		for scanner.Scan() {
+			resp := &persistentResponse{}
+			if err := json.Unmarshal(scanner.Bytes(), resp); err != nil {
+				resp.Error = errors.Wrapf(err, "invalid response %q", scanner.Text()).Error()
+			}
+			select {
+			case responses <- resp:
+			case <-done:
+				return
+			}
+		}
+	}()
+	go func() {
+		err := cmd.Wait()
+		if err != nil {
+			err = errors.Wrap(err, "persistent user code")
+		}
+		// Children that outlive the process may hold its pipes open, so
+		// they're only read for a little longer.
+		deadline := time.Now().Add(persistentDrainTimeout)
+		outR.SetReadDeadline(deadline)
+		respR.SetReadDeadline(deadline)
+		<-copied
+		p.err = err
+		close(done)
+	}()
+	return nil
+}
+
+// stop kills the persistent user process, if it's running, so that it's
+// restarted for the next datum. It must be called with d.persistent.mu held.
+func (p *persistentProcess) stop() {
+	if p.cmd == nil {
+		return
+	}
+	p.stdin.Close()
+	p.cmd.Process.Kill()
+	<-p.done
+	p.cmd = nil
+	p.stdin = nil
+	p.responses = nil
+	p.done = nil
+}
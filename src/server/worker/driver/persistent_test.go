@@ -0,0 +1,99 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/v2/src/client"
+	"github.com/pachyderm/pachyderm/v2/src/internal/require"
+	"github.com/pachyderm/pachyderm/v2/src/pfs"
+	"github.com/pachyderm/pachyderm/v2/src/pps"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/v2/src/server/worker/logs"
+)
+
+func newPersistentTestDriver(t *testing.T, script string) *driver {
+	return &driver{
+		pipelineInfo: &pps.PipelineInfo{
+			Transform: &pps.Transform{
+				Cmd:        []string{"sh", "-c", script},
+				Persistent: true,
+			},
+		},
+		activeDataMutex: &sync.Mutex{},
+		rootDir:         "/",
+		inputDir:        t.TempDir(),
+		persistent:      newPersistentProcess(),
+	}
+}
+
+func testInputs(path string) []*common.Input {
+	return []*common.Input{{
+		Name: "in",
+		FileInfo: &pfs.FileInfo{
+			File: client.NewFile("in", "abc", path),
+		},
+	}}
+}
+
+func TestRunPersistentUserCode(t *testing.T) {
+	// Each line written by the process is its PID followed by the request, so
+	// the process must be the same for every datum.
+	log := filepath.Join(t.TempDir(), "log")
+	d := newPersistentTestDriver(t, fmt.Sprintf(`while read req; do echo "$$ $req" >>%s; echo '{}' >&3; done`, log))
+	for _, path := range []string{"/a", "/b", "/c"} {
+		require.NoError(t, d.RunPersistentUserCode(context.Background(), logs.NewMockLogger(), []string{"FOO=bar"}, testInputs(path)))
+	}
+	data, err := ioutil.ReadFile(log)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Equal(t, 3, len(lines))
+	for i, path := range []string{"/a", "/b", "/c"} {
+		require.Equal(t, strings.Fields(lines[0])[0], strings.Fields(lines[i])[0])
+		require.True(t, strings.Contains(lines[i], path))
+		require.True(t, strings.Contains(lines[i], `"FOO":"bar"`))
+	}
+}
+
+func TestRunPersistentUserCodeError(t *testing.T) {
+	d := newPersistentTestDriver(t, `while read req; do echo '{"error": "bad datum"}' >&3; done`)
+	err := d.RunPersistentUserCode(context.Background(), logs.NewMockLogger(), nil, testInputs("/a"))
+	require.YesError(t, err)
+	require.Matches(t, "bad datum", err.Error())
+}
+
+func TestRunPersistentUserCodeRestart(t *testing.T) {
+	// The process exits after its first datum, and hangs on every other one.
+	d := newPersistentTestDriver(t, `read req; echo '{}' >&3; exit 0`)
+	logger := logs.NewMockLogger()
+	require.NoError(t, d.RunPersistentUserCode(context.Background(), logger, nil, testInputs("/a")))
+	// Wait for the process to exit, after which it should be restarted.
+	<-d.persistent.done
+	require.NoError(t, d.RunPersistentUserCode(context.Background(), logger, nil, testInputs("/b")))
+
+	d = newPersistentTestDriver(t, `read req; sleep 5`)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.YesError(t, d.RunPersistentUserCode(ctx, logger, nil, testInputs("/a")))
+	require.True(t, d.persistent.cmd == nil)
+}
+
+func TestRunPersistentUserCodeOrphanedOutput(t *testing.T) {
+	// The process leaves a child holding its output open, which mustn't stop
+	// the process from being seen to exit.
+	d := newPersistentTestDriver(t, `read req; echo '{}' >&3; sleep 30 & exit 0`)
+	logger := logs.NewMockLogger()
+	require.NoError(t, d.RunPersistentUserCode(context.Background(), logger, nil, testInputs("/a")))
+	select {
+	case <-d.persistent.done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("persistent process wasn't seen to exit")
+	}
+	require.NoError(t, d.persistent.err)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	col "github.com/pachyderm/pachyderm/v2/src/internal/collection"
+	"github.com/pachyderm/pachyderm/v2/src/internal/pfssync"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsconsts"
 	"github.com/pachyderm/pachyderm/v2/src/internal/require"
 	"github.com/pachyderm/pachyderm/v2/src/internal/serviceenv"
@@ -91,6 +92,9 @@ func (td *testDriver) InputDir() string {
 func (td *testDriver) PachClient() *client.APIClient {
 	return td.inner.PachClient()
 }
+func (td *testDriver) InputCache() *pfssync.Cache {
+	return td.inner.InputCache()
+}
 func (td *testDriver) ExpectedNumWorkers() (int64, error) {
 	return td.inner.ExpectedNumWorkers()
 }
@@ -100,8 +104,8 @@ func (td *testDriver) WithContext(ctx context.Context) driver.Driver {
 func (td *testDriver) WithActiveData(inputs []*common.Input, dir string, cb func() error) error {
 	return td.inner.WithActiveData(inputs, dir, cb)
 }
-func (td *testDriver) UserCodeEnv(job string, commit *pfs.Commit, inputs []*common.Input) []string {
-	return td.inner.UserCodeEnv(job, commit, inputs)
+func (td *testDriver) UserCodeEnv(job string, commit *pfs.Commit, inputs []*common.Input, dir string) []string {
+	return td.inner.UserCodeEnv(job, commit, inputs, dir)
 }
 func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
 	return td.inner.RunUserCode(ctx, logger, env)
@@ -109,6 +113,9 @@ func (td *testDriver) RunUserCode(ctx context.Context, logger logs.TaggedLogger,
 func (td *testDriver) RunUserErrorHandlingCode(ctx context.Context, logger logs.TaggedLogger, env []string) error {
 	return td.inner.RunUserErrorHandlingCode(ctx, logger, env)
 }
+func (td *testDriver) RunPersistentUserCode(ctx context.Context, logger logs.TaggedLogger, env []string, inputs []*common.Input) error {
+	return td.inner.RunPersistentUserCode(ctx, logger, env, inputs)
+}
 func (td *testDriver) DeleteJob(stm col.STM, ji *pps.EtcdJobInfo) error {
 	return td.inner.DeleteJob(stm, ji)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +33,10 @@ import (
 
 // TODO: Job failures are propagated through commits with pfs.EmptyStr in the description, would be better to have general purpose metadata associated with a commit.
 
+// defaultJobRetryBackoff is the time to wait before the first retry of a job
+// whose pipeline doesn't set a backoff.
+const defaultJobRetryBackoff = time.Minute
+
 type hasher struct {
 	name string
 	salt string
@@ -70,8 +75,9 @@ func (pj *pendingJob) saveJobStats(stats *datum.Stats) {
 	pj.ji.DataTotal += stats.Processed + stats.Skipped + stats.Failed + stats.Recovered
 }
 
-func (pj *pendingJob) withDeleter(pachClient *client.APIClient, cb func() error) error {
-	defer pj.jdit.SetDeleter(nil)
+// withDeleter calls cb with a deleter for the output of datums in the job's
+// output and meta commits.
+func (pj *pendingJob) withDeleter(pachClient *client.APIClient, cb func(datum.Deleter) error) error {
 	// Setup file operation client for output Meta commit.
 	metaCommit := pj.metaCommitInfo.Commit
 	return pachClient.WithModifyFileClient(metaCommit.Repo.Name, metaCommit.ID, func(mfMeta client.ModifyFile) error {
@@ -91,8 +97,7 @@ func (pj *pendingJob) withDeleter(pachClient *client.APIClient, cb func() error)
 				}
 				return files, nil
 			}
-			pj.jdit.SetDeleter(datum.NewDeleter(metaFileWalker, mfMeta, mfPFS))
-			return cb()
+			return cb(datum.NewDeleter(metaFileWalker, mfMeta, mfPFS))
 		})
 	})
 }
@@ -292,6 +297,16 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo) error {
 		mutex.Lock()
 		defer mutex.Unlock()
 		// This runs the callback asynchronously, but we want to block the errgroup until it completes
+		var opts []work.TaskOption
+		if pj.driver.PipelineInfo().PrioritizeNewestJobs {
+			// Newer output commits are started later, so their jobs get a
+			// higher priority.
+			started, err := types.TimestampFromProto(pj.commitInfo.Started)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, work.WithPriority(started.UnixNano()))
+		}
 		if err := reg.taskQueue.RunTask(pj.driver.PachClient().Ctx(), func(master *work.Master) {
 			defer mutex.Unlock()
 			pj.taskMaster = master
@@ -336,7 +351,7 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo) error {
 				return pj.driver.PachClient().ClearCommit(pj.metaCommitInfo.Commit.Repo.Name, pj.metaCommitInfo.Commit.ID)
 			})
 			pj.logger.Logf("master done running processJobs")
-		}); err != nil {
+		}, opts...); err != nil {
 			return err
 		}
 		// This should block until the callback has completed
@@ -421,6 +436,9 @@ func (reg *registry) processJobStarting(pj *pendingJob) error {
 		reason := fmt.Sprintf("inputs failed: %s", strings.Join(failed, ", "))
 		return reg.failJob(pj, reason)
 	}
+	if err := propagateLabels(pj.driver.PachClient(), pj.driver.PipelineInfo(), pj.ji, pj.commitInfo.Commit); err != nil {
+		return err
+	}
 	pj.ji.State = pps.JobState_JOB_RUNNING
 	return pj.writeJobInfo()
 }
@@ -429,9 +447,6 @@ func (reg *registry) processJobStarting(pj *pendingJob) error {
 // Need to put some more thought into the context use.
 func (reg *registry) processJobRunning(pj *pendingJob) error {
 	pachClient := pj.driver.PachClient()
-	eg, ctx := errgroup.WithContext(pachClient.Ctx())
-	pachClient = pachClient.WithCtx(ctx)
-	stats := &datum.Stats{ProcessStats: &pps.ProcessStats{}}
 	// TODO: We need to delete the output for S3Out since we don't have a clear way to track the output in the stats commit (which means datums cannot be skipped with S3Out).
 	// If we had a way to map the output added through the S3 gateway back to the datums, and stored this in the appropriate place in the stats commit, then we would be able
 	// handle datums the same way we handle normal pipelines.
@@ -442,7 +457,9 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	}
 	// Generate the deletion operations and count the number of datums for the job.
 	var numDatums int64
-	if err := pj.withDeleter(pachClient, func() error {
+	if err := pj.withDeleter(pachClient, func(deleter datum.Deleter) error {
+		pj.jdit.SetDeleter(deleter)
+		defer pj.jdit.SetDeleter(nil)
 		return pj.jdit.Iterate(func(_ *datum.Meta) error {
 			numDatums++
 			return nil
@@ -450,6 +467,48 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	}); err != nil {
 		return err
 	}
+	stats := &datum.Stats{ProcessStats: &pps.ProcessStats{}}
+	failed, err := reg.processDatums(pj, pj.jdit, numDatums, stats)
+	if err != nil {
+		return err
+	}
+	for retry := int64(0); len(failed) > 0 && retryableDatums(pj.driver.PipelineInfo().JobRetries, retry, failed); retry++ {
+		if err := reg.prepareRetry(pj, failed, retry); err != nil {
+			return err
+		}
+		// The failed datums are processed again, so they no longer count as
+		// failed.
+		stats.Failed -= int64(len(failed))
+		stats.FailedID = ""
+		failed, err = reg.processDatums(pj, datum.NewMetaIterator(failed), int64(len(failed)), stats)
+		if err != nil {
+			return err
+		}
+	}
+	// TODO: This shouldn't be necessary.
+	select {
+	case <-pj.driver.PachClient().Ctx().Done():
+		return pj.driver.PachClient().Ctx().Err()
+	default:
+	}
+	pj.saveJobStats(pj.jdit.Stats())
+	pj.saveJobStats(stats)
+	if stats.FailedID != "" {
+		return reg.failJob(pj, fmt.Sprintf("datum %v failed", stats.FailedID))
+	}
+	if pj.ji.Egress != nil {
+		pj.ji.State = pps.JobState_JOB_EGRESSING
+		return pj.writeJobInfo()
+	}
+	return reg.succeedJob(pj)
+}
+
+// processDatums processes the datums in dit, merging the results into stats,
+// and returns the metas of the datums that failed.
+func (reg *registry) processDatums(pj *pendingJob, dit datum.Iterator, numDatums int64, stats *datum.Stats) ([]*datum.Meta, error) {
+	pachClient := pj.driver.PachClient()
+	eg, ctx := errgroup.WithContext(pachClient.Ctx())
+	pachClient = pachClient.WithCtx(ctx)
 	// Set up the datum set spec for the job.
 	// When the datum set spec is not set, evenly distribute the datums.
 	var setSpec *datum.SetSpec
@@ -464,6 +523,7 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 			setSpec.Number = 1
 		}
 	}
+	var failed []*datum.Meta
 	// Setup datum set subtask channel.
 	subtasks := make(chan *work.Task)
 	if err := pachClient.WithRenewer(func(ctx context.Context, renewer *renew.StringSet) error {
@@ -471,7 +531,7 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 		eg.Go(func() error {
 			defer close(subtasks)
 			storageRoot := filepath.Join(pj.driver.InputDir(), client.PPSScratchSpace, uuid.NewWithoutDashes())
-			return datum.CreateSets(pj.jdit, storageRoot, setSpec, func(upload func(client.ModifyFile) error) error {
+			return datum.CreateSets(dit, storageRoot, setSpec, func(upload func(client.ModifyFile) error) error {
 				subtask, err := createDatumSetSubtask(pachClient, pj, upload, renewer)
 				if err != nil {
 					return err
@@ -485,6 +545,11 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 			})
 		})
 		// Setup goroutine for running and collecting datum set subtasks.
+		var subtasksOpts []work.SubtasksOption
+		if maxQueueSize := pj.driver.PipelineInfo().MaxQueueSize; maxQueueSize > 0 {
+			// MaxQueueSize bounds the datum sets queued for each worker.
+			subtasksOpts = append(subtasksOpts, work.WithMaxQueueSize(maxQueueSize*reg.concurrency))
+		}
 		eg.Go(func() error {
 			return pj.logger.LogStep("running and collecting datum set subtasks", func() error {
 				return pj.taskMaster.RunSubtasksChan(
@@ -498,6 +563,17 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 							return err
 						}
 						renewer.Remove(data.FilesetId)
+						if data.Stats.Failed > 0 {
+							// Keep the failed datums around in case the job is retried.
+							if err := datum.NewFileSetIterator(pachClient, data.MetaFilesetId).Iterate(func(meta *datum.Meta) error {
+								if meta.State == datum.State_FAILED {
+									failed = append(failed, meta)
+								}
+								return nil
+							}); err != nil {
+								return err
+							}
+						}
 						repo := pj.commitInfo.Commit.Repo.Name
 						commit := pj.commitInfo.Commit.ID
 						if err := pachClient.AddFileset(repo, commit, data.OutputFilesetId); err != nil {
@@ -510,29 +586,83 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 						}
 						return datum.MergeStats(stats, data.Stats)
 					},
+					subtasksOpts...,
 				)
 			})
 		})
 		return eg.Wait()
 	}); err != nil {
+		return nil, err
+	}
+	return failed, nil
+}
+
+// retryableDatums returns true if the failed datums of a job should be
+// retried, given that they've already been retried the passed in number of
+// times.
+func retryableDatums(jobRetries *pps.JobRetries, retries int64, failed []*datum.Meta) bool {
+	if jobRetries == nil || retries >= jobRetries.Count {
+		return false
+	}
+	if len(jobRetries.RetryableReasons) == 0 {
+		return true
+	}
+	for _, meta := range failed {
+		var retryable bool
+		for _, reason := range jobRetries.RetryableReasons {
+			// The reasons are validated when the pipeline is created.
+			if ok, _ := regexp.MatchString(reason, meta.Reason); ok {
+				retryable = true
+				break
+			}
+		}
+		if !retryable {
+			return false
+		}
+	}
+	return true
+}
+
+// prepareRetry waits out the backoff of a job retry, records the retry in
+// the job info, and deletes the output of the failed datums so that they can
+// be processed again.
+func (reg *registry) prepareRetry(pj *pendingJob, failed []*datum.Meta, retry int64) error {
+	backoff := defaultJobRetryBackoff
+	if jobRetries := pj.driver.PipelineInfo().JobRetries; jobRetries.Backoff != nil {
+		var err error
+		backoff, err = types.DurationFromProto(jobRetries.Backoff)
+		if err != nil {
+			return err
+		}
+	}
+	backoff <<= uint(retry)
+	pj.ji.Restart++
+	pj.ji.Reason = fmt.Sprintf("retrying %d failed datums in %v", len(failed), backoff)
+	if err := pj.writeJobInfo(); err != nil {
 		return err
 	}
-	// TODO: This shouldn't be necessary.
+	pj.logger.Logf("retrying %d failed datums in %v, retry %d of %d", len(failed), backoff, retry+1, pj.driver.PipelineInfo().JobRetries.Count)
 	select {
+	case <-time.After(backoff):
 	case <-pj.driver.PachClient().Ctx().Done():
 		return pj.driver.PachClient().Ctx().Err()
-	default:
 	}
-	pj.saveJobStats(pj.jdit.Stats())
-	pj.saveJobStats(stats)
-	if stats.FailedID != "" {
-		return reg.failJob(pj, fmt.Sprintf("datum %v failed", stats.FailedID))
+	if err := pj.withDeleter(pj.driver.PachClient(), func(deleter datum.Deleter) error {
+		for _, meta := range failed {
+			if err := deleter(meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
 	}
-	if pj.ji.Egress != nil {
-		pj.ji.State = pps.JobState_JOB_EGRESSING
-		return pj.writeJobInfo()
+	for _, meta := range failed {
+		meta.State = datum.State_PROCESSED
+		meta.Reason = ""
 	}
-	return reg.succeedJob(pj)
+	pj.ji.Reason = ""
+	return pj.writeJobInfo()
 }
 
 func createDatumSetSubtask(pachClient *client.APIClient, pj *pendingJob, upload func(client.ModifyFile) error, renewer *renew.StringSet) (*work.Task, error) {
@@ -604,6 +734,9 @@ func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string,
 		}
 		if strings.Contains(ci.Description, pfs.EmptyStr) {
 			failed = append(failed, name)
+		} else if ci.Error != "" {
+			// fsck found that the commit's data is missing or corrupt
+			failed = append(failed, fmt.Sprintf("%s (broken: %s)", name, ci.Error))
 		}
 	}
 	pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
@@ -613,9 +746,52 @@ func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string,
 		if input.Cron != nil && input.Cron.Commit != "" {
 			blockCommit(input.Cron.Name, client.NewCommit(input.Cron.Repo, input.Cron.Commit))
 		}
+		if input.SQL != nil && input.SQL.Commit != "" {
+			blockCommit(input.SQL.Name, client.NewCommit(input.SQL.Repo, input.SQL.Commit))
+		}
+		if input.Bucket != nil && input.Bucket.Commit != "" {
+			blockCommit(input.Bucket.Name, client.NewCommit(input.Bucket.Repo, input.Bucket.Commit))
+		}
 		if input.Git != nil && input.Git.Commit != "" {
 			blockCommit(input.Git.Name, client.NewCommit(input.Git.Name, input.Git.Commit))
 		}
 	})
 	return failed, vistErr
 }
+
+// propagateLabels copies the labels listed in the pipeline's propagate_labels
+// from the job's input commits onto its output commit. If several inputs have
+// the same label, the last one in the input spec wins.
+func propagateLabels(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, jobInfo *pps.JobInfo, outputCommit *pfs.Commit) error {
+	if len(pipelineInfo.PropagateLabels) == 0 {
+		return nil
+	}
+	var inputCommits []*pfs.Commit
+	pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
+		if input.Pfs != nil && input.Pfs.Commit != "" {
+			inputCommits = append(inputCommits, client.NewCommit(input.Pfs.Repo, input.Pfs.Commit))
+		}
+		if input.SQL != nil && input.SQL.Commit != "" {
+			inputCommits = append(inputCommits, client.NewCommit(input.SQL.Repo, input.SQL.Commit))
+		}
+		if input.Bucket != nil && input.Bucket.Commit != "" {
+			inputCommits = append(inputCommits, client.NewCommit(input.Bucket.Repo, input.Bucket.Commit))
+		}
+	})
+	labels := make(map[string]string)
+	for _, commit := range inputCommits {
+		ci, err := pachClient.InspectCommit(commit.Repo.Name, commit.ID)
+		if err != nil {
+			return errors.Wrapf(err, "error inspecting input commit %s@%s", commit.Repo.Name, commit.ID)
+		}
+		for _, key := range pipelineInfo.PropagateLabels {
+			if value, ok := ci.Labels[key]; ok {
+				labels[key] = value
+			}
+		}
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	return pachClient.SetCommitLabels(outputCommit.Repo.Name, outputCommit.ID, labels)
+}
@@ -13,7 +13,7 @@ import (
 
 // Status is a struct representing the current status of the transform worker,
 // its public interface only allows getting the status of a task and canceling
-// the currently-processing datum.
+// the currently-processing datums.
 type Status struct {
 	mutex         sync.Mutex
 	jobID         string
@@ -21,9 +21,15 @@ type Status struct {
 	queueSize     *int64
 	dataProcessed *int64
 	dataRecovered *int64
-	datum         []*pps.InputFile
-	cancel        func()
-	started       time.Time
+	// datums holds the datums that are currently being processed, in the
+	// order they were started.
+	datums []*datumStatus
+}
+
+type datumStatus struct {
+	data    []*pps.InputFile
+	cancel  func()
+	started time.Time
 }
 
 func convertInputs(inputs []*common.Input) []*pps.InputFile {
@@ -75,16 +81,22 @@ func (s *Status) withJob(jobID string, cb func() error) error {
 //}
 
 func (s *Status) withDatum(inputs []*common.Input, cancel func(), cb func() error) error {
+	ds := &datumStatus{
+		data:    convertInputs(inputs),
+		cancel:  cancel,
+		started: time.Now(),
+	}
 	s.withLock(func() {
-		s.datum = convertInputs(inputs)
-		s.cancel = cancel
-		s.started = time.Now()
+		s.datums = append(s.datums, ds)
 	})
 
 	defer s.withLock(func() {
-		s.datum = nil
-		s.cancel = nil
-		s.started = time.Time{}
+		for i := range s.datums {
+			if s.datums[i] == ds {
+				s.datums = append(s.datums[:i], s.datums[i+1:]...)
+				break
+			}
+		}
 	})
 
 	return cb()
@@ -95,15 +107,30 @@ func (s *Status) GetStatus() (*pps.WorkerStatus, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	started, err := types.TimestampProto(s.started)
-	if err != nil {
-		return nil, err
-	}
 	result := &pps.WorkerStatus{
-		JobID:   s.jobID,
-		Data:    s.datum,
-		Started: started,
-		Stats:   s.stats,
+		JobID: s.jobID,
+		Stats: s.stats,
+	}
+	for _, ds := range s.datums {
+		started, err := types.TimestampProto(ds.started)
+		if err != nil {
+			return nil, err
+		}
+		result.Datums = append(result.Datums, &pps.DatumStatus{
+			Data:    ds.data,
+			Started: started,
+		})
+	}
+	// Data and Started describe the oldest datum being processed.
+	if len(result.Datums) > 0 {
+		result.Data = result.Datums[0].Data
+		result.Started = result.Datums[0].Started
+	} else {
+		started, err := types.TimestampProto(time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		result.Started = started
 	}
 	if s.queueSize != nil {
 		result.QueueSize = atomic.LoadInt64(s.queueSize)
@@ -117,15 +144,22 @@ func (s *Status) GetStatus() (*pps.WorkerStatus, error) {
 	return result, nil
 }
 
-// Cancel cancels the currently running datum if it matches the specified job and inputs
+// Cancel cancels the currently running datums that match the specified job
+// and inputs
 func (s *Status) Cancel(jobID string, datumFilter []string) bool {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	if jobID == s.jobID && common.MatchDatum(datumFilter, s.datum) {
-		// Fields will be cleared as the worker stack unwinds
-		s.cancel()
-		return true
+	if jobID != s.jobID {
+		return false
+	}
+	canceled := false
+	for _, ds := range s.datums {
+		if common.MatchDatum(datumFilter, ds.data) {
+			// The datum will be removed as the worker stack unwinds
+			ds.cancel()
+			canceled = true
+		}
 	}
-	return false
+	return canceled
 }
@@ -380,6 +380,21 @@ func TestTransformPipeline(suite *testing.T) {
 		}))
 	})
 
+	suite.Run("TestJobConcurrentDatums", func(t *testing.T) {
+		t.Parallel()
+		pi := defaultPipelineInfo()
+		pi.DatumConcurrency = 2
+		// Concurrent datums aren't swapped into /pfs, so the datum's
+		// directories are found through the environment.
+		pi.Transform.Stdin = []string{`cp "$inputRepo" "$PACH_OUTPUT_DIR"`}
+		env := newWorkerSpawnerPair(t, postgres.NewDatabaseConfig(t), pi)
+		testJobSuccess(t, env, pi, []tarutil.File{
+			tarutil.NewMemFile("/a", []byte("foobar")),
+			tarutil.NewMemFile("/b", []byte("barfoo")),
+			tarutil.NewMemFile("/c", []byte("bazqux")),
+		})
+	})
+
 	suite.Run("TestJobSerial", func(t *testing.T) {
 		t.Parallel()
 		pi := defaultPipelineInfo()
@@ -9,6 +9,9 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+
 	"github.com/pachyderm/pachyderm/v2/src/client"
 	"github.com/pachyderm/pachyderm/v2/src/internal/backoff"
 	"github.com/pachyderm/pachyderm/v2/src/internal/ppsutil"
@@ -84,42 +87,38 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 				datum.WithMetaOutput(mfMeta),
 				datum.WithPFSOutput(mfPFS),
 				datum.WithStats(datumSet.Stats),
+				datum.WithInputCache(driver.InputCache()),
 			}
 			// Setup datum set for processing.
 			return datum.WithSet(pachClient, storageRoot, func(s *datum.Set) error {
+				concurrency := driver.PipelineInfo().DatumConcurrency
+				if concurrency < 1 {
+					concurrency = 1
+				}
+				// Datums are processed in separate goroutines, at most
+				// concurrency of which run at once.
+				eg, ctx := errgroup.WithContext(pachClient.Ctx())
+				sem := semaphore.NewWeighted(concurrency)
 				di := datum.NewFileSetIterator(pachClient, datumSet.FilesetId)
 				// Process each datum in the assigned datum set.
-				return di.Iterate(func(meta *datum.Meta) error {
-					ctx := pachClient.Ctx()
-					inputs := meta.Inputs
-					logger = logger.WithData(inputs)
-					env := driver.UserCodeEnv(logger.JobID(), datumSet.OutputCommit, inputs)
-					var opts []datum.Option
-					if driver.PipelineInfo().DatumTimeout != nil {
-						timeout, err := types.DurationFromProto(driver.PipelineInfo().DatumTimeout)
-						if err != nil {
-							return err
-						}
-						opts = append(opts, datum.WithTimeout(timeout))
+				if err := di.Iterate(func(meta *datum.Meta) error {
+					if err := sem.Acquire(ctx, 1); err != nil {
+						return err
 					}
-					if driver.PipelineInfo().Transform.ErrCmd != nil {
-						opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
-							return driver.RunUserErrorHandlingCode(runCtx, logger, env)
-						}))
+					eg.Go(func() error {
+						defer sem.Release(1)
+						return handleDatum(ctx, driver, logger, datumSet, status, s, meta)
+					})
+					return nil
+				}); err != nil {
+					// The error from the group is more useful than the
+					// cancellation it caused.
+					if egErr := eg.Wait(); egErr != nil {
+						return egErr
 					}
-					return s.WithDatum(ctx, meta, func(d *datum.Datum) error {
-						cancelCtx, cancel := context.WithCancel(ctx)
-						defer cancel()
-						return status.withDatum(inputs, cancel, func() error {
-							return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
-								return d.Run(cancelCtx, func(runCtx context.Context) error {
-									return driver.RunUserCode(runCtx, logger, env)
-								})
-							})
-						})
-					}, opts...)
-
-				})
+					return err
+				}
+				return eg.Wait()
 			}, opts...)
 		})
 		if err != nil {
@@ -134,3 +133,39 @@ func handleDatumSet(driver driver.Driver, logger logs.TaggedLogger, datumSet *Da
 	datumSet.MetaFilesetId = resp.FilesetId
 	return nil
 }
+
+func handleDatum(ctx context.Context, driver driver.Driver, logger logs.TaggedLogger, datumSet *DatumSet, status *Status, s *datum.Set, meta *datum.Meta) error {
+	inputs := meta.Inputs
+	logger = logger.WithData(inputs)
+	// The environment depends on the datum's scratch directory, which is only
+	// known once the datum is being processed.
+	var env []string
+	var opts []datum.Option
+	if driver.PipelineInfo().DatumTimeout != nil {
+		timeout, err := types.DurationFromProto(driver.PipelineInfo().DatumTimeout)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, datum.WithTimeout(timeout))
+	}
+	if driver.PipelineInfo().Transform.ErrCmd != nil {
+		opts = append(opts, datum.WithRecoveryCallback(func(runCtx context.Context) error {
+			return driver.RunUserErrorHandlingCode(runCtx, logger, env)
+		}))
+	}
+	return s.WithDatum(ctx, meta, func(d *datum.Datum) error {
+		env = driver.UserCodeEnv(logger.JobID(), datumSet.OutputCommit, inputs, d.PFSStorageRoot())
+		cancelCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		return status.withDatum(inputs, cancel, func() error {
+			return driver.WithActiveData(inputs, d.PFSStorageRoot(), func() error {
+				return d.Run(cancelCtx, func(runCtx context.Context) error {
+					if driver.PipelineInfo().Transform.Persistent {
+						return driver.RunPersistentUserCode(runCtx, logger, env, inputs)
+					}
+					return driver.RunUserCode(runCtx, logger, env)
+				})
+			})
+		})
+	}, opts...)
+}